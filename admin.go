@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/factory"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// adminCommands are for operational tasks,
+// they use the same models as the API, so no need to prepare signed HTTP requests
+func adminCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "rooms",
+			Usage: "Manage active rooms",
+			Commands: []*cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List all active rooms",
+					Action: withAdminApp(adminListRooms),
+				},
+				{
+					Name:      "info",
+					Usage:     "Show information of an active room",
+					ArgsUsage: "<room_id>",
+					Action:    withAdminApp(adminRoomInfo),
+				},
+				{
+					Name:      "end",
+					Usage:     "End an active room",
+					ArgsUsage: "<room_id>",
+					Action:    withAdminApp(adminEndRoom),
+				},
+			},
+		},
+		{
+			Name:  "users",
+			Usage: "Manage users of an active room",
+			Commands: []*cli.Command{
+				{
+					Name:      "list",
+					Usage:     "List all users of the room",
+					ArgsUsage: "<room_id>",
+					Action:    withAdminApp(adminListUsers),
+				},
+				{
+					Name:      "kick",
+					Usage:     "Remove user from the room",
+					ArgsUsage: "<room_id> <user_id>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "msg",
+							Usage: "Message to show to the user",
+							Value: "notifications.room-disconnected-participant-removed",
+						},
+						&cli.BoolFlag{
+							Name:  "block",
+							Usage: "Block the user to join again",
+						},
+					},
+					Action: withAdminApp(adminKickUser),
+				},
+			},
+		},
+		{
+			Name:  "recordings",
+			Usage: "Manage recordings",
+			Commands: []*cli.Command{
+				{
+					Name:  "list",
+					Usage: "List recordings",
+					Flags: []cli.Flag{
+						&cli.StringSliceFlag{
+							Name:  "room-id",
+							Usage: "Filter by room id, can be used multiple times",
+						},
+						&cli.IntFlag{
+							Name:  "from",
+							Value: 0,
+						},
+						&cli.IntFlag{
+							Name:  "limit",
+							Value: 20,
+						},
+					},
+					Action: withAdminApp(adminListRecordings),
+				},
+				{
+					Name:      "delete",
+					Usage:     "Delete a recording",
+					ArgsUsage: "<record_id>",
+					Action:    withAdminApp(adminDeleteRecording),
+				},
+				{
+					Name:      "token",
+					Usage:     "Generate download token for a recording",
+					ArgsUsage: "<record_id>",
+					Action:    withAdminApp(adminRecordingToken),
+				},
+			},
+		},
+		{
+			Name:  "analytics",
+			Usage: "Manage analytics",
+			Commands: []*cli.Command{
+				{
+					Name:      "export",
+					Usage:     "Export analytics file",
+					ArgsUsage: "<file_id>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "output",
+							Usage: "Output file, default is stdout",
+						},
+					},
+					Action: withAdminApp(adminExportAnalytics),
+				},
+			},
+		},
+		{
+			Name:  "recorders",
+			Usage: "Check recorders",
+			Commands: []*cli.Command{
+				{
+					Name:   "status",
+					Usage:  "Show status of all active recorders",
+					Action: withAdminApp(adminRecordersStatus),
+				},
+			},
+		},
+	}
+}
+
+type adminAction func(ctx context.Context, c *cli.Command, app *factory.Application) error
+
+// withAdminApp will prepare all the services but won't boot them,
+// so that this process doesn't take any work of the running servers
+func withAdminApp(fn adminAction) cli.ActionFunc {
+	return func(ctx context.Context, c *cli.Command) error {
+		appCnf, err := helpers.ReadYamlConfigFile(c.String("config"))
+		if err != nil {
+			return err
+		}
+		config.New(appCnf)
+
+		err = helpers.PrepareServer(config.GetConfig())
+		if err != nil {
+			return err
+		}
+
+		app, err := factory.NewAppFactory(appCnf)
+		if err != nil {
+			return err
+		}
+		defer closeAdminApp(app.AppConfig)
+
+		return fn(ctx, c, app)
+	}
+}
+
+func closeAdminApp(appCnf *config.AppConfig) {
+	if db, err := appCnf.DB.DB(); err == nil {
+		_ = db.Close()
+	}
+	_ = appCnf.RDS.Close()
+	_ = appCnf.NatsConn.Drain()
+}
+
+func requireArgs(c *cli.Command, names ...string) ([]string, error) {
+	if c.NArg() < len(names) {
+		return nil, fmt.Errorf("required arguments: %v", names)
+	}
+	return c.Args().Slice()[:len(names)], nil
+}
+
+func printProtoJson(m proto.Message) error {
+	op := protojson.MarshalOptions{
+		Multiline:     true,
+		UseProtoNames: true,
+	}
+	marshal, err := op.Marshal(m)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(marshal))
+	return nil
+}
+
+func adminListRooms(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	status, msg, rooms := app.Models.RoomModel.GetActiveRoomsInfo("")
+	if !status {
+		return errors.New(msg)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ROOM ID\tSID\tTITLE\tPARTICIPANTS\tRECORDING\tBREAKOUT\tCREATED")
+	for _, r := range rooms {
+		i := r.GetRoomInfo()
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%t\t%t\t%s\n", i.GetRoomId(), i.GetSid(), i.GetRoomTitle(), i.GetJoinedParticipants(), i.GetIsRecording() == 1, i.GetIsBreakoutRoom() == 1, time.Unix(i.GetCreationTime(), 0).Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func adminRoomInfo(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "room_id")
+	if err != nil {
+		return err
+	}
+
+	status, msg, room := app.Models.RoomModel.GetActiveRoomInfo(ctx, &plugnmeet.GetActiveRoomInfoReq{
+		RoomId: args[0],
+	})
+	if !status {
+		return errors.New(msg)
+	}
+
+	return printProtoJson(room)
+}
+
+func adminEndRoom(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "room_id")
+	if err != nil {
+		return err
+	}
+
+	status, msg := app.Models.RoomModel.EndRoom(ctx, &plugnmeet.RoomEndReq{
+		RoomId: args[0],
+	})
+	if !status {
+		return errors.New(msg)
+	}
+	// cleanup runs in the background, the connections must be open until it finishes
+	fmt.Println("room ended:", args[0], "waiting for the cleanup to finish...")
+	app.Models.RoomModel.WaitForOnAfterRoomEnded()
+
+	fmt.Println("cleanup finished:", args[0])
+	return nil
+}
+
+func adminListUsers(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "room_id")
+	if err != nil {
+		return err
+	}
+
+	status, msg, room := app.Models.RoomModel.GetActiveRoomInfo(ctx, &plugnmeet.GetActiveRoomInfoReq{
+		RoomId: args[0],
+	})
+	if !status {
+		return errors.New(msg)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "USER ID\tNAME\tSTATE\tJOINED AT")
+	for _, p := range room.GetParticipantsInfo() {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.GetIdentity(), p.GetName(), p.GetState().String(), time.Unix(p.GetJoinedAt(), 0).Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func adminKickUser(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "room_id", "user_id")
+	if err != nil {
+		return err
+	}
+
+	err = app.Models.UserModel.RemoveParticipant(&plugnmeet.RemoveParticipantReq{
+		RoomId:    args[0],
+		UserId:    args[1],
+		Msg:       c.String("msg"),
+		BlockUser: c.Bool("block"),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("user removed:", args[1])
+	return nil
+}
+
+func adminListRecordings(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	result, err := app.Models.RecordingModel.FetchRecordings(&protocol.FetchRecordingsReq{
+		RoomIds:            c.StringSlice("room-id"),
+		From:               uint32(c.Int("from")),
+		Limit:              uint32(c.Int("limit")),
+		IncludeUnpublished: true,
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TOTAL: %d\n", result.GetTotalRecordings())
+	_, _ = fmt.Fprintln(w, "RECORD ID\tROOM ID\tROOM SID\tSIZE (MB)\tCREATED\tFILE")
+	for _, r := range result.GetRecordingsList() {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t%s\t%s\n", r.GetRecordId(), r.GetRoomId(), r.GetRoomSid(), r.GetFileSize(), time.Unix(r.GetCreationTime(), 0).Format(time.RFC3339), r.GetFilePath())
+	}
+	return w.Flush()
+}
+
+func adminDeleteRecording(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "record_id")
+	if err != nil {
+		return err
+	}
+
+	err = app.Models.RecordingModel.DeleteRecording(&plugnmeet.DeleteRecordingReq{
+		RecordId: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("recording deleted:", args[0])
+	return nil
+}
+
+func adminRecordingToken(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "record_id")
+	if err != nil {
+		return err
+	}
+
+	token, err := app.Models.RecordingModel.GetDownloadToken(&plugnmeet.GetDownloadTokenReq{
+		RecordId: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(token)
+	fmt.Println("download path: /download/recording/" + token)
+	return nil
+}
+
+func adminExportAnalytics(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	args, err := requireArgs(c, "file_id")
+	if err != nil {
+		return err
+	}
+
+	// same way as the download API, so that we'll get the correct file path
+	token, err := app.Models.AnalyticsModel.GetAnalyticsDownloadToken(&plugnmeet.GetAnalyticsDownloadTokenReq{
+		FileId: args[0],
+	})
+	if err != nil {
+		return err
+	}
+	key, _, err := app.Models.AnalyticsModel.VerifyAnalyticsToken(token)
+	if err != nil {
+		return err
+	}
+
+	src, _, err := app.Models.AnalyticsModel.Storage().Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	var dst io.Writer = os.Stdout
+	if output := c.String("output"); output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		dst = f
+	}
+
+	_, err = io.Copy(dst, src)
+	return err
+}
+
+func adminRecordersStatus(ctx context.Context, c *cli.Command, app *factory.Application) error {
+	recorders := app.Services.NatsService.GetAllActiveRecorders()
+	if len(recorders) == 0 {
+		return errors.New("no active recorder found")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "RECORDER ID\tMAX LIMIT\tCURRENT PROGRESS\tLAST PING")
+	for _, r := range recorders {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", r.RecorderId, r.MaxLimit, r.CurrentProgress, time.UnixMilli(r.LastPing).Format(time.RFC3339))
+	}
+	return w.Flush()
+}
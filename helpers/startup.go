@@ -3,7 +3,9 @@ package helpers
 import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/factory"
+	"github.com/mynaparrot/plugnmeet-server/pkg/migrations"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 	"os"
 )
@@ -56,3 +58,21 @@ func PrepareServer(appCnf *config.AppConfig) error {
 
 	return nil
 }
+
+// RunMigrations will apply all the pending schema migrations
+func RunMigrations(appCnf *config.AppConfig) error {
+	m, err := migrations.New(appCnf)
+	if err != nil {
+		return err
+	}
+
+	applied, err := m.Up()
+	if err != nil {
+		return err
+	}
+	if applied > 0 {
+		log.Infof("applied %d schema migrations", applied)
+	}
+
+	return nil
+}
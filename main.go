@@ -32,6 +32,9 @@ func main() {
 				Value:       "config.yaml",
 			},
 		},
+		Commands: append([]*cli.Command{
+			migrateCommand(),
+		}, adminCommands()...),
 		Action:  startServer,
 		Version: version.Version,
 	}
@@ -55,6 +58,13 @@ func startServer(ctx context.Context, c *cli.Command) error {
 		log.Fatalln(err)
 	}
 
+	if appCnf.DatabaseInfo.AutoMigrate {
+		err = helpers.RunMigrations(appCnf)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
 	appFactory, err := factory.NewAppFactory(appCnf)
 	if err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/factory"
+	"github.com/mynaparrot/plugnmeet-server/pkg/migrations"
+	"github.com/urfave/cli/v3"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+func migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Manage database schema migrations",
+		Commands: []*cli.Command{
+			{
+				Name:  "up",
+				Usage: "Apply all pending migrations",
+				Action: func(ctx context.Context, c *cli.Command) error {
+					m, err := prepareMigrator(c)
+					if err != nil {
+						return err
+					}
+					applied, err := m.Up()
+					if err != nil {
+						return err
+					}
+					fmt.Printf("applied %d migrations\n", applied)
+					return nil
+				},
+			},
+			{
+				Name:  "down",
+				Usage: "Roll back the latest applied migrations",
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:  "steps",
+						Usage: "Number of migrations to roll back",
+						Value: 1,
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+					m, err := prepareMigrator(c)
+					if err != nil {
+						return err
+					}
+					reverted, err := m.Down(int(c.Int("steps")))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("reverted %d migrations\n", reverted)
+					return nil
+				},
+			},
+			{
+				Name:  "status",
+				Usage: "Show applied & pending migrations",
+				Action: func(ctx context.Context, c *cli.Command) error {
+					m, err := prepareMigrator(c)
+					if err != nil {
+						return err
+					}
+					list, err := m.Status()
+					if err != nil {
+						return err
+					}
+
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+					for _, st := range list {
+						status, appliedAt := "pending", ""
+						if st.Applied {
+							status = "applied"
+							appliedAt = st.AppliedAt.Format(time.RFC3339)
+						}
+						_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, status, appliedAt)
+					}
+					return w.Flush()
+				},
+			},
+		},
+	}
+}
+
+// prepareMigrator will only connect with database,
+// other services aren't required to run migrations
+func prepareMigrator(c *cli.Command) (*migrations.Migrator, error) {
+	appCnf, err := helpers.ReadYamlConfigFile(c.String("config"))
+	if err != nil {
+		return nil, err
+	}
+	config.New(appCnf)
+
+	err = factory.NewDatabaseConnection(appCnf)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrations.New(appCnf)
+}
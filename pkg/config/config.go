@@ -36,6 +36,12 @@ type AppConfig struct {
 	SharedNotePad                SharedNotePad                `yaml:"shared_notepad"`
 	AzureCognitiveServicesSpeech AzureCognitiveServicesSpeech `yaml:"azure_cognitive_services_speech"`
 	AnalyticsSettings            *AnalyticsSettings           `yaml:"analytics_settings"`
+	ChatArchiveSettings          *ChatArchiveSettings         `yaml:"chat_archive_settings"`
+	WhiteboardSnapshotSettings   *WhiteboardSnapshotSettings  `yaml:"whiteboard_snapshot_settings"`
+	ChatModerationSettings       *ChatModerationSettings      `yaml:"chat_moderation_settings"`
+	WaitingRoomSettings          WaitingRoomSettings          `yaml:"waiting_room_settings"`
+	BreakoutRoomSettings         BreakoutRoomSettings         `yaml:"breakout_room_settings"`
+	StorageSettings              StorageSettings              `yaml:"storage_settings"`
 	NatsInfo                     NatsInfo                     `yaml:"nats_info"`
 }
 
@@ -45,6 +51,7 @@ type ClientInfo struct {
 	Path           string         `yaml:"path"`
 	ApiKey         string         `yaml:"api_key"`
 	Secret         string         `yaml:"secret"`
+	Secrets        []ClientSecret `yaml:"secrets"`
 	TokenValidity  *time.Duration `yaml:"token_validity"`
 	WebhookConf    WebhookConf    `yaml:"webhook_conf"`
 	PrometheusConf PrometheusConf `yaml:"prometheus"`
@@ -53,10 +60,29 @@ type ClientInfo struct {
 	BBBJoinHost    *string        `yaml:"bbb_join_host"`
 }
 
+// ClientSecret is used for rotating the secret.
+// New tokens will always be signed using the primary secret,
+// others will be accepted for verification until accepted_until
+type ClientSecret struct {
+	Secret        string     `yaml:"secret"`
+	Primary       bool       `yaml:"primary"`
+	AcceptedUntil *time.Time `yaml:"accepted_until"`
+}
+
 type WebhookConf struct {
-	Enable              bool   `yaml:"enable"`
-	Url                 string `yaml:"url,omitempty"`
-	EnableForPerMeeting bool   `yaml:"enable_for_per_meeting"`
+	Enable              bool                `yaml:"enable"`
+	Url                 string              `yaml:"url,omitempty"`
+	EnableForPerMeeting bool                `yaml:"enable_for_per_meeting"`
+	Delivery            WebhookDeliveryConf `yaml:"delivery"`
+}
+
+type WebhookDeliveryConf struct {
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	LogMaxAge      time.Duration `yaml:"log_max_age"`
+	// DeadLetterMaxAge dead letters which weren't replayed will be removed after it
+	DeadLetterMaxAge time.Duration `yaml:"dead_letter_max_age"`
 }
 
 type PrometheusConf struct {
@@ -87,11 +113,51 @@ type UploadFileSettings struct {
 }
 
 type RecorderInfo struct {
-	RecordingFilesPath         string        `yaml:"recording_files_path"`
-	TokenValidity              time.Duration `yaml:"token_validity"`
-	EnableDelRecordingBackup   bool          `yaml:"enable_del_recording_backup"`
-	DelRecordingBackupPath     string        `yaml:"del_recording_backup_path"`
-	DelRecordingBackupDuration time.Duration `yaml:"del_recording_backup_duration"`
+	RecordingFilesPath         string              `yaml:"recording_files_path"`
+	TokenValidity              time.Duration       `yaml:"token_validity"`
+	EnableDelRecordingBackup   bool                `yaml:"enable_del_recording_backup"`
+	DelRecordingBackupPath     string              `yaml:"del_recording_backup_path"`
+	DelRecordingBackupDuration time.Duration       `yaml:"del_recording_backup_duration"`
+	Retention                  *RecordingRetention `yaml:"retention"`
+	// PlaybackTokenValidity should be long enough to play the recording in a player
+	PlaybackTokenValidity time.Duration `yaml:"playback_token_validity"`
+}
+
+// RecordingRetention will be used to delete the expired recordings automatically.
+// Rooms can override DefaultDays during creation
+type RecordingRetention struct {
+	Enabled bool `yaml:"enabled"`
+	// DefaultDays 0 means recordings will be kept forever unless the room has its own value
+	DefaultDays int `yaml:"default_days"`
+	// BatchSize is the maximum number of recordings to delete per run
+	BatchSize int `yaml:"batch_size"`
+}
+
+const (
+	StorageTypeLocal = "local"
+	StorageTypeS3    = "s3"
+)
+
+// StorageSettings defines where recordings, uploaded & analytics files will be stored.
+// For local, paths of the respective settings will be used
+type StorageSettings struct {
+	Type string            `yaml:"type"`
+	S3   S3StorageSettings `yaml:"s3"`
+	// UsePresignedUrl will redirect downloads to a presigned url if the storage supports it
+	UsePresignedUrl    bool          `yaml:"use_presigned_url"`
+	PresignedUrlExpiry time.Duration `yaml:"presigned_url_expiry"`
+}
+
+type S3StorageSettings struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	// UsePathStyle is required for MinIO & most of the S3-compatible services
+	UsePathStyle bool `yaml:"use_path_style"`
+	// Prefix will be added before all the keys
+	Prefix string `yaml:"prefix"`
 }
 
 type SharedNotePad struct {
@@ -125,6 +191,42 @@ type AnalyticsSettings struct {
 	TokenValidity  *time.Duration `yaml:"token_validity"`
 }
 
+type ChatArchiveSettings struct {
+	Enabled        bool           `yaml:"enabled"`
+	FilesStorePath *string        `yaml:"files_store_path"`
+	TokenValidity  *time.Duration `yaml:"token_validity"`
+}
+
+type WhiteboardSnapshotSettings struct {
+	Enabled bool `yaml:"enabled"`
+	// StorePath default is whiteboard_snapshots directory inside upload_file_settings.path
+	StorePath     *string        `yaml:"store_path"`
+	TokenValidity *time.Duration `yaml:"token_validity"`
+}
+
+type ChatModerationSettings struct {
+	Enabled bool `yaml:"enabled"`
+	// Action for matched messages, possible values: drop, mask or flag
+	Action      string   `yaml:"action"`
+	BannedWords []string `yaml:"banned_words"`
+	// Patterns are regular expressions
+	Patterns     []string `yaml:"patterns"`
+	BlockLinks   bool     `yaml:"block_links"`
+	NotifyAdmins bool     `yaml:"notify_admins"`
+}
+
+type WaitingRoomSettings struct {
+	// AutoRejectAfter will reject users who are waiting longer than this duration, 0 to disable
+	AutoRejectAfter time.Duration `yaml:"auto_reject_after"`
+}
+
+type BreakoutRoomSettings struct {
+	// ReturnCountdown is the warning period before participants are sent back to the main room
+	ReturnCountdown time.Duration `yaml:"return_countdown"`
+	// ReturnGracePeriod will keep breakout rooms running after the countdown, 0 to end immediately
+	ReturnGracePeriod time.Duration `yaml:"return_grace_period"`
+}
+
 type ChatParticipant struct {
 	RoomSid string
 	RoomId  string
@@ -142,6 +244,7 @@ type CopyrightConf struct {
 }
 
 type DatabaseInfo struct {
+	// DriverName can be mysql or postgres
 	DriverName      string         `yaml:"driver_name"`
 	Host            string         `yaml:"host"`
 	Port            int32          `yaml:"port"`
@@ -153,6 +256,10 @@ type DatabaseInfo struct {
 	Loc             *string        `yaml:"loc"`
 	ConnMaxLifetime *time.Duration `yaml:"conn_max_lifetime"`
 	MaxOpenConns    *int           `yaml:"max_open_conns"`
+	// SslMode will be used only for postgres, default is disable
+	SslMode *string `yaml:"ssl_mode"`
+	// AutoMigrate will apply pending migrations during startup
+	AutoMigrate bool `yaml:"auto_migrate"`
 }
 
 type RedisInfo struct {
@@ -179,6 +286,7 @@ type NatsInfo struct {
 	NumReplicas              int              `yaml:"num_replicas"`
 	Subjects                 NatsSubjects     `yaml:"subjects"`
 	Recorder                 NatsInfoRecorder `yaml:"recorder"`
+	RateLimit                *NatsRateLimit   `yaml:"rate_limit"`
 }
 
 type NatsSubjects struct {
@@ -191,6 +299,24 @@ type NatsSubjects struct {
 	DataChannel     string `yaml:"data_channel"`
 }
 
+// NatsRateLimit will limit messages sent by a user to the server
+type NatsRateLimit struct {
+	Enabled bool `yaml:"enabled"`
+	// Default is used for the events which don't have own bucket in Events
+	Default NatsRateLimitBucket `yaml:"default"`
+	// Events key is the name of plugnmeet.NatsMsgClientToServerEvents, CHAT or DATA_CHANNEL
+	Events map[string]NatsRateLimitBucket `yaml:"events"`
+	// RemoveAfter number of violations within ViolationWindow, 0 to disable removing user
+	RemoveAfter     int64          `yaml:"remove_after"`
+	ViolationWindow *time.Duration `yaml:"violation_window"`
+}
+
+type NatsRateLimitBucket struct {
+	// Rate number of messages per second
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
 type NatsInfoRecorder struct {
 	RecorderChannel string `yaml:"recorder_channel"`
 	RecorderInfoKv  string `yaml:"recorder_info_kv"`
@@ -214,6 +340,36 @@ func New(a *AppConfig) {
 		appCnf.Client.TokenValidity = &validity
 	}
 
+	// primary secret from the list will be used for signing
+	for _, cs := range appCnf.Client.Secrets {
+		if cs.Primary && cs.Secret != "" {
+			if appCnf.Client.Secret != "" && appCnf.Client.Secret != cs.Secret && !appCnf.Client.hasSecret(appCnf.Client.Secret) {
+				// the old secret will remain accepted, otherwise existing tokens will be invalid
+				logrus.Warnln("client.secret was replaced by the primary secret of client.secrets, it will be accepted without any time limit. Add it to client.secrets with accepted_until to limit it.")
+				appCnf.Client.Secrets = append(appCnf.Client.Secrets, ClientSecret{Secret: appCnf.Client.Secret})
+			}
+			appCnf.Client.Secret = cs.Secret
+			break
+		}
+	}
+
+	// webhook delivery defaults
+	if appCnf.Client.WebhookConf.Delivery.MaxAttempts <= 0 {
+		appCnf.Client.WebhookConf.Delivery.MaxAttempts = 6
+	}
+	if appCnf.Client.WebhookConf.Delivery.InitialBackoff <= 0 {
+		appCnf.Client.WebhookConf.Delivery.InitialBackoff = time.Second * 5
+	}
+	if appCnf.Client.WebhookConf.Delivery.MaxBackoff <= 0 {
+		appCnf.Client.WebhookConf.Delivery.MaxBackoff = time.Minute * 10
+	}
+	if appCnf.Client.WebhookConf.Delivery.LogMaxAge <= 0 {
+		appCnf.Client.WebhookConf.Delivery.LogMaxAge = time.Hour * 24 * 7
+	}
+	if appCnf.Client.WebhookConf.Delivery.DeadLetterMaxAge <= 0 {
+		appCnf.Client.WebhookConf.Delivery.DeadLetterMaxAge = time.Hour * 24 * 30
+	}
+
 	// set default values
 	if appCnf.AnalyticsSettings != nil {
 		if appCnf.AnalyticsSettings.FilesStorePath == nil {
@@ -233,6 +389,58 @@ func New(a *AppConfig) {
 		}
 	}
 
+	if appCnf.ChatArchiveSettings != nil {
+		if appCnf.ChatArchiveSettings.FilesStorePath == nil {
+			p := "./chat_archives"
+			appCnf.ChatArchiveSettings.FilesStorePath = &p
+		}
+		if appCnf.ChatArchiveSettings.TokenValidity == nil {
+			d := time.Minute * 30
+			appCnf.ChatArchiveSettings.TokenValidity = &d
+		}
+
+		p := *appCnf.ChatArchiveSettings.FilesStorePath
+		if strings.HasPrefix(p, "./") {
+			p = filepath.Join(a.RootWorkingDir, p)
+		}
+
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			_ = os.MkdirAll(p, os.ModePerm)
+		}
+	}
+
+	if appCnf.WhiteboardSnapshotSettings != nil {
+		if appCnf.WhiteboardSnapshotSettings.StorePath == nil {
+			p := filepath.Join(appCnf.UploadFileSettings.Path, "whiteboard_snapshots")
+			appCnf.WhiteboardSnapshotSettings.StorePath = &p
+		}
+		if appCnf.WhiteboardSnapshotSettings.TokenValidity == nil {
+			d := time.Minute * 30
+			appCnf.WhiteboardSnapshotSettings.TokenValidity = &d
+		}
+	}
+
+	if appCnf.NatsInfo.RateLimit != nil {
+		if appCnf.NatsInfo.RateLimit.Default.Rate <= 0 {
+			appCnf.NatsInfo.RateLimit.Default.Rate = 5
+		}
+		if appCnf.NatsInfo.RateLimit.Default.Burst <= 0 {
+			appCnf.NatsInfo.RateLimit.Default.Burst = 20
+		}
+		if appCnf.NatsInfo.RateLimit.ViolationWindow == nil {
+			d := time.Minute
+			appCnf.NatsInfo.RateLimit.ViolationWindow = &d
+		}
+	}
+
+	if appCnf.ChatModerationSettings != nil {
+		switch appCnf.ChatModerationSettings.Action {
+		case ChatModerationActionDrop, ChatModerationActionMask, ChatModerationActionFlag:
+		default:
+			appCnf.ChatModerationSettings.Action = ChatModerationActionMask
+		}
+	}
+
 	// set default
 	if appCnf.RecorderInfo.EnableDelRecordingBackup {
 		if appCnf.RecorderInfo.DelRecordingBackupDuration == 0 {
@@ -248,6 +456,22 @@ func New(a *AppConfig) {
 			log.Fatal(err)
 		}
 	}
+	if appCnf.StorageSettings.Type == "" {
+		appCnf.StorageSettings.Type = StorageTypeLocal
+	}
+	if appCnf.StorageSettings.S3.Region == "" {
+		appCnf.StorageSettings.S3.Region = "us-east-1"
+	}
+	if appCnf.StorageSettings.PresignedUrlExpiry <= 0 {
+		appCnf.StorageSettings.PresignedUrlExpiry = time.Minute * 10
+	}
+
+	if appCnf.RecorderInfo.PlaybackTokenValidity <= 0 {
+		appCnf.RecorderInfo.PlaybackTokenValidity = time.Hour * 3
+	}
+	if appCnf.RecorderInfo.Retention != nil && appCnf.RecorderInfo.Retention.BatchSize <= 0 {
+		appCnf.RecorderInfo.Retention.BatchSize = 100
+	}
 
 	setLogger()
 	a.readClientFiles()
@@ -311,6 +535,32 @@ func (a *AppConfig) FormatDBTable(table string) string {
 	return table
 }
 
+// AcceptedSecrets will return the secrets which can be used to verify tokens & signatures.
+// The primary secret will always be the first one
+func (c *ClientInfo) AcceptedSecrets() []string {
+	secrets := []string{c.Secret}
+	now := time.Now()
+	for _, cs := range c.Secrets {
+		if cs.Secret == "" || cs.Secret == c.Secret {
+			continue
+		}
+		if cs.AcceptedUntil != nil && now.After(*cs.AcceptedUntil) {
+			continue
+		}
+		secrets = append(secrets, cs.Secret)
+	}
+	return secrets
+}
+
+func (c *ClientInfo) hasSecret(secret string) bool {
+	for _, cs := range c.Secrets {
+		if cs.Secret == secret {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *AppConfig) readClientFiles() {
 	// if enable debug mode, then we won't cache files
 	// otherwise changes of files won't be loaded
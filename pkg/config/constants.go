@@ -16,5 +16,21 @@ const (
 	WaitBeforeAnalyticsStartProcessing       = 40 * time.Second
 	MaxDurationWaitBeforeCleanRoomWebhook    = 1 * time.Minute
 
-	DefaultWebhookQueueSize = 200
+	WebhookDeliveryRequestTimeout = 10 * time.Second
+	WebhookDeliveryAckWait        = 30 * time.Second
+	// WebhookDeadLetterMaxMsgs older dead letters will be removed after it
+	WebhookDeadLetterMaxMsgs = 100000
+
+	MaxScheduledRoomSeriesOccurrences = 366
+
+	DefaultModeratorWaitTimeout = 30 * time.Minute
+
+	DefaultBreakoutRoomReturnCountdown = 60 * time.Second
+
+	DatabaseDriverMysql    = "mysql"
+	DatabaseDriverPostgres = "postgres"
+
+	ChatModerationActionDrop = "drop"
+	ChatModerationActionMask = "mask"
+	ChatModerationActionFlag = "flag"
 )
@@ -17,12 +17,14 @@ var unmarshalOpts = protojson.UnmarshalOptions{
 // AnalyticsController holds the dependencies for analytics-related handlers.
 type AnalyticsController struct {
 	AnalyticsModel *models.AnalyticsModel
+	TenantModel    *models.TenantModel
 }
 
 // NewAnalyticsController creates a new AnalyticsController.
-func NewAnalyticsController(am *models.AnalyticsModel) *AnalyticsController {
+func NewAnalyticsController(am *models.AnalyticsModel, tm *models.TenantModel) *AnalyticsController {
 	return &AnalyticsController{
 		AnalyticsModel: am,
+		TenantModel:    tm,
 	}
 }
 
@@ -53,7 +55,7 @@ func (ac *AnalyticsController) HandleFetchAnalytics(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
-	result, err := ac.AnalyticsModel.FetchAnalytics(req)
+	result, err := ac.AnalyticsModel.FetchAnalytics(req, getTenantId(c))
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
@@ -76,6 +78,10 @@ func (ac *AnalyticsController) HandleDeleteAnalytics(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := ac.TenantModel.CheckAnalyticsAccess(getTenantId(c), req.GetFileId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	err := ac.AnalyticsModel.DeleteAnalytics(req)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
@@ -91,6 +97,10 @@ func (ac *AnalyticsController) HandleGetAnalyticsDownloadToken(c *fiber.Ctx) err
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := ac.TenantModel.CheckAnalyticsAccess(getTenantId(c), req.GetFileId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	token, err := ac.AnalyticsModel.GetAnalyticsDownloadToken(req)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
@@ -111,11 +121,10 @@ func (ac *AnalyticsController) HandleDownloadAnalytics(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).SendString("token require or invalid url")
 	}
 
-	file, status, err := ac.AnalyticsModel.VerifyAnalyticsToken(token)
+	key, status, err := ac.AnalyticsModel.VerifyAnalyticsToken(token)
 	if err != nil {
 		return c.Status(status).SendString(err.Error())
 	}
 
-	c.Attachment(file)
-	return c.SendFile(file, false)
+	return sendStorageFile(c, ac.AnalyticsModel.Storage(), key, false)
 }
@@ -21,41 +21,74 @@ type AuthController struct {
 	AppConfig   *config.AppConfig
 	AuthModel   *models.AuthModel
 	RoomModel   *models.RoomModel
+	TenantModel *models.TenantModel
 	NatsService *natsservice.NatsService
 }
 
 // NewAuthController creates a new AuthController.
-func NewAuthController(config *config.AppConfig, authModel *models.AuthModel, roomModel *models.RoomModel, natsService *natsservice.NatsService) *AuthController {
+func NewAuthController(config *config.AppConfig, authModel *models.AuthModel, roomModel *models.RoomModel, tenantModel *models.TenantModel, natsService *natsservice.NatsService) *AuthController {
 	return &AuthController{
 		AppConfig:   config,
 		AuthModel:   authModel,
 		RoomModel:   roomModel,
+		TenantModel: tenantModel,
 		NatsService: natsService,
 	}
 }
 
 // HandleAuthHeaderCheck is a middleware to check API-KEY & HASH-SIGNATURE.
+// The key can be the main API key or the key of an active tenant.
 func (ac *AuthController) HandleAuthHeaderCheck(c *fiber.Ctx) error {
 	apiKey := c.Get("API-KEY", "")
 	signature := c.Get("HASH-SIGNATURE", "")
 	body := c.Body()
 
-	if apiKey != ac.AppConfig.Client.ApiKey {
+	secrets := ac.AppConfig.Client.AcceptedSecrets()
+	tenantId := ""
+	if apiKey == "" {
 		c.Status(fiber.StatusUnauthorized)
 		return utils.SendCommonProtoJsonResponse(c, false, "invalid API key")
 	}
+	if apiKey != ac.AppConfig.Client.ApiKey {
+		tenant, err := ac.TenantModel.GetActiveTenantByApiKey(apiKey)
+		if err != nil || tenant == nil {
+			c.Status(fiber.StatusUnauthorized)
+			return utils.SendCommonProtoJsonResponse(c, false, "invalid API key")
+		}
+		secrets = []string{tenant.Secret}
+		tenantId = tenant.TenantId
+	}
 	if signature == "" {
 		c.Status(fiber.StatusUnauthorized)
 		return utils.SendCommonProtoJsonResponse(c, false, "hash signature value required")
 	}
 
-	mac := hmac.New(sha256.New, []byte(ac.AppConfig.Client.Secret))
-	mac.Write(body)
-	expectedSignature := hex.EncodeToString(mac.Sum(nil))
-	if subtle.ConstantTimeCompare([]byte(expectedSignature), []byte(signature)) != 1 {
+	verified := false
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		expectedSignature := hex.EncodeToString(mac.Sum(nil))
+		if subtle.ConstantTimeCompare([]byte(expectedSignature), []byte(signature)) == 1 {
+			verified = true
+			break
+		}
+	}
+	if !verified {
 		c.Status(fiber.StatusUnauthorized)
 		return utils.SendCommonProtoJsonResponse(c, false, "can't verify provided information")
 	}
+	c.Locals("tenantId", tenantId)
+
+	return c.Next()
+}
+
+// HandleAuthRequireMainApiKey is a middleware to allow only requests made using the main API key.
+// It must be used after HandleAuthHeaderCheck
+func (ac *AuthController) HandleAuthRequireMainApiKey(c *fiber.Ctx) error {
+	if getTenantId(c) != "" {
+		c.Status(fiber.StatusForbidden)
+		return utils.SendCommonProtoJsonResponse(c, false, "tenant API key isn't allowed for this request")
+	}
 
 	return c.Next()
 }
@@ -95,6 +128,11 @@ func (ac *AuthController) HandleVerifyToken(c *fiber.Ctx) error {
 	if rInfo.MaxParticipants > 0 && roomDbInfo.JoinedParticipants >= int64(rInfo.MaxParticipants) {
 		return utils.SendCommonProtobufResponse(c, false, "notifications.max-num-participates-exceeded")
 	}
+	if roomDbInfo.TenantId != "" {
+		if err := ac.TenantModel.CheckParticipantQuota(roomDbInfo.TenantId); err != nil {
+			return utils.SendCommonProtobufResponse(c, false, err.Error())
+		}
+	}
 
 	v := version.Version
 	rId := roomId.(string)
@@ -10,6 +10,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"google.golang.org/protobuf/encoding/protojson"
 	"net/url"
@@ -103,12 +104,15 @@ func (bc *BBBController) HandleVerifyApiRequest(c *fiber.Ctx) error {
 		queries = strings.TrimSuffix(s3[0], "&")
 	}
 
-	ourSum := bbbapiwrapper.CalculateCheckSum(bc.AppConfig.Client.Secret, method, queries)
-	if subtle.ConstantTimeCompare([]byte(checksum), []byte(ourSum)) != 1 {
-		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "checksumError", "Checksums do not match"))
+	// during secret rotation old secret will be accepted too
+	for _, secret := range bc.AppConfig.Client.AcceptedSecrets() {
+		ourSum := bbbapiwrapper.CalculateCheckSum(secret, method, queries)
+		if subtle.ConstantTimeCompare([]byte(checksum), []byte(ourSum)) == 1 {
+			return c.Next()
+		}
 	}
 
-	return c.Next()
+	return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "checksumError", "Checksums do not match"))
 }
 
 // HandleBBBCreate handles BBB create meeting requests.
@@ -337,7 +341,7 @@ func (bc *BBBController) HandleBBBGetMeetingInfo(c *fiber.Ctx) error {
 
 // HandleBBBGetMeetings handles BBB getMeetings requests.
 func (bc *BBBController) HandleBBBGetMeetings(c *fiber.Ctx) error {
-	_, _, rooms := bc.RoomModel.GetActiveRoomsInfo()
+	_, _, rooms := bc.RoomModel.GetActiveRoomsInfo("")
 
 	if rooms == nil {
 		return c.XML(bbbapiwrapper.CommonResponseMsg("SUCCESS", "noMeetings", "no meetings were found on this server"))
@@ -392,8 +396,11 @@ func (bc *BBBController) HandleBBBGetRecordings(c *fiber.Ctx) error {
 		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "parsingError", "We can not parse request"))
 	}
 
+	// by default, BBB returns both published & unpublished recordings
+	published := bbbRecordingStateFilter(bbbRequestParams(c)["state"])
+
 	host := fmt.Sprintf("%s://%s", c.Protocol(), c.Hostname())
-	recordings, pagination, err := bc.BBBApiWrapperModel.GetRecordings(host, q)
+	recordings, pagination, err := bc.BBBApiWrapperModel.GetRecordings(host, q, published)
 	if err != nil {
 		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "error", err.Error()))
 	}
@@ -436,18 +443,102 @@ func (bc *BBBController) HandleBBBDeleteRecordings(c *fiber.Ctx) error {
 	})
 }
 
-// HandleBBBPublishRecordings TO-DO: in the future
+// HandleBBBPublishRecordings handles BBB publishRecordings requests.
 func (bc *BBBController) HandleBBBPublishRecordings(c *fiber.Ctx) error {
+	q := new(bbbapiwrapper.PublishRecordingsReq)
+	var err error
+	if c.Method() == "POST" && c.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		err = c.BodyParser(q)
+	} else {
+		err = c.QueryParser(q)
+	}
+	if err != nil {
+		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "parsingError", "We can not parse request"))
+	}
+	if q.RecordID == "" {
+		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "missingParamRecordID", "You must specify one or more a recordIDs"))
+	}
+
+	err = bc.RecordingModel.PublishRecordings(strings.Split(q.RecordID, ","), q.Publish)
+	if err != nil {
+		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "notFound", err.Error()))
+	}
+
 	return c.XML(bbbapiwrapper.PublishRecordingsRes{
 		ReturnCode: "SUCCESS",
-		Published:  true,
+		Published:  q.Publish,
 	})
 }
 
-// HandleBBBUpdateRecordings TO-DO: in the future
+// HandleBBBUpdateRecordings handles BBB updateRecordings requests.
+// meta_* params will be stored as the custom meta of the recordings
 func (bc *BBBController) HandleBBBUpdateRecordings(c *fiber.Ctx) error {
+	q := new(bbbapiwrapper.UpdateRecordingsReq)
+	var err error
+	if c.Method() == "POST" && c.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		err = c.BodyParser(q)
+	} else {
+		err = c.QueryParser(q)
+	}
+	if err != nil {
+		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "parsingError", "We can not parse request"))
+	}
+	if q.RecordID == "" {
+		return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "missingParamRecordID", "You must specify one or more a recordIDs"))
+	}
+
+	meta := make(map[string]string)
+	for k, v := range bbbRequestParams(c) {
+		if strings.HasPrefix(k, "meta_") {
+			meta[strings.ToLower(strings.TrimPrefix(k, "meta_"))] = v
+		}
+	}
+
+	for _, id := range strings.Split(q.RecordID, ",") {
+		_, err = bc.RecordingModel.UpdateRecording(&protocol.UpdateRecordingReq{
+			RecordId: id,
+			Meta:     meta,
+		})
+		if err != nil {
+			return c.XML(bbbapiwrapper.CommonResponseMsg("FAILED", "notFound", err.Error()))
+		}
+	}
+
 	return c.XML(bbbapiwrapper.UpdateRecordingsRes{
 		ReturnCode: "SUCCESS",
 		Updated:    true,
 	})
 }
+
+// bbbRequestParams will return all the params of the request
+// from the form body for POST or from the query
+func bbbRequestParams(c *fiber.Ctx) map[string]string {
+	if c.Method() == "POST" && c.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		params := make(map[string]string)
+		c.Request().PostArgs().VisitAll(func(k, v []byte) {
+			params[string(k)] = string(v)
+		})
+		return params
+	}
+	return c.Queries()
+}
+
+// bbbRecordingStateFilter will convert the state param of getRecordings to the published filter.
+// nil means recordings of any state
+func bbbRecordingStateFilter(state string) *bool {
+	var published, unpublished bool
+	for _, s := range strings.Split(state, ",") {
+		switch strings.TrimSpace(s) {
+		case "published":
+			published = true
+		case "unpublished":
+			unpublished = true
+		case "any":
+			return nil
+		}
+	}
+	if published == unpublished {
+		return nil
+	}
+	return &published
+}
@@ -3,7 +3,9 @@ package controllers
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -53,6 +55,84 @@ func (brc *BreakoutRoomController) HandleCreateBreakoutRooms(c *fiber.Ctx) error
 	return sendBreakoutRoomResponse(c, res)
 }
 
+// HandleAutoCreateBreakoutRooms handles creating breakout rooms with server-side user assignment.
+func (brc *BreakoutRoomController) HandleAutoCreateBreakoutRooms(c *fiber.Ctx) error {
+	isAdmin := c.Locals("isAdmin")
+	roomId := c.Locals("roomId")
+	requestedUserId := c.Locals("requestedUserId")
+
+	if isAdmin != true {
+		return utils.SendCommonProtobufResponse(c, false, "only admin can perform this task")
+	}
+
+	req := new(protocol.AutoCreateBreakoutRoomsReq)
+	err := proto.Unmarshal(c.Body(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	req.RoomId = roomId.(string)
+	req.RequestedUserId = requestedUserId.(string)
+	err = brc.BreakoutRoomModel.AutoCreateBreakoutRooms(c.UserContext(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtobufResponse(c, true, "success")
+}
+
+// HandleSelectBreakoutRoom handles a participant picking a breakout room when self-selection is active.
+func (brc *BreakoutRoomController) HandleSelectBreakoutRoom(c *fiber.Ctx) error {
+	roomId := c.Locals("roomId")
+	requestedUserId := c.Locals("requestedUserId")
+
+	req := new(protocol.SelectBreakoutRoomReq)
+	err := proto.Unmarshal(c.Body(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+	if req.BreakoutRoomId == "" {
+		return utils.SendCommonProtobufResponse(c, false, "breakout_room_id is required")
+	}
+
+	req.RoomId = roomId.(string)
+	req.UserId = requestedUserId.(string)
+	token, err := brc.BreakoutRoomModel.SelectBreakoutRoom(c.UserContext(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendProtobufResponse(c, &protocol.SelectBreakoutRoomRes{
+		Status: true,
+		Msg:    "success",
+		Token:  token,
+	})
+}
+
+// HandleReturnToMainRoom handles bringing participants back from all breakout rooms with a countdown.
+func (brc *BreakoutRoomController) HandleReturnToMainRoom(c *fiber.Ctx) error {
+	isAdmin := c.Locals("isAdmin")
+	roomId := c.Locals("roomId")
+
+	if isAdmin != true {
+		return utils.SendCommonProtobufResponse(c, false, "only admin can perform this task")
+	}
+
+	req := new(protocol.ReturnToMainRoomReq)
+	err := proto.Unmarshal(c.Body(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	req.RoomId = roomId.(string)
+	err = brc.BreakoutRoomModel.ReturnToMainRoom(req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtobufResponse(c, true, "success")
+}
+
 // HandleJoinBreakoutRoom handles joining a breakout room.
 func (brc *BreakoutRoomController) HandleJoinBreakoutRoom(c *fiber.Ctx) error {
 	roomId := c.Locals("roomId")
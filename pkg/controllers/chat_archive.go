@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
+	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// ChatArchiveController holds dependencies for chat archive-related handlers.
+type ChatArchiveController struct {
+	ChatArchiveModel *models.ChatArchiveModel
+}
+
+// NewChatArchiveController creates a new ChatArchiveController.
+func NewChatArchiveController(m *models.ChatArchiveModel) *ChatArchiveController {
+	return &ChatArchiveController{
+		ChatArchiveModel: m,
+	}
+}
+
+// HandleFetchChatArchives handles listing archived chat sessions.
+func (cac *ChatArchiveController) HandleFetchChatArchives(c *fiber.Ctx) error {
+	req := new(protocol.FetchChatArchivesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	result, err := cac.ChatArchiveModel.FetchChatArchives(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if result.GetTotalArchives() == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no archive found")
+	}
+
+	r := &protocol.FetchChatArchivesRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleGetChatArchiveDownloadToken generates a download token for a chat archive.
+func (cac *ChatArchiveController) HandleGetChatArchiveDownloadToken(c *fiber.Ctx) error {
+	req := new(protocol.GetChatArchiveDownloadTokenReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	token, err := cac.ChatArchiveModel.GetChatArchiveDownloadToken(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.GetChatArchiveDownloadTokenRes{
+		Status: true,
+		Msg:    "success",
+		Token:  &token,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDownloadChatArchive handles the download of a chat archive file.
+func (cac *ChatArchiveController) HandleDownloadChatArchive(c *fiber.Ctx) error {
+	token := c.Params("token")
+	if len(token) == 0 {
+		return c.Status(fiber.StatusUnauthorized).SendString("token require or invalid url")
+	}
+
+	key, status, err := cac.ChatArchiveModel.VerifyChatArchiveToken(token)
+	if err != nil {
+		return c.Status(status).SendString(err.Error())
+	}
+
+	return sendStorageFile(c, cac.ChatArchiveModel.Storage(), key, false)
+}
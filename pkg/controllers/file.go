@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/gofiber/fiber/v2"
@@ -8,11 +9,16 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
+	"mime"
+	"net/http"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // FileController holds dependencies for file-related handlers.
@@ -112,6 +118,10 @@ func (fc *FileController) HandleDownloadUploadedFile(c *fiber.Ctx) error {
 
 	file := fmt.Sprintf("%s/%s/%s", fc.AppConfig.UploadFileSettings.Path, sid, otherParts)
 	mtype, err := mimetype.DetectFile(file)
+	if err != nil && !storageservice.IsLocal(fc.FileModel.Storage()) {
+		// the file may not be available in this server
+		return sendStorageFile(c, fc.FileModel.Storage(), sid+"/"+otherParts, false)
+	}
 	if err != nil {
 		ms := strings.SplitN(err.Error(), "/", -1)
 		return c.Status(fiber.StatusNotFound).SendString(ms[len(ms)-1])
@@ -189,6 +199,133 @@ func (fc *FileController) HandleGetClientFiles(c *fiber.Ctx) error {
 	})
 }
 
+// sendStorageFile will send the file of the storage as attachment or inline for playback.
+// For object storage, the client will be redirected to a presigned url if enabled,
+// otherwise the file will be streamed through the server.
+// Playback will never be redirected, because the token is verified for every request.
+// Range, ETag & Last-Modified based requests are supported for streaming
+func sendStorageFile(c *fiber.Ctx, st storageservice.Storage, key string, inline bool) error {
+	// keys of the object storage are literal, so we'll clean those here
+	key = strings.TrimPrefix(path.Clean("/"+key), "/")
+	filename := path.Base(key)
+
+	settings := config.GetConfig().StorageSettings
+	if settings.UsePresignedUrl && !inline {
+		u, err := st.PresignedURL(c.UserContext(), key, settings.PresignedUrlExpiry, filename, inline)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		}
+		if u != "" {
+			return c.Redirect(u, fiber.StatusTemporaryRedirect)
+		}
+	}
+
+	info, err := st.Stat(c.UserContext(), key)
+	if err != nil {
+		if errors.Is(err, storageservice.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		}
+		log.Errorln(err)
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	if contentType == "" {
+		contentType = fiber.MIMEOctetStream
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+	c.Set(fiber.HeaderContentDisposition, disposition+"; filename="+strconv.Quote(filename))
+	c.Set(fiber.HeaderAcceptRanges, "bytes")
+	if info.ETag != "" {
+		c.Set(fiber.HeaderETag, info.ETag)
+	}
+	if !info.ModTime.IsZero() {
+		c.Set(fiber.HeaderLastModified, info.ModTime.UTC().Format(http.TimeFormat))
+	}
+
+	if isStorageFileNotModified(c, info) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	offset, length := int64(0), info.Size
+	status := fiber.StatusOK
+	if rg := c.Get(fiber.HeaderRange); rg != "" && isStorageFileIfRangeMatched(c, info) {
+		start, l, err := storageservice.ParseByteRange(rg, info.Size)
+		switch {
+		case err == nil:
+			offset, length = start, l
+			status = fiber.StatusPartialContent
+			c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, start+l-1, info.Size))
+		case errors.Is(err, storageservice.ErrInvalidRange):
+			c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", info.Size))
+			c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+			return c.SendStatus(fiber.StatusRequestedRangeNotSatisfiable)
+		}
+		// otherwise the full file will be sent
+	}
+
+	c.Status(status)
+	if c.Method() == fiber.MethodHead || length == 0 {
+		c.Response().Header.SetContentLength(int(length))
+		c.Response().SkipBody = c.Method() == fiber.MethodHead
+		return nil
+	}
+
+	rd, _, err := st.GetRange(c.UserContext(), key, offset, length)
+	if err != nil {
+		log.Errorln(err)
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+	// the reader will be closed after sending
+	return c.SendStream(rd, int(length))
+}
+
+// isStorageFileNotModified checks If-None-Match & If-Modified-Since headers
+func isStorageFileNotModified(c *fiber.Ctx, info *storageservice.ObjectInfo) bool {
+	if inm := c.Get(fiber.HeaderIfNoneMatch); inm != "" {
+		if info.ETag == "" {
+			return false
+		}
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == info.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := c.Get(fiber.HeaderIfModifiedSince); ims != "" && !info.ModTime.IsZero() {
+		if t, err := http.ParseTime(ims); err == nil {
+			return !info.ModTime.Truncate(time.Second).After(t)
+		}
+	}
+	return false
+}
+
+// isStorageFileIfRangeMatched checks If-Range header,
+// the range will be ignored if the file has changed
+func isStorageFileIfRangeMatched(c *fiber.Ctx, info *storageservice.ObjectInfo) bool {
+	ir := strings.TrimSpace(c.Get(fiber.HeaderIfRange))
+	if ir == "" {
+		return true
+	}
+	if strings.HasPrefix(ir, `"`) {
+		return info.ETag != "" && ir == info.ETag
+	}
+	if t, err := http.ParseTime(ir); err == nil && !info.ModTime.IsZero() {
+		return !info.ModTime.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
 func commonFileErrorResponse(c *fiber.Ctx, msg string, status int) error {
 	if status > 0 {
 		_ = c.SendStatus(status)
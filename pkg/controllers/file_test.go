@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// presignedStorage will return a presigned url like object storage
+type presignedStorage struct {
+	*storageservice.LocalStorage
+}
+
+func (s *presignedStorage) PresignedURL(_ context.Context, key string, _ time.Duration, _ string, _ bool) (string, error) {
+	return "https://storage.example.com/" + key, nil
+}
+
+func setupStorageFileApp(st storageservice.Storage) *fiber.App {
+	app := fiber.New()
+	app.Get("/download/*", func(c *fiber.Ctx) error {
+		return sendStorageFile(c, st, c.Params("*"), false)
+	})
+	app.Get("/playback/*", func(c *fiber.Ctx) error {
+		return sendStorageFile(c, st, c.Params("*"), true)
+	})
+	return app
+}
+
+func TestSendStorageFile(t *testing.T) {
+	st := storageservice.NewLocalStorage(t.TempDir())
+	content := "recording data"
+	if err := st.Put(context.Background(), "sub/file.mp4", strings.NewReader(content), int64(len(content)), "video/mp4"); err != nil {
+		t.Fatal(err)
+	}
+	app := setupStorageFileApp(st)
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/download/sub/file.mp4", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Contains(t, res.Header.Get(fiber.HeaderContentDisposition), "attachment")
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, "recording data", string(body))
+	etag := res.Header.Get(fiber.HeaderETag)
+	assert.NotEmpty(t, etag)
+
+	// 206
+	req := httptest.NewRequest(fiber.MethodGet, "/playback/sub/file.mp4", nil)
+	req.Header.Set(fiber.HeaderRange, "bytes=2-5")
+	res, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusPartialContent, res.StatusCode)
+	assert.Equal(t, "bytes 2-5/14", res.Header.Get(fiber.HeaderContentRange))
+	assert.Contains(t, res.Header.Get(fiber.HeaderContentDisposition), "inline")
+	body, _ = io.ReadAll(res.Body)
+	assert.Equal(t, "cord", string(body))
+
+	// range will be ignored if the file has changed
+	req = httptest.NewRequest(fiber.MethodGet, "/playback/sub/file.mp4", nil)
+	req.Header.Set(fiber.HeaderRange, "bytes=2-5")
+	req.Header.Set(fiber.HeaderIfRange, `"changed"`)
+	res, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	// 304
+	req = httptest.NewRequest(fiber.MethodGet, "/playback/sub/file.mp4", nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, etag)
+	res, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotModified, res.StatusCode)
+
+	// 416
+	req = httptest.NewRequest(fiber.MethodGet, "/playback/sub/file.mp4", nil)
+	req.Header.Set(fiber.HeaderRange, "bytes=100-")
+	res, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusRequestedRangeNotSatisfiable, res.StatusCode)
+	assert.Equal(t, "bytes */14", res.Header.Get(fiber.HeaderContentRange))
+
+	// 404
+	res, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/download/sub/missing.mp4", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, res.StatusCode)
+}
+
+func TestSendStorageFile_PresignedUrl(t *testing.T) {
+	settings := &config.GetConfig().StorageSettings
+	usePresignedUrl := settings.UsePresignedUrl
+	settings.UsePresignedUrl = true
+	t.Cleanup(func() {
+		settings.UsePresignedUrl = usePresignedUrl
+	})
+
+	st := &presignedStorage{LocalStorage: storageservice.NewLocalStorage(t.TempDir())}
+	_ = st.Put(context.Background(), "file.mp4", strings.NewReader("data"), 4, "video/mp4")
+	app := setupStorageFileApp(st)
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/download/file.mp4", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTemporaryRedirect, res.StatusCode)
+	assert.Equal(t, "https://storage.example.com/file.mp4", res.Header.Get(fiber.HeaderLocation))
+
+	// playback token is checked for every request, so it must not be redirected
+	res, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/playback/file.mp4", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, "data", string(body))
+}
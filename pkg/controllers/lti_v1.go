@@ -7,6 +7,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"strings"
 )
 
@@ -164,7 +165,7 @@ func (lc *LtiV1Controller) HandleLTIV1EndRoom(c *fiber.Ctx) error {
 func (lc *LtiV1Controller) HandleLTIV1FetchRecordings(c *fiber.Ctx) error {
 	roomId := c.Locals("roomId")
 
-	req := new(plugnmeet.FetchRecordingsReq)
+	req := new(protocol.FetchRecordingsReq)
 	err := c.BodyParser(req)
 	if err != nil {
 		return c.JSON(fiber.Map{
@@ -174,7 +175,7 @@ func (lc *LtiV1Controller) HandleLTIV1FetchRecordings(c *fiber.Ctx) error {
 	}
 
 	req.RoomIds = []string{roomId.(string)}
-	result, err := lc.RecordingModel.FetchRecordings(req)
+	result, err := lc.RecordingModel.FetchRecordings(req, "")
 
 	if err != nil {
 		return c.JSON(fiber.Map{
@@ -7,6 +7,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"github.com/mynaparrot/plugnmeet-server/version"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
@@ -28,9 +29,10 @@ type NatsController struct {
 	curveKeyPair  nkeys.KeyPair
 	authModel     *models.AuthModel
 	natsModel     *models.NatsModel
+	chatModModel  *models.ChatModerationModel
 }
 
-func NewNatsController(app *config.AppConfig, authModel *models.AuthModel, natsModel *models.NatsModel) *NatsController {
+func NewNatsController(app *config.AppConfig, authModel *models.AuthModel, natsModel *models.NatsModel, chatModModel *models.ChatModerationModel) *NatsController {
 	issuerKeyPair, err := nkeys.FromSeed([]byte(app.NatsInfo.AuthCalloutIssuerPrivate))
 	if err != nil {
 		log.Fatal(err)
@@ -42,6 +44,7 @@ func NewNatsController(app *config.AppConfig, authModel *models.AuthModel, natsM
 		issuerKeyPair: issuerKeyPair,
 		authModel:     authModel,
 		natsModel:     natsModel,
+		chatModModel:  chatModModel,
 	}
 
 	if app.NatsInfo.AuthCalloutXkeyPrivate != nil && *app.NatsInfo.AuthCalloutXkeyPrivate != "" {
@@ -70,6 +73,11 @@ func (c *NatsController) BootUp(wg *sync.WaitGroup) {
 
 	// now subscribe
 	c.subscribeToSystemWorker(stream)
+
+	if (c.app.ChatModerationSettings != nil && c.app.ChatModerationSettings.Enabled) ||
+		(c.app.NatsInfo.RateLimit != nil && c.app.NatsInfo.RateLimit.Enabled) {
+		c.subscribeToIngressWorker()
+	}
 	// subscribe to connection events
 	c.subscribeToUsersConnEvents()
 
@@ -176,3 +184,49 @@ func (c *NatsController) subscribeToSystemWorker(stream jetstream.Stream) {
 		return
 	}
 }
+
+// subscribeToIngressWorker will receive chat & dataChannel messages from clients
+// so that those can be checked before publishing to the room
+func (c *NatsController) subscribeToIngressWorker() {
+	stream, err := c.app.JetStream.CreateOrUpdateStream(c.ctx, jetstream.StreamConfig{
+		Name:      fmt.Sprintf("%s-ingress", c.app.NatsInfo.Subjects.SystemJsWorker),
+		Replicas:  c.app.NatsInfo.NumReplicas,
+		Retention: jetstream.WorkQueuePolicy,
+		Subjects: []string{
+			fmt.Sprintf("%s.*.*.%s", c.app.NatsInfo.Subjects.SystemJsWorker, natsservice.IngressChat),
+			fmt.Sprintf("%s.*.*.%s", c.app.NatsInfo.Subjects.SystemJsWorker, natsservice.IngressDataChannel),
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cons, err := stream.CreateOrUpdateConsumer(c.ctx, jetstream.ConsumerConfig{
+		Durable: fmt.Sprintf("pnm-%s-ingress", c.app.NatsInfo.Subjects.SystemJsWorker),
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	_, err = cons.Consume(func(msg jetstream.Msg) {
+		defer msg.Ack()
+		// messages of the same user should keep the order
+		p := strings.Split(msg.Subject(), ".")
+		if len(p) != 4 {
+			return
+		}
+		switch p[3] {
+		case natsservice.IngressChat:
+			c.chatModModel.HandleChatMessage(p[1], p[2], msg.Data())
+		case natsservice.IngressDataChannel:
+			c.natsModel.HandleDataChannelMessage(p[1], p[2], msg.Data())
+		}
+	}, jetstream.ConsumeErrHandler(func(consumeCtx jetstream.ConsumeContext, err error) {
+		log.Errorln(err)
+	}))
+
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+}
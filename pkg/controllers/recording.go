@@ -5,28 +5,32 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 )
 
 // RecordingController holds dependencies for recording-related handlers.
 type RecordingController struct {
 	RecordingModel *models.RecordingModel
+	TenantModel    *models.TenantModel
 }
 
 // NewRecordingController creates a new RecordingController.
-func NewRecordingController(m *models.RecordingModel) *RecordingController {
+func NewRecordingController(m *models.RecordingModel, tm *models.TenantModel) *RecordingController {
 	return &RecordingController{
 		RecordingModel: m,
+		TenantModel:    tm,
 	}
 }
 
 // HandleFetchRecordings handles fetching recordings.
 func (rc *RecordingController) HandleFetchRecordings(c *fiber.Ctx) error {
-	req := new(plugnmeet.FetchRecordingsReq)
+	// same as plugnmeet.FetchRecordingsReq with include_unpublished
+	req := new(protocol.FetchRecordingsReq)
 	if err := parseAndValidateRequest(c.Body(), req); err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
-	result, err := rc.RecordingModel.FetchRecordings(req)
+	result, err := rc.RecordingModel.FetchRecordings(req, getTenantId(c))
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
@@ -49,6 +53,10 @@ func (rc *RecordingController) HandleRecordingInfo(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	result, err := rc.RecordingModel.RecordingInfo(req)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
@@ -64,6 +72,10 @@ func (rc *RecordingController) HandleDeleteRecording(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	err := rc.RecordingModel.DeleteRecording(req)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
@@ -79,6 +91,10 @@ func (rc *RecordingController) HandleGetDownloadToken(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	token, err := rc.RecordingModel.GetDownloadToken(req)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
@@ -92,6 +108,118 @@ func (rc *RecordingController) HandleGetDownloadToken(c *fiber.Ctx) error {
 	return utils.SendProtoJsonResponse(c, r)
 }
 
+// HandlePublishRecording handles publishing or unpublishing a recording.
+func (rc *RecordingController) HandlePublishRecording(c *fiber.Ctx) error {
+	req := new(protocol.PublishRecordingReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	props, err := rc.RecordingModel.PublishRecording(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.RecordingPropertiesRes{
+		Status:              true,
+		Msg:                 "success",
+		RecordingProperties: props,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleUpdateRecording handles updating title, description & custom meta of a recording.
+func (rc *RecordingController) HandleUpdateRecording(c *fiber.Ctx) error {
+	req := new(protocol.UpdateRecordingReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	props, err := rc.RecordingModel.UpdateRecording(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.RecordingPropertiesRes{
+		Status:              true,
+		Msg:                 "success",
+		RecordingProperties: props,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleRecordingRetentionReport handles listing recordings which are expired by the retention policy
+// without deleting them.
+func (rc *RecordingController) HandleRecordingRetentionReport(c *fiber.Ctx) error {
+	req := new(protocol.RecordingRetentionReportReq)
+	// all the fields are optional, so the body can be empty
+	if len(c.Body()) > 0 {
+		if err := parseAndValidateRequest(c.Body(), req); err != nil {
+			return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+		}
+	}
+
+	result, err := rc.RecordingModel.RecordingRetentionReport(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.RecordingRetentionReportRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleFetchDeletedRecordings handles listing recordings which are in the deleted recordings backup.
+func (rc *RecordingController) HandleFetchDeletedRecordings(c *fiber.Ctx) error {
+	req := new(protocol.FetchDeletedRecordingsReq)
+	// all the fields are optional, so the body can be empty
+	if len(c.Body()) > 0 {
+		if err := parseAndValidateRequest(c.Body(), req); err != nil {
+			return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+		}
+	}
+
+	result, err := rc.RecordingModel.FetchDeletedRecordings(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if result.GetTotalRecordings() == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no recordings found")
+	}
+
+	r := &protocol.FetchDeletedRecordingsRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleRestoreRecording handles restoring a recording from the deleted recordings backup.
+func (rc *RecordingController) HandleRestoreRecording(c *fiber.Ctx) error {
+	req := new(protocol.RestoreRecordingReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rc.RecordingModel.RestoreRecording(req, getTenantId(c)); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
 // HandleDownloadRecording handles downloading a recording file.
 func (rc *RecordingController) HandleDownloadRecording(c *fiber.Ctx) error {
 	token := c.Params("token")
@@ -100,11 +228,53 @@ func (rc *RecordingController) HandleDownloadRecording(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).SendString("token require or invalid url")
 	}
 
-	file, status, err := rc.RecordingModel.VerifyRecordingToken(token)
+	key, status, err := rc.RecordingModel.VerifyRecordingToken(token)
+	if err != nil {
+		return c.Status(status).SendString(err.Error())
+	}
+
+	return sendStorageFile(c, rc.RecordingModel.Storage(), key, false)
+}
+
+// HandleGetPlaybackToken handles generating a token to play a recording in a player.
+func (rc *RecordingController) HandleGetPlaybackToken(c *fiber.Ctx) error {
+	req := new(protocol.GetPlaybackTokenReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rc.TenantModel.CheckRecordingAccess(getTenantId(c), req.GetRecordId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	token, expireAt, err := rc.RecordingModel.GetPlaybackToken(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.GetPlaybackTokenRes{
+		Status:   true,
+		Msg:      "success",
+		Token:    &token,
+		ExpireAt: &expireAt,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandlePlaybackRecording handles streaming a recording for an HTML5 player.
+func (rc *RecordingController) HandlePlaybackRecording(c *fiber.Ctx) error {
+	token := c.Params("token")
+
+	if len(token) == 0 {
+		return c.Status(fiber.StatusUnauthorized).SendString("token require or invalid url")
+	}
+
+	key, status, err := rc.RecordingModel.VerifyPlaybackToken(token)
 	if err != nil {
 		return c.Status(status).SendString(err.Error())
 	}
+	// token will be checked again for every request, so don't let proxies cache it
+	c.Set(fiber.HeaderCacheControl, "private, no-transform")
 
-	c.Attachment(file)
-	return c.SendFile(file, false)
+	return sendStorageFile(c, rc.RecordingModel.Storage(), key, true)
 }
@@ -5,29 +5,43 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"google.golang.org/protobuf/proto"
 )
 
 // RoomController holds dependencies for room-related handlers.
 type RoomController struct {
-	RoomModel *models.RoomModel
+	RoomModel   *models.RoomModel
+	TenantModel *models.TenantModel
 }
 
 // NewRoomController creates a new RoomController.
-func NewRoomController(m *models.RoomModel) *RoomController {
+func NewRoomController(m *models.RoomModel, tm *models.TenantModel) *RoomController {
 	return &RoomController{
-		RoomModel: m,
+		RoomModel:   m,
+		TenantModel: tm,
 	}
 }
 
 // HandleRoomCreate handles creating a new room.
 func (rc *RoomController) HandleRoomCreate(c *fiber.Ctx) error {
-	req := new(plugnmeet.CreateRoomReq)
-	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+	cr := new(protocol.CreateRoomReq)
+	if err := unmarshalOpts.Unmarshal(c.Body(), cr); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if cr.GetTemplateId() != "" && cr.Metadata != nil && cr.Metadata.RoomFeatures == nil {
+		// features will come from the template
+		cr.Metadata.RoomFeatures = new(plugnmeet.RoomCreateFeatures)
+	}
+	if err := validateRequest(cr); err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
-	room, err := rc.RoomModel.CreateRoom(c.UserContext(), req)
+	req, opts := models.SplitCreateRoomReq(cr)
+	// with the template, it's required to know which fields were present in the request
+	opts.RawRequest = c.Body()
+
+	room, err := rc.RoomModel.CreateTenantRoom(c.UserContext(), req, getTenantId(c), opts)
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
@@ -48,6 +62,10 @@ func (rc *RoomController) HandleIsRoomActive(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRoomAccess(getTenantId(c), req.GetRoomId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	res, _, _, _ := rc.RoomModel.IsRoomActive(c.UserContext(), req)
 	return utils.SendProtoJsonResponse(c, res)
 }
@@ -59,6 +77,10 @@ func (rc *RoomController) HandleGetActiveRoomInfo(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRoomAccess(getTenantId(c), req.GetRoomId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	status, msg, res := rc.RoomModel.GetActiveRoomInfo(c.UserContext(), req)
 
 	r := &plugnmeet.GetActiveRoomInfoRes{
@@ -72,7 +94,7 @@ func (rc *RoomController) HandleGetActiveRoomInfo(c *fiber.Ctx) error {
 
 // HandleGetActiveRoomsInfo gets information about all active rooms.
 func (rc *RoomController) HandleGetActiveRoomsInfo(c *fiber.Ctx) error {
-	status, msg, res := rc.RoomModel.GetActiveRoomsInfo()
+	status, msg, res := rc.RoomModel.GetActiveRoomsInfo(getTenantId(c))
 
 	r := &plugnmeet.GetActiveRoomsInfoRes{
 		Status: status,
@@ -90,6 +112,10 @@ func (rc *RoomController) HandleEndRoom(c *fiber.Ctx) error {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
+	if err := rc.TenantModel.CheckRoomAccess(getTenantId(c), req.GetRoomId()); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
 	status, msg := rc.RoomModel.EndRoom(c.UserContext(), req)
 
 	return utils.SendCommonProtoJsonResponse(c, status, msg)
@@ -97,12 +123,12 @@ func (rc *RoomController) HandleEndRoom(c *fiber.Ctx) error {
 
 // HandleFetchPastRooms handles fetching past rooms.
 func (rc *RoomController) HandleFetchPastRooms(c *fiber.Ctx) error {
-	req := new(plugnmeet.FetchPastRoomsReq)
+	req := new(protocol.FetchPastRoomsReq)
 	if err := parseAndValidateRequest(c.Body(), req); err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
 	}
 
-	result, err := rc.RoomModel.FetchPastRooms(req)
+	result, err := rc.RoomModel.FetchPastRooms(req, getTenantId(c))
 
 	if err != nil {
 		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
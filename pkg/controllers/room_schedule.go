@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
+	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// RoomScheduleController holds dependencies for scheduled room related handlers.
+type RoomScheduleController struct {
+	RoomScheduleModel *models.RoomScheduleModel
+}
+
+// NewRoomScheduleController creates a new RoomScheduleController.
+func NewRoomScheduleController(m *models.RoomScheduleModel) *RoomScheduleController {
+	return &RoomScheduleController{
+		RoomScheduleModel: m,
+	}
+}
+
+// HandleCreateScheduledRoom handles scheduling a new room.
+func (rsc *RoomScheduleController) HandleCreateScheduledRoom(c *fiber.Ctx) error {
+	req := new(protocol.ScheduleRoomReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := rsc.RoomScheduleModel.CreateScheduledRoom(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.ScheduleRoomRes{
+		Status:       true,
+		Msg:          "success",
+		ScheduleInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleUpdateScheduledRoom handles updating a pending schedule.
+func (rsc *RoomScheduleController) HandleUpdateScheduledRoom(c *fiber.Ctx) error {
+	req := new(protocol.ScheduleRoomReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := rsc.RoomScheduleModel.UpdateScheduledRoom(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.ScheduleRoomRes{
+		Status:       true,
+		Msg:          "success",
+		ScheduleInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleCancelScheduledRoom handles cancelling a pending schedule.
+func (rsc *RoomScheduleController) HandleCancelScheduledRoom(c *fiber.Ctx) error {
+	req := new(protocol.CancelScheduledRoomReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rsc.RoomScheduleModel.CancelScheduledRoom(req, getTenantId(c)); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
+// HandleFetchScheduledRooms handles listing schedules.
+func (rsc *RoomScheduleController) HandleFetchScheduledRooms(c *fiber.Ctx) error {
+	req := new(protocol.FetchScheduledRoomsReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	result, err := rsc.RoomScheduleModel.FetchScheduledRooms(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if result.GetTotalRooms() == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no schedule found")
+	}
+
+	r := &protocol.FetchScheduledRoomsRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleCreateScheduledRoomSeries handles scheduling a recurring series of rooms.
+func (rsc *RoomScheduleController) HandleCreateScheduledRoomSeries(c *fiber.Ctx) error {
+	req := new(protocol.ScheduleRoomSeriesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := rsc.RoomScheduleModel.CreateScheduledRoomSeries(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.ScheduleRoomSeriesRes{
+		Status:     true,
+		Msg:        "success",
+		SeriesInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleCancelScheduledRoomSeries handles cancelling all pending occurrences of a series.
+func (rsc *RoomScheduleController) HandleCancelScheduledRoomSeries(c *fiber.Ctx) error {
+	req := new(protocol.CancelScheduledRoomSeriesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := rsc.RoomScheduleModel.CancelScheduledRoomSeries(req, getTenantId(c)); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
+	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// RoomTemplateController holds dependencies for room template-related handlers.
+type RoomTemplateController struct {
+	RoomTemplateModel *models.RoomTemplateModel
+}
+
+// NewRoomTemplateController creates a new RoomTemplateController.
+func NewRoomTemplateController(m *models.RoomTemplateModel) *RoomTemplateController {
+	return &RoomTemplateController{
+		RoomTemplateModel: m,
+	}
+}
+
+// HandleCreateRoomTemplate handles creating a new room template.
+func (rtc *RoomTemplateController) HandleCreateRoomTemplate(c *fiber.Ctx) error {
+	req := new(protocol.CreateRoomTemplateReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := rtc.RoomTemplateModel.CreateRoomTemplate(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.RoomTemplateRes{
+		Status:       true,
+		Msg:          "success",
+		TemplateInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleUpdateRoomTemplate handles updating a room template.
+func (rtc *RoomTemplateController) HandleUpdateRoomTemplate(c *fiber.Ctx) error {
+	req := new(protocol.UpdateRoomTemplateReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := rtc.RoomTemplateModel.UpdateRoomTemplate(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.RoomTemplateRes{
+		Status:       true,
+		Msg:          "success",
+		TemplateInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDeleteRoomTemplate handles deleting a room template.
+func (rtc *RoomTemplateController) HandleDeleteRoomTemplate(c *fiber.Ctx) error {
+	req := new(protocol.DeleteRoomTemplateReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	err := rtc.RoomTemplateModel.DeleteRoomTemplate(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
+// HandleFetchRoomTemplates handles listing room templates.
+func (rtc *RoomTemplateController) HandleFetchRoomTemplates(c *fiber.Ctx) error {
+	req := new(protocol.FetchRoomTemplatesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	result, err := rtc.RoomTemplateModel.FetchRoomTemplates(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if result.GetTotalTemplates() == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no template found")
+	}
+
+	r := &protocol.FetchRoomTemplatesRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
+	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// TenantController holds dependencies for tenant-related handlers.
+type TenantController struct {
+	TenantModel *models.TenantModel
+}
+
+// NewTenantController creates a new TenantController.
+func NewTenantController(m *models.TenantModel) *TenantController {
+	return &TenantController{
+		TenantModel: m,
+	}
+}
+
+// getTenantId will return the tenant of the API key used for the request,
+// empty value means the main API key was used
+func getTenantId(c *fiber.Ctx) string {
+	if tenantId, ok := c.Locals("tenantId").(string); ok {
+		return tenantId
+	}
+	return ""
+}
+
+// HandleCreateTenant handles creating a new tenant.
+func (tc *TenantController) HandleCreateTenant(c *fiber.Ctx) error {
+	req := new(protocol.CreateTenantReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := tc.TenantModel.CreateTenant(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.TenantRes{
+		Status:     true,
+		Msg:        "success",
+		TenantInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleUpdateTenant handles updating a tenant.
+func (tc *TenantController) HandleUpdateTenant(c *fiber.Ctx) error {
+	req := new(protocol.UpdateTenantReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := tc.TenantModel.UpdateTenant(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.TenantRes{
+		Status:     true,
+		Msg:        "success",
+		TenantInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDeleteTenant handles deleting a tenant.
+func (tc *TenantController) HandleDeleteTenant(c *fiber.Ctx) error {
+	req := new(protocol.DeleteTenantReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	err := tc.TenantModel.DeleteTenant(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
+// HandleFetchTenants handles fetching all the tenants.
+func (tc *TenantController) HandleFetchTenants(c *fiber.Ctx) error {
+	list, err := tc.TenantModel.FetchTenants()
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if len(list) == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no tenant found")
+	}
+
+	r := &protocol.FetchTenantsRes{
+		Status:  true,
+		Msg:     "success",
+		Tenants: list,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
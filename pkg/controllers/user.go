@@ -50,6 +50,9 @@ func (uc *UserController) HandleGenerateJoinToken(c *fiber.Ctx) error {
 	if ri == nil || ri.ID == 0 {
 		return utils.SendCommonProtoJsonResponse(c, false, "room is not active. create room first")
 	}
+	if tenantId := getTenantId(c); tenantId != "" && ri.TenantId != tenantId {
+		return utils.SendCommonProtoJsonResponse(c, false, "room is not active. create room first")
+	}
 
 	token, err := uc.UserModel.GetPNMJoinToken(c.UserContext(), req)
 	if err != nil {
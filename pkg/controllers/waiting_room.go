@@ -5,6 +5,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -67,3 +68,77 @@ func (wrc *WaitingRoomController) HandleUpdateWaitingRoomMessage(c *fiber.Ctx) e
 
 	return utils.SendCommonProtobufResponse(c, true, "success")
 }
+
+// HandleGetWaitingQueue handles listing users of the waiting room in FIFO order.
+func (wrc *WaitingRoomController) HandleGetWaitingQueue(c *fiber.Ctx) error {
+	roomId := c.Locals("roomId")
+	isAdmin := c.Locals("isAdmin")
+
+	if !isAdmin.(bool) {
+		return utils.SendCommonProtobufResponse(c, false, "only admin can perform this task")
+	}
+
+	queue, err := wrc.WaitingRoomModel.GetWaitingQueue(roomId.(string))
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendProtobufResponse(c, &protocol.GetWaitingQueueRes{
+		Status: true,
+		Msg:    "success",
+		Total:  uint32(len(queue)),
+		Queue:  queue,
+	})
+}
+
+// HandleApproveNextUsers handles approving the first N users of the waiting room.
+func (wrc *WaitingRoomController) HandleApproveNextUsers(c *fiber.Ctx) error {
+	roomId := c.Locals("roomId")
+	isAdmin := c.Locals("isAdmin")
+
+	if !isAdmin.(bool) {
+		return utils.SendCommonProtobufResponse(c, false, "only admin can perform this task")
+	}
+
+	req := new(protocol.ApproveNextWaitingUsersReq)
+	err := proto.Unmarshal(c.Body(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	req.RoomId = roomId.(string)
+	approved, err := wrc.WaitingRoomModel.ApproveNextWaitingUsers(req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendProtobufResponse(c, &protocol.ApproveNextWaitingUsersRes{
+		Status:   true,
+		Msg:      "success",
+		Approved: approved,
+	})
+}
+
+// HandleRejectUsers handles rejecting users from the waiting room.
+func (wrc *WaitingRoomController) HandleRejectUsers(c *fiber.Ctx) error {
+	roomId := c.Locals("roomId")
+	isAdmin := c.Locals("isAdmin")
+
+	if !isAdmin.(bool) {
+		return utils.SendCommonProtobufResponse(c, false, "only admin can perform this task")
+	}
+
+	req := new(protocol.RejectWaitingUsersReq)
+	err := proto.Unmarshal(c.Body(), req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	req.RoomId = roomId.(string)
+	err = wrc.WaitingRoomModel.RejectWaitingUsers(req)
+	if err != nil {
+		return utils.SendCommonProtobufResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtobufResponse(c, true, "success")
+}
@@ -3,7 +3,9 @@ package controllers
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/livekit/protocol/livekit"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
 	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 )
 
 // WebhookController holds dependencies for webhook-related handlers.
@@ -47,3 +49,125 @@ func (wc *WebhookController) HandleWebhook(c *fiber.Ctx) error {
 
 	return c.SendStatus(fiber.StatusOK)
 }
+
+// HandleFetchWebhookDeliveries handles fetching the webhook delivery log.
+func (wc *WebhookController) HandleFetchWebhookDeliveries(c *fiber.Ctx) error {
+	req := new(protocol.FetchWebhookDeliveriesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	list, err := wc.WebhookModel.FetchWebhookDeliveries(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if len(list) == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no info found")
+	}
+
+	r := &protocol.FetchWebhookDeliveriesRes{
+		Status: true,
+		Msg:    "success",
+	}
+	for _, d := range list {
+		r.Deliveries = append(r.Deliveries, models.ToWebhookDeliveryInfo(d))
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleReplayWebhookDeliveries handles sending stored webhook events again.
+func (wc *WebhookController) HandleReplayWebhookDeliveries(c *fiber.Ctx) error {
+	req := new(protocol.ReplayWebhookDeliveriesReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	list, err := wc.WebhookModel.ReplayWebhookDeliveries(req)
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if len(list) == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no delivery found to replay")
+	}
+
+	r := &protocol.ReplayWebhookDeliveriesRes{
+		Status: true,
+		Msg:    "success",
+	}
+	for _, d := range list {
+		r.Deliveries = append(r.Deliveries, models.ToWebhookDeliveryInfo(d))
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleCreateWebhookEndpoint handles registering a new webhook endpoint.
+func (wc *WebhookController) HandleCreateWebhookEndpoint(c *fiber.Ctx) error {
+	req := new(protocol.CreateWebhookEndpointReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := wc.WebhookModel.CreateWebhookEndpoint(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.WebhookEndpointRes{
+		Status:       true,
+		Msg:          "success",
+		EndpointInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleUpdateWebhookEndpoint handles updating a registered webhook endpoint.
+func (wc *WebhookController) HandleUpdateWebhookEndpoint(c *fiber.Ctx) error {
+	req := new(protocol.UpdateWebhookEndpointReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	info, err := wc.WebhookModel.UpdateWebhookEndpoint(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.WebhookEndpointRes{
+		Status:       true,
+		Msg:          "success",
+		EndpointInfo: info,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDeleteWebhookEndpoint handles removing a registered webhook endpoint.
+func (wc *WebhookController) HandleDeleteWebhookEndpoint(c *fiber.Ctx) error {
+	req := new(protocol.DeleteWebhookEndpointReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := wc.WebhookModel.DeleteWebhookEndpoint(req, getTenantId(c)); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
+// HandleFetchWebhookEndpoints handles listing registered webhook endpoints.
+func (wc *WebhookController) HandleFetchWebhookEndpoints(c *fiber.Ctx) error {
+	list, err := wc.WebhookModel.FetchWebhookEndpoints(getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if len(list) == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no endpoint found")
+	}
+
+	r := &protocol.FetchWebhookEndpointsRes{
+		Status:    true,
+		Msg:       "success",
+		Endpoints: list,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-protocol/utils"
+	"github.com/mynaparrot/plugnmeet-server/pkg/models"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+)
+
+// WhiteboardSnapshotController holds dependencies for whiteboard snapshot-related handlers.
+type WhiteboardSnapshotController struct {
+	WhiteboardSnapshotModel *models.WhiteboardSnapshotModel
+}
+
+// NewWhiteboardSnapshotController creates a new WhiteboardSnapshotController.
+func NewWhiteboardSnapshotController(m *models.WhiteboardSnapshotModel) *WhiteboardSnapshotController {
+	return &WhiteboardSnapshotController{
+		WhiteboardSnapshotModel: m,
+	}
+}
+
+// HandleFetchWhiteboardSnapshots handles listing stored whiteboard snapshots.
+func (wsc *WhiteboardSnapshotController) HandleFetchWhiteboardSnapshots(c *fiber.Ctx) error {
+	req := new(protocol.FetchWhiteboardSnapshotsReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	result, err := wsc.WhiteboardSnapshotModel.FetchWhiteboardSnapshots(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+	if result.GetTotalSnapshots() == 0 {
+		return utils.SendCommonProtoJsonResponse(c, false, "no snapshot found")
+	}
+
+	r := &protocol.FetchWhiteboardSnapshotsRes{
+		Status: true,
+		Msg:    "success",
+		Result: result,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDeleteWhiteboardSnapshot handles deleting a whiteboard snapshot.
+func (wsc *WhiteboardSnapshotController) HandleDeleteWhiteboardSnapshot(c *fiber.Ctx) error {
+	req := new(protocol.DeleteWhiteboardSnapshotReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	if err := wsc.WhiteboardSnapshotModel.DeleteWhiteboardSnapshot(req, getTenantId(c)); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	return utils.SendCommonProtoJsonResponse(c, true, "success")
+}
+
+// HandleGetWhiteboardSnapshotDownloadToken generates a download token for a whiteboard snapshot.
+func (wsc *WhiteboardSnapshotController) HandleGetWhiteboardSnapshotDownloadToken(c *fiber.Ctx) error {
+	req := new(protocol.GetWhiteboardSnapshotDownloadTokenReq)
+	if err := parseAndValidateRequest(c.Body(), req); err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	token, err := wsc.WhiteboardSnapshotModel.GetWhiteboardSnapshotDownloadToken(req, getTenantId(c))
+	if err != nil {
+		return utils.SendCommonProtoJsonResponse(c, false, err.Error())
+	}
+
+	r := &protocol.GetWhiteboardSnapshotDownloadTokenRes{
+		Status: true,
+		Msg:    "success",
+		Token:  &token,
+	}
+	return utils.SendProtoJsonResponse(c, r)
+}
+
+// HandleDownloadWhiteboardSnapshot handles exporting a whiteboard snapshot as zip.
+func (wsc *WhiteboardSnapshotController) HandleDownloadWhiteboardSnapshot(c *fiber.Ctx) error {
+	token := c.Params("token")
+	if len(token) == 0 {
+		return c.Status(fiber.StatusUnauthorized).SendString("token require or invalid url")
+	}
+
+	snapshotId, status, err := wsc.WhiteboardSnapshotModel.VerifyWhiteboardSnapshotToken(token)
+	if err != nil {
+		return c.Status(status).SendString(err.Error())
+	}
+
+	c.Attachment(fmt.Sprintf("%s.zip", snapshotId))
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := wsc.WhiteboardSnapshotModel.ExportWhiteboardSnapshot(snapshotId, w); err != nil {
+			log.Errorln(err)
+		}
+		_ = w.Flush()
+	})
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+type ChatArchive struct {
+	ID          uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	ArchiveId   string `gorm:"column:archive_id;unique;NOT NULL"`
+	RoomTableID uint64 `gorm:"column:room_table_id;NOT NULL"`
+	RoomId      string `gorm:"column:room_id;NOT NULL"`
+	RoomSid     string `gorm:"column:room_sid;unique;NOT NULL"`
+	TenantId    string `gorm:"column:tenant_id;NOT NULL"`
+	// FileName without extension, both .json & .txt files will be stored
+	FileName         string    `gorm:"column:file_name;NOT NULL"`
+	FileSize         float64   `gorm:"column:file_size;NOT NULL"`
+	TotalMessages    int64     `gorm:"column:total_messages;default:0;NOT NULL"`
+	RoomCreationTime int64     `gorm:"column:room_creation_time;default:0;NOT NULL"`
+	CreationTime     int64     `gorm:"column:creation_time;autoCreateTime;NOT NULL"`
+	Created          time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+}
+
+func (m *ChatArchive) TableName() string {
+	return config.GetConfig().FormatDBTable("chat_archives")
+}
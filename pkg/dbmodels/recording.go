@@ -3,6 +3,7 @@ package dbmodels
 import (
 	"database/sql"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -10,15 +11,23 @@ type Recording struct {
 	ID               uint64         `gorm:"column:id;primaryKey;autoIncrement"`
 	RecordID         string         `gorm:"column:record_id;unique;NOT NULL"`
 	RoomID           string         `gorm:"column:room_id;NOT NULL"`
+	ParentRoomID     string         `gorm:"column:parent_room_id;NOT NULL"`
 	RoomSid          sql.NullString `gorm:"column:room_sid;unique"`
 	RecorderID       string         `gorm:"column:recorder_id;NOT NULL"`
 	FilePath         string         `gorm:"column:file_path;NOT NULL"`
 	Size             float64        `gorm:"column:size;NOT NULL"`
 	Published        int64          `gorm:"column:published;default:1;NOT NULL"`
+	Title            string         `gorm:"column:title;NOT NULL"`
+	Description      sql.NullString `gorm:"column:description"`
+	Metadata         sql.NullString `gorm:"column:metadata"`
+	RetentionDays    int            `gorm:"column:retention_days;default:0;NOT NULL"`
+	TenantId         string         `gorm:"column:tenant_id;NOT NULL"`
 	CreationTime     int64          `gorm:"column:creation_time;autoCreateTime;NOT NULL"`
 	RoomCreationTime int64          `gorm:"column:room_creation_time;default:0;NOT NULL"`
 	Created          time.Time      `gorm:"column:created;autoCreateTime;NOT NULL"`
 	Modified         time.Time      `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+	// DeletedAt will be set when the file was moved to the backup path, so that it can be restored
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
 }
 
 func (m *Recording) TableName() string {
@@ -0,0 +1,26 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+type RoomTemplate struct {
+	ID         uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	TemplateId string `gorm:"column:template_id;unique;NOT NULL"`
+	TenantId   string `gorm:"column:tenant_id;NOT NULL"`
+	Name       string `gorm:"column:name;NOT NULL"`
+	// RoomFeatures & DefaultLockSettings are stored as protojson
+	RoomFeatures        string `gorm:"column:room_features;NOT NULL"`
+	DefaultLockSettings string `gorm:"column:default_lock_settings;NOT NULL"`
+	// 0 means not set
+	RoomDuration    uint64    `gorm:"column:room_duration;default:0;NOT NULL"`
+	EmptyTimeout    uint32    `gorm:"column:empty_timeout;default:0;NOT NULL"`
+	MaxParticipants uint32    `gorm:"column:max_participants;default:0;NOT NULL"`
+	Created         time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+	Modified        time.Time `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+}
+
+func (m *RoomTemplate) TableName() string {
+	return config.GetConfig().FormatDBTable("room_templates")
+}
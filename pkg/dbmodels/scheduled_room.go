@@ -0,0 +1,34 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+const (
+	ScheduledRoomStatusPending   = 0
+	ScheduledRoomStatusStarted   = 1
+	ScheduledRoomStatusCancelled = 2
+	ScheduledRoomStatusFailed    = 3
+)
+
+type ScheduledRoom struct {
+	ID             uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	ScheduleId     string    `gorm:"column:schedule_id;unique;NOT NULL"`
+	SeriesId       string    `gorm:"column:series_id;NOT NULL"`
+	RoomId         string    `gorm:"column:room_id;NOT NULL"`
+	RoomTitle      string    `gorm:"column:room_title;NOT NULL"`
+	StartAt        int64     `gorm:"column:start_at;NOT NULL"`
+	CreateRoomReq  string    `gorm:"column:create_room_req;NOT NULL"`
+	CreateRoomOpts string    `gorm:"column:create_room_opts;NOT NULL"`
+	Status         int       `gorm:"column:status;default:0;NOT NULL"`
+	RoomSid        string    `gorm:"column:room_sid;NOT NULL"`
+	ErrorMsg       string    `gorm:"column:error_msg;NOT NULL"`
+	TenantId       string    `gorm:"column:tenant_id;NOT NULL"`
+	Created        time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+	Modified       time.Time `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+}
+
+func (m *ScheduledRoom) TableName() string {
+	return config.GetConfig().FormatDBTable("scheduled_rooms")
+}
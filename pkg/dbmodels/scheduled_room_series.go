@@ -0,0 +1,32 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+const (
+	ScheduledRoomSeriesStatusActive    = 0
+	ScheduledRoomSeriesStatusCancelled = 1
+)
+
+type ScheduledRoomSeries struct {
+	ID             uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	SeriesId       string    `gorm:"column:series_id;unique;NOT NULL"`
+	RoomId         string    `gorm:"column:room_id;NOT NULL"`
+	RoomTitle      string    `gorm:"column:room_title;NOT NULL"`
+	RecurrenceRule string    `gorm:"column:recurrence_rule;NOT NULL"`
+	Timezone       string    `gorm:"column:timezone;NOT NULL"`
+	StartAt        int64     `gorm:"column:start_at;NOT NULL"`
+	Occurrences    int       `gorm:"column:occurrences;default:0;NOT NULL"`
+	TenantId       string    `gorm:"column:tenant_id;NOT NULL"`
+	CreateRoomReq  string    `gorm:"column:create_room_req;NOT NULL"`
+	CreateRoomOpts string    `gorm:"column:create_room_opts;NOT NULL"`
+	Status         int       `gorm:"column:status;default:0;NOT NULL"`
+	Created        time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+	Modified       time.Time `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+}
+
+func (m *ScheduledRoomSeries) TableName() string {
+	return config.GetConfig().FormatDBTable("scheduled_room_series")
+}
@@ -0,0 +1,17 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+// SchemaMigration keeps record of the applied migrations
+type SchemaMigration struct {
+	Version   uint64    `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name;size:255;NOT NULL"`
+	AppliedAt time.Time `gorm:"column:applied_at;autoCreateTime;NOT NULL"`
+}
+
+func (m *SchemaMigration) TableName() string {
+	return config.GetConfig().FormatDBTable("schema_migrations")
+}
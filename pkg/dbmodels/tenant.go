@@ -0,0 +1,24 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+type Tenant struct {
+	ID       uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	TenantId string `gorm:"column:tenant_id;unique;NOT NULL"`
+	Name     string `gorm:"column:name;NOT NULL"`
+	ApiKey   string `gorm:"column:api_key;unique;NOT NULL"`
+	Secret   string `gorm:"column:secret;NOT NULL"`
+	// 0 means unlimited
+	MaxConcurrentRooms int       `gorm:"column:max_concurrent_rooms;default:0;NOT NULL"`
+	MaxParticipants    int       `gorm:"column:max_participants;default:0;NOT NULL"`
+	IsActive           int       `gorm:"column:is_active;default:1;NOT NULL"`
+	Created            time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+	Modified           time.Time `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+}
+
+func (m *Tenant) TableName() string {
+	return config.GetConfig().FormatDBTable("tenants")
+}
@@ -0,0 +1,25 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+type WebhookEndpoint struct {
+	ID         uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	EndpointId string `gorm:"column:endpoint_id;unique;NOT NULL"`
+	Name       string `gorm:"column:name;NOT NULL"`
+	Url        string `gorm:"column:url;NOT NULL"`
+	Secret     string `gorm:"column:secret;NOT NULL"`
+	// Events is comma separated lowercase event names, empty value means all events
+	Events   string `gorm:"column:events;NOT NULL"`
+	IsActive int    `gorm:"column:is_active;default:1;NOT NULL"`
+	// TenantId empty value means the endpoint will receive events of all the rooms
+	TenantId string    `gorm:"column:tenant_id;NOT NULL"`
+	Created  time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+	Modified time.Time `gorm:"column:modified;autoUpdateTime;NOT NULL"`
+}
+
+func (m *WebhookEndpoint) TableName() string {
+	return config.GetConfig().FormatDBTable("webhook_endpoints")
+}
@@ -0,0 +1,28 @@
+package dbmodels
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+type WhiteboardSnapshot struct {
+	ID          uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	SnapshotId  string `gorm:"column:snapshot_id;unique;NOT NULL"`
+	RoomTableID uint64 `gorm:"column:room_table_id;NOT NULL"`
+	RoomId      string `gorm:"column:room_id;NOT NULL"`
+	RoomSid     string `gorm:"column:room_sid;unique;NOT NULL"`
+	TenantId    string `gorm:"column:tenant_id;NOT NULL"`
+	// FileId & FileName of the office file which was active on the whiteboard
+	FileId           string    `gorm:"column:file_id;NOT NULL"`
+	FileName         string    `gorm:"column:file_name;NOT NULL"`
+	TotalPages       int       `gorm:"column:total_pages;default:0;NOT NULL"`
+	TotalFiles       int       `gorm:"column:total_files;default:0;NOT NULL"`
+	TotalEvents      int       `gorm:"column:total_events;default:0;NOT NULL"`
+	RoomCreationTime int64     `gorm:"column:room_creation_time;default:0;NOT NULL"`
+	CreationTime     int64     `gorm:"column:creation_time;autoCreateTime;NOT NULL"`
+	Created          time.Time `gorm:"column:created;autoCreateTime;NOT NULL"`
+}
+
+func (m *WhiteboardSnapshot) TableName() string {
+	return config.GetConfig().FormatDBTable("whiteboard_snapshots")
+}
@@ -38,6 +38,7 @@ type ApplicationModels struct {
 	RecorderModel      *models.RecorderModel
 	RecordingModel     *models.RecordingModel
 	RoomModel          *models.RoomModel
+	RoomScheduleModel  *models.RoomScheduleModel
 	SchedulerModel     *models.SchedulerModel
 	SpeechToTextModel  *models.SpeechToTextModel
 	UserModel          *models.UserModel
@@ -47,25 +48,30 @@ type ApplicationModels struct {
 
 // ApplicationControllers holds all the controllers.
 type ApplicationControllers struct {
-	AnalyticsController    *controllers.AnalyticsController
-	AuthController         *controllers.AuthController
-	BBBController          *controllers.BBBController
-	BreakoutRoomController *controllers.BreakoutRoomController
-	EtherpadController     *controllers.EtherpadController
-	ExDisplayController    *controllers.ExDisplayController
-	ExMediaController      *controllers.ExMediaController
-	FileController         *controllers.FileController
-	IngressController      *controllers.IngressController
-	LtiV1Controller        *controllers.LtiV1Controller
-	PollsController        *controllers.PollsController
-	RecorderController     *controllers.RecorderController
-	RecordingController    *controllers.RecordingController
-	RoomController         *controllers.RoomController
-	SpeechToTextController *controllers.SpeechToTextController
-	UserController         *controllers.UserController
-	WaitingRoomController  *controllers.WaitingRoomController
-	WebhookController      *controllers.WebhookController
-	NatsController         *controllers.NatsController
+	AnalyticsController          *controllers.AnalyticsController
+	AuthController               *controllers.AuthController
+	BBBController                *controllers.BBBController
+	BreakoutRoomController       *controllers.BreakoutRoomController
+	ChatArchiveController        *controllers.ChatArchiveController
+	EtherpadController           *controllers.EtherpadController
+	ExDisplayController          *controllers.ExDisplayController
+	ExMediaController            *controllers.ExMediaController
+	FileController               *controllers.FileController
+	IngressController            *controllers.IngressController
+	LtiV1Controller              *controllers.LtiV1Controller
+	PollsController              *controllers.PollsController
+	RecorderController           *controllers.RecorderController
+	RecordingController          *controllers.RecordingController
+	RoomController               *controllers.RoomController
+	RoomScheduleController       *controllers.RoomScheduleController
+	RoomTemplateController       *controllers.RoomTemplateController
+	SpeechToTextController       *controllers.SpeechToTextController
+	TenantController             *controllers.TenantController
+	UserController               *controllers.UserController
+	WaitingRoomController        *controllers.WaitingRoomController
+	WebhookController            *controllers.WebhookController
+	WhiteboardSnapshotController *controllers.WhiteboardSnapshotController
+	NatsController               *controllers.NatsController
 }
 
 // Application is the root struct holding all dependencies.
@@ -88,4 +94,6 @@ func (a *Application) Boot() {
 	wg.Wait()
 	// start scheduler
 	go a.Models.SchedulerModel.StartScheduler()
+	// start sending queued webhooks
+	a.Models.WebhookModel.StartDeliveryWorker()
 }
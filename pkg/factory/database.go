@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"strings"
@@ -12,20 +13,17 @@ import (
 
 func NewDatabaseConnection(appCnf *config.AppConfig) error {
 	info := appCnf.DatabaseInfo
-	charset := "utf8mb4"
-	loc := "UTC"
 
-	if info.Charset != nil && *info.Charset != "" {
-		charset = *info.Charset
+	var dialector gorm.Dialector
+	switch info.DriverName {
+	case config.DatabaseDriverPostgres:
+		dialector = newPostgresDialector(info)
+	case "", config.DatabaseDriverMysql:
+		dialector = newMysqlDialector(info)
+	default:
+		return fmt.Errorf("unsupported database driver: %s", info.DriverName)
 	}
-	if info.Loc != nil && *info.Loc != "" {
-		loc = strings.ReplaceAll(*info.Loc, "/", "%2F")
-	}
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=True&loc=%s", info.Username, info.Password, info.Host, info.Port, info.DBName, charset, loc)
 
-	mysqlCnf := mysql.Config{
-		DSN: dsn, // data source name
-	}
 	cnf := &gorm.Config{}
 
 	if !appCnf.Client.Debug {
@@ -44,7 +42,7 @@ func NewDatabaseConnection(appCnf *config.AppConfig) error {
 		cnf.Logger = logger.Default.LogMode(logger.Info)
 	}
 
-	db, err := gorm.Open(mysql.New(mysqlCnf), cnf)
+	db, err := gorm.Open(dialector, cnf)
 	if err != nil {
 		return err
 	}
@@ -64,6 +62,7 @@ func NewDatabaseConnection(appCnf *config.AppConfig) error {
 	}
 
 	// https://github.com/go-sql-driver/mysql?tab=readme-ov-file#important-settings
+	// same settings are fine for postgres too
 	d.SetConnMaxLifetime(connMaxLifetime)
 	d.SetMaxOpenConns(maxOpenConns)
 	d.SetMaxIdleConns(maxOpenConns)
@@ -71,3 +70,45 @@ func NewDatabaseConnection(appCnf *config.AppConfig) error {
 	appCnf.DB = db
 	return nil
 }
+
+func newMysqlDialector(info config.DatabaseInfo) gorm.Dialector {
+	charset := "utf8mb4"
+	loc := "UTC"
+
+	if info.Charset != nil && *info.Charset != "" {
+		charset = *info.Charset
+	}
+	if info.Loc != nil && *info.Loc != "" {
+		loc = strings.ReplaceAll(*info.Loc, "/", "%2F")
+	}
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=True&loc=%s", info.Username, info.Password, info.Host, info.Port, info.DBName, charset, loc)
+
+	return mysql.New(mysql.Config{
+		DSN: dsn, // data source name
+	})
+}
+
+func newPostgresDialector(info config.DatabaseInfo) gorm.Dialector {
+	loc := "UTC"
+	sslMode := "disable"
+
+	if info.Loc != nil && *info.Loc != "" {
+		loc = *info.Loc
+	}
+	if info.SslMode != nil && *info.SslMode != "" {
+		sslMode = *info.SslMode
+	}
+	// values are quoted, so that they can contain spaces or quotes
+	dsn := fmt.Sprintf("host='%s' port=%d user='%s' password='%s' dbname='%s' sslmode='%s' TimeZone='%s'",
+		escapePostgresDsnValue(info.Host), info.Port, escapePostgresDsnValue(info.Username), escapePostgresDsnValue(info.Password),
+		escapePostgresDsnValue(info.DBName), escapePostgresDsnValue(sslMode), escapePostgresDsnValue(loc))
+
+	return postgres.New(postgres.Config{
+		DSN: dsn,
+	})
+}
+
+func escapePostgresDsnValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	return strings.ReplaceAll(v, "'", `\'`)
+}
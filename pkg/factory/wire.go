@@ -33,6 +33,8 @@ var modelSet = wire.NewSet(
 	models.NewAuthModel,
 	models.NewBBBApiWrapperModel,
 	models.NewBreakoutRoomModel,
+	models.NewChatArchiveModel,
+	models.NewChatModerationModel,
 	models.NewRoomDurationModel,
 	models.NewEtherpadModel,
 	models.NewExDisplayModel,
@@ -45,11 +47,15 @@ var modelSet = wire.NewSet(
 	models.NewRecorderModel,
 	models.NewRecordingModel,
 	models.NewRoomModel,
+	models.NewRoomScheduleModel,
+	models.NewRoomTemplateModel,
 	models.NewSchedulerModel,
 	models.NewSpeechToTextModel,
+	models.NewTenantModel,
 	models.NewUserModel,
 	models.NewWaitingRoomModel,
 	models.NewWebhookModel,
+	models.NewWhiteboardSnapshotModel,
 )
 
 // build the dependency set for controllers
@@ -58,6 +64,7 @@ var controllerSet = wire.NewSet(
 	controllers.NewAuthController,
 	controllers.NewBBBController,
 	controllers.NewBreakoutRoomController,
+	controllers.NewChatArchiveController,
 	controllers.NewEtherpadController,
 	controllers.NewExDisplayController,
 	controllers.NewExMediaController,
@@ -68,10 +75,14 @@ var controllerSet = wire.NewSet(
 	controllers.NewRecorderController,
 	controllers.NewRecordingController,
 	controllers.NewRoomController,
+	controllers.NewRoomScheduleController,
+	controllers.NewRoomTemplateController,
 	controllers.NewSpeechToTextController,
+	controllers.NewTenantController,
 	controllers.NewUserController,
 	controllers.NewWaitingRoomController,
 	controllers.NewWebhookController,
+	controllers.NewWhiteboardSnapshotController,
 	controllers.NewNatsController,
 )
 
@@ -50,6 +50,7 @@ func NewAppFactory(appConfig *config.AppConfig) (*Application, error) {
 	recorderModel := models.NewRecorderModel(appConfig, databaseService, redisService)
 	recordingModel := models.NewRecordingModel(appConfig, databaseService, redisService)
 	roomModel := models.NewRoomModel(appConfig, databaseService, redisService)
+	roomScheduleModel := models.NewRoomScheduleModel(appConfig, databaseService, redisService)
 	schedulerModel := models.NewSchedulerModel(appConfig, databaseService, redisService)
 	speechToTextModel := models.NewSpeechToTextModel(appConfig, databaseService, redisService)
 	userModel := models.NewUserModel(appConfig, databaseService, redisService)
@@ -73,16 +74,20 @@ func NewAppFactory(appConfig *config.AppConfig) (*Application, error) {
 		RecorderModel:      recorderModel,
 		RecordingModel:     recordingModel,
 		RoomModel:          roomModel,
+		RoomScheduleModel:  roomScheduleModel,
 		SchedulerModel:     schedulerModel,
 		SpeechToTextModel:  speechToTextModel,
 		UserModel:          userModel,
 		WaitingRoomModel:   waitingRoomModel,
 		WebhookModel:       webhookModel,
 	}
-	analyticsController := controllers.NewAnalyticsController(analyticsModel)
-	authController := controllers.NewAuthController(appConfig, authModel, roomModel, natsService)
+	tenantModel := models.NewTenantModel(appConfig, databaseService)
+	analyticsController := controllers.NewAnalyticsController(analyticsModel, tenantModel)
+	authController := controllers.NewAuthController(appConfig, authModel, roomModel, tenantModel, natsService)
 	bbbController := controllers.NewBBBController(appConfig, roomModel, userModel, bbbApiWrapperModel, recordingModel, natsService)
 	breakoutRoomController := controllers.NewBreakoutRoomController(breakoutRoomModel)
+	chatArchiveModel := models.NewChatArchiveModel(appConfig, databaseService)
+	chatArchiveController := controllers.NewChatArchiveController(chatArchiveModel)
 	etherpadController := controllers.NewEtherpadController(appConfig, etherpadModel, roomModel, databaseService)
 	exDisplayController := controllers.NewExDisplayController(exDisplayModel)
 	exMediaController := controllers.NewExMediaController(exMediaModel)
@@ -91,33 +96,45 @@ func NewAppFactory(appConfig *config.AppConfig) (*Application, error) {
 	ltiV1Controller := controllers.NewLtiV1Controller(ltiV1Model, roomModel, recordingModel)
 	pollsController := controllers.NewPollsController(pollModel, redisService)
 	recorderController := controllers.NewRecorderController(appConfig, recorderModel, recordingModel, roomModel, databaseService)
-	recordingController := controllers.NewRecordingController(recordingModel)
-	roomController := controllers.NewRoomController(roomModel)
+	recordingController := controllers.NewRecordingController(recordingModel, tenantModel)
+	roomController := controllers.NewRoomController(roomModel, tenantModel)
+	roomScheduleController := controllers.NewRoomScheduleController(roomScheduleModel)
+	roomTemplateModel := models.NewRoomTemplateModel(appConfig, databaseService)
+	roomTemplateController := controllers.NewRoomTemplateController(roomTemplateModel)
 	speechToTextController := controllers.NewSpeechToTextController(speechToTextModel)
+	tenantController := controllers.NewTenantController(tenantModel)
 	userController := controllers.NewUserController(appConfig, userModel, databaseService, natsService)
 	waitingRoomController := controllers.NewWaitingRoomController(waitingRoomModel)
 	webhookController := controllers.NewWebhookController(authModel, webhookModel)
-	natsController := controllers.NewNatsController(appConfig, authModel, natsModel)
+	whiteboardSnapshotModel := models.NewWhiteboardSnapshotModel(appConfig, databaseService)
+	whiteboardSnapshotController := controllers.NewWhiteboardSnapshotController(whiteboardSnapshotModel)
+	chatModerationModel := models.NewChatModerationModel(appConfig, redisService)
+	natsController := controllers.NewNatsController(appConfig, authModel, natsModel, chatModerationModel)
 	applicationControllers := &ApplicationControllers{
-		AnalyticsController:    analyticsController,
-		AuthController:         authController,
-		BBBController:          bbbController,
-		BreakoutRoomController: breakoutRoomController,
-		EtherpadController:     etherpadController,
-		ExDisplayController:    exDisplayController,
-		ExMediaController:      exMediaController,
-		FileController:         fileController,
-		IngressController:      ingressController,
-		LtiV1Controller:        ltiV1Controller,
-		PollsController:        pollsController,
-		RecorderController:     recorderController,
-		RecordingController:    recordingController,
-		RoomController:         roomController,
-		SpeechToTextController: speechToTextController,
-		UserController:         userController,
-		WaitingRoomController:  waitingRoomController,
-		WebhookController:      webhookController,
-		NatsController:         natsController,
+		AnalyticsController:          analyticsController,
+		AuthController:               authController,
+		BBBController:                bbbController,
+		BreakoutRoomController:       breakoutRoomController,
+		ChatArchiveController:        chatArchiveController,
+		EtherpadController:           etherpadController,
+		ExDisplayController:          exDisplayController,
+		ExMediaController:            exMediaController,
+		FileController:               fileController,
+		IngressController:            ingressController,
+		LtiV1Controller:              ltiV1Controller,
+		PollsController:              pollsController,
+		RecorderController:           recorderController,
+		RecordingController:          recordingController,
+		RoomController:               roomController,
+		RoomScheduleController:       roomScheduleController,
+		RoomTemplateController:       roomTemplateController,
+		SpeechToTextController:       speechToTextController,
+		TenantController:             tenantController,
+		UserController:               userController,
+		WaitingRoomController:        waitingRoomController,
+		WebhookController:            webhookController,
+		WhiteboardSnapshotController: whiteboardSnapshotController,
+		NatsController:               natsController,
 	}
 	application := &Application{
 		Services:    applicationServices,
@@ -139,7 +156,7 @@ func provideContext() context.Context {
 var serviceSet = wire.NewSet(dbservice.New, redisservice.New, natsservice.New, livekitservice.New)
 
 // build the dependency set for models
-var modelSet = wire.NewSet(models.NewAnalyticsModel, models.NewAuthModel, models.NewBBBApiWrapperModel, models.NewBreakoutRoomModel, models.NewRoomDurationModel, models.NewEtherpadModel, models.NewExDisplayModel, models.NewExMediaModel, models.NewFileModel, models.NewIngressModel, models.NewLtiV1Model, models.NewNatsModel, models.NewPollModel, models.NewRecorderModel, models.NewRecordingModel, models.NewRoomModel, models.NewSchedulerModel, models.NewSpeechToTextModel, models.NewUserModel, models.NewWaitingRoomModel, models.NewWebhookModel)
+var modelSet = wire.NewSet(models.NewAnalyticsModel, models.NewAuthModel, models.NewBBBApiWrapperModel, models.NewBreakoutRoomModel, models.NewChatArchiveModel, models.NewChatModerationModel, models.NewRoomDurationModel, models.NewEtherpadModel, models.NewExDisplayModel, models.NewExMediaModel, models.NewFileModel, models.NewIngressModel, models.NewLtiV1Model, models.NewNatsModel, models.NewPollModel, models.NewRecorderModel, models.NewRecordingModel, models.NewRoomModel, models.NewRoomScheduleModel, models.NewRoomTemplateModel, models.NewSchedulerModel, models.NewSpeechToTextModel, models.NewTenantModel, models.NewUserModel, models.NewWaitingRoomModel, models.NewWebhookModel, models.NewWhiteboardSnapshotModel)
 
 // build the dependency set for controllers
-var controllerSet = wire.NewSet(controllers.NewAnalyticsController, controllers.NewAuthController, controllers.NewBBBController, controllers.NewBreakoutRoomController, controllers.NewEtherpadController, controllers.NewExDisplayController, controllers.NewExMediaController, controllers.NewFileController, controllers.NewIngressController, controllers.NewLtiV1Controller, controllers.NewPollsController, controllers.NewRecorderController, controllers.NewRecordingController, controllers.NewRoomController, controllers.NewSpeechToTextController, controllers.NewUserController, controllers.NewWaitingRoomController, controllers.NewWebhookController, controllers.NewNatsController)
+var controllerSet = wire.NewSet(controllers.NewAnalyticsController, controllers.NewAuthController, controllers.NewBBBController, controllers.NewBreakoutRoomController, controllers.NewChatArchiveController, controllers.NewEtherpadController, controllers.NewExDisplayController, controllers.NewExMediaController, controllers.NewFileController, controllers.NewIngressController, controllers.NewLtiV1Controller, controllers.NewPollsController, controllers.NewRecorderController, controllers.NewRecordingController, controllers.NewRoomController, controllers.NewRoomScheduleController, controllers.NewRoomTemplateController, controllers.NewSpeechToTextController, controllers.NewTenantController, controllers.NewUserController, controllers.NewWaitingRoomController, controllers.NewWebhookController, controllers.NewWhiteboardSnapshotController, controllers.NewNatsController)
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"bytes"
+	"github.com/goccy/go-json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MergeProtoJson will unmarshal override on top of base, both in protojson format.
+// Unlike proto.Merge, every field present in override will take precedence,
+// even if the value is zero e.g. false
+func MergeProtoJson(base, override []byte, m proto.Message) error {
+	baseObj, err := decodeJsonObject(base)
+	if err != nil {
+		return err
+	}
+	overrideObj, err := decodeJsonObject(override)
+	if err != nil {
+		return err
+	}
+
+	merged := mergeProtoJsonObjects(m.ProtoReflect().Descriptor(), baseObj, overrideObj)
+	marshal, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+
+	op := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	}
+	return op.Unmarshal(marshal, m)
+}
+
+func decodeJsonObject(data []byte) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	if len(bytes.TrimSpace(data)) == 0 {
+		return obj, nil
+	}
+
+	// numbers will be kept as it is, so int64 won't lose precision
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// mergeProtoJsonObjects will use the json name of the field as key,
+// because protojson accepts both the proto name & the json name
+func mergeProtoJsonObjects(md protoreflect.MessageDescriptor, base, override map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		result[protoJsonFieldKey(md, k)] = v
+	}
+
+	for k, v := range override {
+		key := protoJsonFieldKey(md, k)
+		fd := protoJsonField(md, k)
+		if fd != nil && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			baseVal, ok1 := result[key].(map[string]interface{})
+			overrideVal, ok2 := v.(map[string]interface{})
+			if ok1 && ok2 {
+				result[key] = mergeProtoJsonObjects(fd.Message(), baseVal, overrideVal)
+				continue
+			}
+		}
+		result[key] = v
+	}
+
+	return result
+}
+
+func protoJsonField(md protoreflect.MessageDescriptor, key string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(key); fd != nil {
+		return fd
+	}
+	return md.Fields().ByName(protoreflect.Name(key))
+}
+
+func protoJsonFieldKey(md protoreflect.MessageDescriptor, key string) string {
+	if fd := protoJsonField(md, key); fd != nil {
+		return fd.JSONName()
+	}
+	return key
+}
@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"testing"
+)
+
+func TestMergeProtoJson(t *testing.T) {
+	template := []byte(`{"metadata":{"roomFeatures":{"allowWebcams":true,"allowPolls":true,"chatFeatures":{"allowChat":true,"allowFileUpload":true}}}}`)
+	// request uses proto names & only changes some of the fields
+	req := []byte(`{"room_id":"room01","template_id":"tpl01","metadata":{"room_title":"Test","room_features":{"allow_webcams":false,"chat_features":{"allow_file_upload":false}}}}`)
+
+	r := new(plugnmeet.CreateRoomReq)
+	if err := MergeProtoJson(template, req, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetRoomId() != "room01" || r.GetMetadata().GetRoomTitle() != "Test" {
+		t.Errorf("request values should be kept, got %+v", r)
+	}
+	features := r.GetMetadata().GetRoomFeatures()
+	if features.GetAllowWebcams() {
+		t.Error("false in the request should override true of the template")
+	}
+	if !features.GetAllowPolls() {
+		t.Error("template value should be used when absent in the request")
+	}
+	if !features.GetChatFeatures().GetAllowChat() || features.GetChatFeatures().GetAllowFileUpload() {
+		t.Errorf("nested features should be merged, got %+v", features.GetChatFeatures())
+	}
+}
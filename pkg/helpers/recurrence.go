@@ -0,0 +1,195 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RecurrenceFreqDaily   = "DAILY"
+	RecurrenceFreqWeekly  = "WEEKLY"
+	RecurrenceFreqMonthly = "MONTHLY"
+)
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is a subset of RFC 5545 RRULE.
+// Supported parts: FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY (only with WEEKLY), COUNT & UNTIL
+type RecurrenceRule struct {
+	Freq     string
+	Interval int
+	ByDay    []time.Weekday
+	Count    int
+	Until    time.Time
+}
+
+// ParseRecurrenceRule will parse rule like: FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE;COUNT=10
+// UNTIL without Z suffix is a floating time, so it will be parsed in loc, which is UTC if nil
+func ParseRecurrenceRule(rule string, loc *time.Location) (*RecurrenceRule, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, errors.New("empty recurrence rule")
+	}
+
+	r := &RecurrenceRule{
+		Interval: 1,
+	}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid rule part: %s", part)
+		}
+		key, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+
+		switch key {
+		case "FREQ":
+			switch val {
+			case RecurrenceFreqDaily, RecurrenceFreqWeekly, RecurrenceFreqMonthly:
+				r.Freq = val
+			default:
+				return nil, fmt.Errorf("unsupported FREQ: %s", val)
+			}
+		case "INTERVAL":
+			i, err := strconv.Atoi(val)
+			if err != nil || i < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL: %s", val)
+			}
+			r.Interval = i
+		case "COUNT":
+			c, err := strconv.Atoi(val)
+			if err != nil || c < 1 {
+				return nil, fmt.Errorf("invalid COUNT: %s", val)
+			}
+			r.Count = c
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(val, loc)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = until
+		case "BYDAY":
+			for _, d := range strings.Split(val, ",") {
+				wd, ok := recurrenceWeekdays[d]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY: %s", d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part: %s", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if r.Count == 0 && r.Until.IsZero() {
+		return nil, errors.New("either COUNT or UNTIL is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, errors.New("COUNT and UNTIL can't be used together")
+	}
+	if len(r.ByDay) > 0 && r.Freq != RecurrenceFreqWeekly {
+		return nil, errors.New("BYDAY is supported only with WEEKLY")
+	}
+
+	return r, nil
+}
+
+func parseRecurrenceUntil(val string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", val); err == nil {
+		return t, nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t, err := time.ParseInLocation("20060102T150405", val, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", val, loc); err == nil {
+		// whole day should be included
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL: %s", val)
+}
+
+// Occurrences will return start times of all occurrences, beginning with start.
+// Calculation will be done in the location of start, so the wall clock time stays same during DST changes.
+// An error will be returned if the rule produces more than max occurrences.
+func (r *RecurrenceRule) Occurrences(start time.Time, max int) ([]time.Time, error) {
+	var list []time.Time
+	add := func(t time.Time) bool {
+		if t.Before(start) {
+			return true
+		}
+		if !r.Until.IsZero() && t.After(r.Until) {
+			return false
+		}
+		if r.Count > 0 && len(list) >= r.Count {
+			return false
+		}
+		list = append(list, t)
+		return true
+	}
+
+	// safety guard, so that invalid combination can't loop forever
+	for i := 0; i <= max*31; i++ {
+		if len(list) > max {
+			return nil, fmt.Errorf("recurrence rule produces more than %d occurrences", max)
+		}
+
+		switch r.Freq {
+		case RecurrenceFreqDaily:
+			if !add(start.AddDate(0, 0, i*r.Interval)) {
+				return list, nil
+			}
+		case RecurrenceFreqWeekly:
+			if len(r.ByDay) == 0 {
+				if !add(start.AddDate(0, 0, i*7*r.Interval)) {
+					return list, nil
+				}
+				continue
+			}
+			// week starts on Monday
+			offset := (int(start.Weekday()) + 6) % 7
+			weekStart := start.AddDate(0, 0, -offset+i*7*r.Interval)
+			days := make([]int, 0, len(r.ByDay))
+			for _, d := range r.ByDay {
+				days = append(days, (int(d)+6)%7)
+			}
+			sort.Ints(days)
+			for _, d := range days {
+				if !add(weekStart.AddDate(0, 0, d)) {
+					return list, nil
+				}
+			}
+		case RecurrenceFreqMonthly:
+			y, m, _ := start.Date()
+			first := time.Date(y, m+time.Month(i*r.Interval), 1, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+			// skip months which don't have this day, e.g. 31st
+			if first.AddDate(0, 1, -1).Day() < start.Day() {
+				continue
+			}
+			if !add(first.AddDate(0, 0, start.Day()-1)) {
+				return list, nil
+			}
+		}
+	}
+
+	return list, nil
+}
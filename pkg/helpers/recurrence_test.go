@@ -0,0 +1,123 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	valid := []string{
+		"FREQ=DAILY;COUNT=5",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;UNTIL=20260131T000000Z",
+		"FREQ=MONTHLY;UNTIL=20261231",
+	}
+	for _, rule := range valid {
+		if _, err := ParseRecurrenceRule(rule, nil); err != nil {
+			t.Errorf("%s: %s", rule, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"FREQ=YEARLY;COUNT=2",
+		"FREQ=DAILY",
+		"FREQ=DAILY;COUNT=2;UNTIL=20260101",
+		"FREQ=DAILY;BYDAY=MO;COUNT=2",
+		"FREQ=WEEKLY;BYDAY=XX;COUNT=2",
+		"FREQ=DAILY;INTERVAL=0;COUNT=2",
+	}
+	for _, rule := range invalid {
+		if _, err := ParseRecurrenceRule(rule, nil); err == nil {
+			t.Errorf("%s: expected error but got nil", rule)
+		}
+	}
+}
+
+func TestRecurrenceRule_Occurrences(t *testing.T) {
+	// Thursday
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule     string
+		expected []string
+	}{
+		{
+			rule:     "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			expected: []string{"2026-01-01", "2026-01-03", "2026-01-05"},
+		},
+		{
+			rule:     "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=4",
+			expected: []string{"2026-01-01", "2026-01-05", "2026-01-08", "2026-01-12"},
+		},
+		{
+			rule:     "FREQ=WEEKLY;UNTIL=20260115",
+			expected: []string{"2026-01-01", "2026-01-08", "2026-01-15"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;COUNT=3",
+			expected: []string{"2026-01-01", "2026-02-01", "2026-03-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseRecurrenceRule(tt.rule, nil)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		list, err := r.Occurrences(start, 100)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if len(list) != len(tt.expected) {
+			t.Errorf("%s: expected %d occurrences but got %d", tt.rule, len(tt.expected), len(list))
+			continue
+		}
+		for i, o := range list {
+			if o.Format("2006-01-02") != tt.expected[i] || o.Hour() != 10 {
+				t.Errorf("%s: expected %s but got %s", tt.rule, tt.expected[i], o)
+			}
+		}
+	}
+
+	// should skip months without the day
+	r, _ := ParseRecurrenceRule("FREQ=MONTHLY;COUNT=2", nil)
+	list, _ := r.Occurrences(time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC), 100)
+	if len(list) != 2 || list[1].Format("2006-01-02") != "2026-03-31" {
+		t.Errorf("expected 2026-03-31 as second occurrence but got %v", list)
+	}
+
+	// should not exceed max
+	r, _ = ParseRecurrenceRule("FREQ=DAILY;UNTIL=20301231", nil)
+	if _, err := r.Occurrences(start, 10); err == nil {
+		t.Error("expected error for too many occurrences but got nil")
+	}
+}
+
+func TestParseRecurrenceRule_Until(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Dhaka")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		rule     string
+		expected time.Time
+	}{
+		{"FREQ=DAILY;UNTIL=20260115T100000Z", time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)},
+		// floating time is in the timezone of the series
+		{"FREQ=DAILY;UNTIL=20260115T100000", time.Date(2026, 1, 15, 10, 0, 0, 0, loc)},
+		{"FREQ=DAILY;UNTIL=20260115", time.Date(2026, 1, 15, 23, 59, 59, 0, loc)},
+	}
+	for _, tt := range tests {
+		r, err := ParseRecurrenceRule(tt.rule, loc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !r.Until.Equal(tt.expected) {
+			t.Errorf("%s: expected %s but got %s", tt.rule, tt.expected, r.Until)
+		}
+	}
+}
@@ -0,0 +1,539 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/livekit/protocol/auth"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/nats-io/nats.go/jetstream"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	// WebhookDeliveryStatusFailed means the attempt was failed & will be retried
+	WebhookDeliveryStatusFailed = "failed"
+	// WebhookDeliveryStatusDead means all attempts were failed
+	WebhookDeliveryStatusDead = "dead"
+)
+
+// WebhookDelivery is a single event for a single url.
+// Every change of state will be stored in the delivery log
+type WebhookDelivery struct {
+	Id         string `json:"id"`
+	EventId    string `json:"event_id"`
+	Event      string `json:"event"`
+	RoomId     string `json:"room_id"`
+	RoomSid    string `json:"room_sid"`
+	Url        string `json:"url"`
+	EndpointId string `json:"endpoint_id,omitempty"`
+	Payload    string `json:"payload"`
+	Status     string `json:"status"`
+	Attempts   int    `json:"attempts"`
+	HttpStatus int    `json:"http_status,omitempty"`
+	LastError  string `json:"last_error,omitempty"`
+	ReplayOf   string `json:"replay_of,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	UpdatedAt  int64  `json:"updated_at"`
+}
+
+var webhookHttpClient = &http.Client{
+	Timeout: config.WebhookDeliveryRequestTimeout,
+}
+
+var errWebhookEndpointRemoved = errors.New("webhook endpoint was removed or disabled")
+
+type webhookDeliveryTarget struct {
+	url        string
+	endpointId string
+}
+
+// enqueueDelivery will prepare the payload once,
+// so that all the urls & later replays will receive the same event id
+func (w *WebhookNotifier) enqueueDelivery(event *plugnmeet.CommonNotifyEvent, urls []string, tenantId string) error {
+	// make sure the event name is lowercase
+	ev := strings.ToLower(event.GetEvent())
+
+	var targets []webhookDeliveryTarget
+	for _, u := range urls {
+		targets = append(targets, webhookDeliveryTarget{url: u})
+	}
+	targets = append(targets, w.getEndpointTargets(ev, tenantId)...)
+	if len(targets) < 1 {
+		return nil
+	}
+
+	event.Event = &ev
+	if event.CreatedAt == nil {
+		now := time.Now().UTC().Unix()
+		event.CreatedAt = &now
+	}
+	if event.Id == nil {
+		mId := uuid.NewString()
+		event.Id = &mId
+	}
+
+	op := protojson.MarshalOptions{
+		EmitUnpopulated: false,
+		UseProtoNames:   true,
+	}
+	encoded, err := op.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	for _, t := range targets {
+		d := &WebhookDelivery{
+			Id:         uuid.NewString(),
+			EventId:    event.GetId(),
+			Event:      ev,
+			RoomId:     event.GetRoom().GetRoomId(),
+			RoomSid:    event.GetRoom().GetSid(),
+			Url:        t.url,
+			EndpointId: t.endpointId,
+			Payload:    string(encoded),
+			Status:     WebhookDeliveryStatusPending,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		if err := w.publishDelivery(d); err != nil {
+			log.Errorln("failed to queue webhook,", "url:", t.url, "event:", ev, "roomId:", d.RoomId, "sid:", d.RoomSid, "error:", err)
+		}
+	}
+
+	return nil
+}
+
+// getEndpointTargets will return the registered endpoints which have subscribed to the event.
+// Endpoints of a tenant will receive events of the rooms of the same tenant only
+func (w *WebhookNotifier) getEndpointTargets(event, tenantId string) []webhookDeliveryTarget {
+	endpoints, err := w.getActiveEndpoints()
+	if err != nil {
+		log.Errorln(err)
+		return nil
+	}
+
+	var targets []webhookDeliveryTarget
+	for _, ep := range endpoints {
+		if ep.TenantId != "" && ep.TenantId != tenantId {
+			continue
+		}
+		if WebhookEndpointWantsEvent(ep.Events, event) {
+			targets = append(targets, webhookDeliveryTarget{
+				url:        ep.Url,
+				endpointId: ep.EndpointId,
+			})
+		}
+	}
+
+	return targets
+}
+
+// getActiveEndpoints will load the endpoints from the DB only if it isn't cached yet
+func (w *WebhookNotifier) getActiveEndpoints() ([]dbmodels.WebhookEndpoint, error) {
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+
+	if w.endpoints != nil {
+		return w.endpoints, nil
+	}
+
+	endpoints, err := w.ds.GetWebhookEndpoints("", true)
+	if err != nil {
+		return nil, err
+	}
+	if endpoints == nil {
+		endpoints = make([]dbmodels.WebhookEndpoint, 0)
+	}
+	w.endpoints = endpoints
+
+	return endpoints, nil
+}
+
+// WebhookEndpointsChanged should be called after insert, update or delete of any endpoint,
+// so that all the servers of the cluster will reload the endpoints
+func (w *WebhookNotifier) WebhookEndpointsChanged() {
+	w.clearEndpointsCache()
+	if !w.isEnabled {
+		return
+	}
+	if err := w.natsService.PublishWebhookEndpointsChanged(); err != nil {
+		log.Errorln(err)
+	}
+}
+
+func (w *WebhookNotifier) clearEndpointsCache() {
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+	w.endpoints = nil
+}
+
+func (w *WebhookNotifier) subscribeEndpointsChanged() {
+	_, err := w.natsService.SubscribeWebhookEndpointsChanged(w.clearEndpointsCache)
+	if err != nil {
+		log.Errorln("failed to subscribe webhook endpoints changes:", err)
+	}
+}
+
+// WebhookEndpointWantsEvent will check if the event exists in the comma separated events,
+// empty events means the endpoint wants everything
+func WebhookEndpointWantsEvent(events, event string) bool {
+	if events == "" {
+		return true
+	}
+	for _, e := range strings.Split(events, ",") {
+		if strings.EqualFold(strings.TrimSpace(e), event) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayWebhookDelivery will put the same payload in the queue again as a new delivery
+func (w *WebhookNotifier) ReplayWebhookDelivery(old *WebhookDelivery) (*WebhookDelivery, error) {
+	now := time.Now().Unix()
+	d := &WebhookDelivery{
+		Id:         uuid.NewString(),
+		EventId:    old.EventId,
+		Event:      old.Event,
+		RoomId:     old.RoomId,
+		RoomSid:    old.RoomSid,
+		Url:        old.Url,
+		EndpointId: old.EndpointId,
+		Payload:    old.Payload,
+		Status:     WebhookDeliveryStatusPending,
+		ReplayOf:   old.Id,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	err := w.publishDelivery(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (w *WebhookNotifier) publishDelivery(d *WebhookDelivery) error {
+	marshal, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	err = w.natsService.PublishWebhookDelivery(d.Id, marshal)
+	if err != nil {
+		return err
+	}
+	w.logDelivery(d)
+
+	return nil
+}
+
+func (w *WebhookNotifier) logDelivery(d *WebhookDelivery) {
+	marshal, err := json.Marshal(d)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	err = w.natsService.AddWebhookDeliveryLog(d.RoomSid, d.Status, d.Id, marshal)
+	if err != nil {
+		log.Errorln(err)
+	}
+}
+
+// createDeliveryStreams will create the streams, so that every server can queue the deliveries
+func (w *WebhookNotifier) createDeliveryStreams() {
+	stream, err := w.natsService.CreateWebhookDeliveryStreams(w.deliveryConf.LogMaxAge, w.deliveryConf.DeadLetterMaxAge)
+	if err != nil {
+		log.Errorln("failed to create webhook delivery streams:", err)
+		return
+	}
+	w.deliveryStream = stream
+}
+
+// StartDeliveryWorker will start consuming the delivery queue.
+// As it's a work queue, every delivery will be handled by only one server of the cluster
+func (w *WebhookNotifier) StartDeliveryWorker() {
+	if !w.isEnabled || w.deliveryStream == nil {
+		return
+	}
+
+	cons, err := w.deliveryStream.CreateOrUpdateConsumer(context.Background(), jetstream.ConsumerConfig{
+		Durable:   natsservice.WebhookDeliveryStream,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   config.WebhookDeliveryAckWait,
+		// one more delivery to store the dead letter after the last attempt,
+		// otherwise a broken message will be redelivered forever
+		MaxDeliver: w.deliveryConf.MaxAttempts + 1,
+		BackOff:    calculateWebhookRedeliveryBackoff(w.deliveryConf.MaxAttempts+1, config.WebhookDeliveryAckWait, w.deliveryConf.InitialBackoff, w.deliveryConf.MaxBackoff),
+	})
+	if err != nil {
+		log.Errorln("failed to create webhook delivery consumer:", err)
+		return
+	}
+
+	_, err = cons.Consume(w.dispatchDeliveryMsg, jetstream.ConsumeErrHandler(func(consumeCtx jetstream.ConsumeContext, err error) {
+		log.Errorln(err)
+	}))
+	if err != nil {
+		log.Errorln("failed to consume webhook delivery queue:", err)
+	}
+}
+
+type webhookDeliveryMsg struct {
+	msg jetstream.Msg
+	d   *WebhookDelivery
+}
+
+// dispatchDeliveryMsg will add the message in the queue of the room & url.
+// Deliveries of the same room will be sent to the url one by one to keep the order,
+// different rooms or urls will be handled in parallel
+func (w *WebhookNotifier) dispatchDeliveryMsg(msg jetstream.Msg) {
+	d := new(WebhookDelivery)
+	if err := json.Unmarshal(msg.Data(), d); err != nil {
+		log.Errorln(err)
+		// invalid message, no need to retry
+		_ = msg.Term()
+		return
+	}
+
+	key := d.RoomSid
+	if key == "" {
+		key = d.RoomId
+	}
+	// an unavailable url should not block the others
+	key = d.Url + "|" + key
+
+	w.roomQueuesLock.Lock()
+	defer w.roomQueuesLock.Unlock()
+
+	queue, running := w.roomQueues[key]
+	w.roomQueues[key] = append(queue, &webhookDeliveryMsg{msg: msg, d: d})
+	if !running {
+		go w.processRoomDeliveries(key)
+	}
+}
+
+// processRoomDeliveries will send the deliveries of the queue one by one.
+// The head of the queue will be kept until it was delivered or moved to the dead-letter store,
+// so a failed delivery will block the next ones
+func (w *WebhookNotifier) processRoomDeliveries(key string) {
+	for {
+		w.roomQueuesLock.Lock()
+		queue := w.roomQueues[key]
+		if len(queue) == 0 {
+			delete(w.roomQueues, key)
+			w.roomQueuesLock.Unlock()
+			return
+		}
+		head := queue[0]
+		w.roomQueuesLock.Unlock()
+
+		w.deliverQueueHead(key, head)
+
+		w.roomQueuesLock.Lock()
+		w.roomQueues[key] = w.roomQueues[key][1:]
+		w.roomQueuesLock.Unlock()
+	}
+}
+
+// deliverQueueHead will retry the delivery in this server with backoff,
+// otherwise the next deliveries of the queue would be sent before it
+func (w *WebhookNotifier) deliverQueueHead(key string, head *webhookDeliveryMsg) {
+	meta, err := head.msg.Metadata()
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	// NumDelivered will be more than 1 only if the message was redelivered,
+	// e.g. the server which was working on it has stopped
+	attempt := int(meta.NumDelivered)
+	for {
+		// waiting messages should not be redelivered to another server
+		w.markQueueInProgress(key)
+
+		retryAfter, retry := w.handleDeliveryMsg(head.msg, head.d, attempt)
+		if !retry {
+			return
+		}
+		w.waitForRetry(key, retryAfter)
+		attempt++
+	}
+}
+
+// waitForRetry will wait for the delay & keep the messages of the queue in progress
+func (w *WebhookNotifier) waitForRetry(key string, delay time.Duration) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	ticker := time.NewTicker(config.WebhookDeliveryAckWait / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return
+		case <-ticker.C:
+			w.markQueueInProgress(key)
+		}
+	}
+}
+
+func (w *WebhookNotifier) markQueueInProgress(key string) {
+	w.roomQueuesLock.Lock()
+	defer w.roomQueuesLock.Unlock()
+	for _, m := range w.roomQueues[key] {
+		_ = m.msg.InProgress()
+	}
+}
+
+// handleDeliveryMsg will make the attempt of the delivery.
+// It will return the delay & true if the delivery should be attempted again
+func (w *WebhookNotifier) handleDeliveryMsg(msg jetstream.Msg, d *WebhookDelivery, attempt int) (time.Duration, bool) {
+	d.Attempts = attempt
+
+	statusCode, err := w.sendDeliveryRequest(d)
+	d.HttpStatus = statusCode
+	d.UpdatedAt = time.Now().Unix()
+
+	if err == nil {
+		d.Status = WebhookDeliveryStatusDelivered
+		d.LastError = ""
+		w.logDelivery(d)
+		if d.Attempts > 1 {
+			// it was delivered, so it should not be replayed as failed
+			if err := w.natsService.DeleteWebhookDeliveryLogs(d.RoomSid, WebhookDeliveryStatusFailed, d.Id); err != nil {
+				log.Errorln(err)
+			}
+		}
+		if w.app.Client.Debug {
+			log.Println("webhook sent for event:", d.Event, "roomID:", d.RoomId, "sid:", d.RoomSid, "to URL:", d.Url, "with http response code:", statusCode)
+		}
+		_ = msg.Ack()
+		return 0, false
+	}
+
+	d.LastError = err.Error()
+	log.Errorln("failed to send webhook,", "url:", d.Url, "event:", d.Event, "roomId:", d.RoomId, "sid:", d.RoomSid, "attempt:", d.Attempts, "error:", err)
+
+	// no need to retry if the endpoint doesn't exist anymore
+	if d.Attempts >= w.deliveryConf.MaxAttempts || errors.Is(err, errWebhookEndpointRemoved) {
+		d.Status = WebhookDeliveryStatusDead
+		marshal, err := json.Marshal(d)
+		if err == nil {
+			err = w.natsService.AddWebhookDeadLetter(d.RoomSid, d.Id, marshal)
+		}
+		if err != nil {
+			log.Errorln(err)
+			if d.Attempts <= w.deliveryConf.MaxAttempts {
+				// we'll try again to store the dead letter
+				return w.deliveryConf.MaxBackoff, true
+			}
+			_ = msg.Term()
+			return 0, false
+		}
+		w.logDelivery(d)
+		_ = msg.Ack()
+		return 0, false
+	}
+
+	d.Status = WebhookDeliveryStatusFailed
+	w.logDelivery(d)
+	return calculateWebhookBackoff(d.Attempts, w.deliveryConf.InitialBackoff, w.deliveryConf.MaxBackoff), true
+}
+
+// sendDeliveryRequest will sign the payload in the same way livekit does,
+// registered endpoints will be signed using their own secret.
+// Any non 2xx response will be considered as failed
+func (w *WebhookNotifier) sendDeliveryRequest(d *WebhookDelivery) (int, error) {
+	secret := w.app.Client.Secret
+	if d.EndpointId != "" {
+		ep, err := w.ds.GetWebhookEndpoint(d.EndpointId)
+		if err != nil {
+			return 0, err
+		}
+		if ep == nil || ep.IsActive != 1 {
+			return 0, errWebhookEndpointRemoved
+		}
+		secret = ep.Secret
+	}
+
+	payload := []byte(d.Payload)
+	sum := sha256.Sum256(payload)
+	b64 := base64.StdEncoding.EncodeToString(sum[:])
+
+	at := auth.NewAccessToken(w.app.Client.ApiKey, secret).
+		SetValidFor(5 * time.Minute).
+		SetSha256(b64)
+	token, err := at.ToJWT()
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := http.NewRequest(http.MethodPost, d.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	r.Header.Set("Authorization", token)
+	// in various Apache modules will strip the Authorization header,
+	// so we'll use additional one
+	r.Header.Set("Hash-Token", token)
+	r.Header.Set("content-type", "application/webhook+json")
+
+	res, err := webhookHttpClient.Do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected http response: %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
+
+// calculateWebhookBackoff will return exponential delay for the next attempt
+func calculateWebhookBackoff(attempt int, initial, max time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+// calculateWebhookRedeliveryBackoff will return the delays for redelivery
+// when the server didn't acknowledge the message in time,
+// delay can't be less than the ack wait, otherwise it will be redelivered during processing
+func calculateWebhookRedeliveryBackoff(maxDeliver int, ackWait, initial, max time.Duration) []time.Duration {
+	backoff := make([]time.Duration, 0, maxDeliver)
+	for i := 1; i <= maxDeliver; i++ {
+		backoff = append(backoff, ackWait+calculateWebhookBackoff(i, initial, max))
+	}
+	return backoff
+}
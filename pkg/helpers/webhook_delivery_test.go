@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+func TestCalculateWebhookBackoff(t *testing.T) {
+	initial := time.Second * 5
+	max := time.Minute
+
+	tests := map[int]time.Duration{
+		1:  time.Second * 5,
+		2:  time.Second * 10,
+		3:  time.Second * 20,
+		4:  time.Second * 40,
+		5:  time.Minute,
+		20: time.Minute,
+	}
+
+	for attempt, expected := range tests {
+		if d := calculateWebhookBackoff(attempt, initial, max); d != expected {
+			t.Errorf("attempt %d: expected %s but got %s", attempt, expected, d)
+		}
+	}
+}
+
+func TestWebhookEndpointWantsEvent(t *testing.T) {
+	if !WebhookEndpointWantsEvent("", "room_started") {
+		t.Error("empty events should match everything")
+	}
+	if !WebhookEndpointWantsEvent("room_started, RECORDING_PROCEEDED", "recording_proceeded") {
+		t.Error("expected recording_proceeded to match")
+	}
+	if WebhookEndpointWantsEvent("room_started,room_finished", "participant_joined") {
+		t.Error("expected participant_joined not to match")
+	}
+}
+
+func TestCalculateWebhookRedeliveryBackoff(t *testing.T) {
+	backoff := calculateWebhookRedeliveryBackoff(3, time.Second*30, time.Second*5, time.Second*15)
+	expected := []time.Duration{time.Second * 35, time.Second * 40, time.Second * 45}
+
+	if len(backoff) != len(expected) {
+		t.Fatalf("expected %d delays but got %d", len(expected), len(backoff))
+	}
+	for i, d := range expected {
+		if backoff[i] != d {
+			t.Errorf("delivery %d: expected %s but got %s", i+1, d, backoff[i])
+		}
+	}
+}
+
+func TestGetEndpointTargets(t *testing.T) {
+	// cached endpoints will be used, so no DB is required
+	w := &WebhookNotifier{
+		endpoints: []dbmodels.WebhookEndpoint{
+			{EndpointId: "global", Url: "http://localhost/global"},
+			{EndpointId: "tenant-a", Url: "http://localhost/a", TenantId: "a"},
+			{EndpointId: "tenant-b", Url: "http://localhost/b", TenantId: "b", Events: "room_finished"},
+		},
+	}
+
+	tests := []struct {
+		event    string
+		tenantId string
+		expected []string
+	}{
+		{"room_started", "", []string{"global"}},
+		{"room_started", "a", []string{"global", "tenant-a"}},
+		{"room_started", "b", []string{"global"}},
+		{"room_finished", "b", []string{"global", "tenant-b"}},
+	}
+
+	for _, tt := range tests {
+		var ids []string
+		for _, target := range w.getEndpointTargets(tt.event, tt.tenantId) {
+			ids = append(ids, target.endpointId)
+		}
+		if len(ids) != len(tt.expected) {
+			t.Errorf("event %s, tenant %q: expected %v but got %v", tt.event, tt.tenantId, tt.expected, ids)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != tt.expected[i] {
+				t.Errorf("event %s, tenant %q: expected %v but got %v", tt.event, tt.tenantId, tt.expected, ids)
+				break
+			}
+		}
+	}
+
+	// cache will be loaded again after any change
+	w.clearEndpointsCache()
+	if w.endpoints != nil {
+		t.Error("expected empty cache after clearing")
+	}
+}
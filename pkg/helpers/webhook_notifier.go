@@ -3,12 +3,12 @@ package helpers
 import (
 	"github.com/goccy/go-json"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
-	"github.com/mynaparrot/plugnmeet-protocol/webhook"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	log "github.com/sirupsen/logrus"
 	"sync"
 	"time"
@@ -22,15 +22,20 @@ type WebhookNotifier struct {
 	isEnabled            bool
 	enabledForPerMeeting bool
 	defaultUrl           string
-	// notifiers will hold a queue for each room, local to this server instance
-	notifiers map[string]*webhook.Notifier
-	// mu will protect access to the notifiers map
-	mu sync.Mutex
+	deliveryConf         config.WebhookDeliveryConf
+	deliveryStream       jetstream.Stream
+	roomQueuesLock       sync.Mutex
+	roomQueues           map[string][]*webhookDeliveryMsg
+	// endpoints is the cache of active registered endpoints, nil means not loaded yet
+	endpointsLock sync.Mutex
+	endpoints     []dbmodels.WebhookEndpoint
 }
 
 type webhookRedisFields struct {
 	Urls            []string `json:"urls"`
 	PerformDeleting bool     `json:"perform_deleting"`
+	// TenantId of the room, so that only endpoints of the tenant will receive the events
+	TenantId string `json:"tenant_id,omitempty"`
 }
 
 func newWebhookNotifier(app *config.AppConfig) *WebhookNotifier {
@@ -41,55 +46,17 @@ func newWebhookNotifier(app *config.AppConfig) *WebhookNotifier {
 		isEnabled:            app.Client.WebhookConf.Enable,
 		enabledForPerMeeting: app.Client.WebhookConf.EnableForPerMeeting,
 		defaultUrl:           app.Client.WebhookConf.Url,
-		notifiers:            make(map[string]*webhook.Notifier),
+		deliveryConf:         app.Client.WebhookConf.Delivery,
+		roomQueues:           make(map[string][]*webhookDeliveryMsg),
 	}
 
-	// Subscribe to the cleanup broadcast channel for clustered environments.
-	w.subscribeToCleanup()
-
-	return w
-}
-
-// subscribeToCleanup listens for cleanup messages broadcast to all servers.
-func (w *WebhookNotifier) subscribeToCleanup() {
-	_, err := w.app.NatsConn.Subscribe(natsservice.WebhookCleanupSubject, func(m *nats.Msg) {
-		roomId := string(m.Data)
-		log.Infof("received cleanup signal for room: %s", roomId)
-		w.cleanupNotifier(roomId)
-	})
-	if err != nil {
-		log.Errorf("failed to subscribe to webhook cleanup subject: %v", err)
+	if w.isEnabled {
+		// every server of the cluster will work on the same queue
+		w.createDeliveryStreams()
+		w.subscribeEndpointsChanged()
 	}
-}
-
-// getOrCreateNotifier returns a dedicated notifier for a given room.
-// If one doesn't exist, it creates and stores it.
-func (w *WebhookNotifier) getOrCreateNotifier(roomId string) *webhook.Notifier {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-
-	if notifier, ok := w.notifiers[roomId]; ok {
-		return notifier
-	}
-
-	// Create a new notifier for this room
-	notifier := webhook.NewNotifier(config.DefaultWebhookQueueSize, w.app.Client.Debug, config.GetLogger())
-	w.notifiers[roomId] = notifier
-	log.Infof("created new webhook queue for room: %s", roomId)
 
-	return notifier
-}
-
-// cleanupNotifier stops the worker and removes the notifier for a room from the local map.
-func (w *WebhookNotifier) cleanupNotifier(roomId string) {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-
-	if notifier, ok := w.notifiers[roomId]; ok {
-		notifier.Kill() // This will call Kill() on the worker.
-		delete(w.notifiers, roomId)
-		log.Infof("cleaned up webhook queue for room: %s", roomId)
-	}
+	return w
 }
 
 func (w *WebhookNotifier) RegisterWebhook(roomId, sid string) {
@@ -102,20 +69,21 @@ func (w *WebhookNotifier) RegisterWebhook(roomId, sid string) {
 		urls = append(urls, w.defaultUrl)
 	}
 
-	if w.enabledForPerMeeting {
-		roomInfo, _ := w.ds.GetRoomInfoBySid(sid, nil)
-		if roomInfo != nil && roomInfo.WebhookUrl != "" {
+	var tenantId string
+	roomInfo, _ := w.ds.GetRoomInfoBySid(sid, nil)
+	if roomInfo != nil {
+		tenantId = roomInfo.TenantId
+		if w.enabledForPerMeeting && roomInfo.WebhookUrl != "" {
 			urls = append(urls, roomInfo.WebhookUrl)
 		}
 	}
 
-	if len(urls) < 1 {
-		return
-	}
-
+	// we'll save even without any url,
+	// because registered endpoints may want events of this room
 	d := &webhookRedisFields{
 		Urls:            urls,
 		PerformDeleting: false,
+		TenantId:        tenantId,
 	}
 
 	err := w.saveData(roomId, d)
@@ -143,13 +111,6 @@ func (w *WebhookNotifier) DeleteWebhook(roomId string) error {
 		return nil
 	}
 
-	// Broadcast a cleanup message to all servers in the cluster.
-	// Only the server running the worker for this room will act on it.
-	err = w.app.NatsConn.Publish(natsservice.WebhookCleanupSubject, []byte(roomId))
-	if err != nil {
-		log.Errorf("failed to publish webhook cleanup for room %s: %v", roomId, err)
-	}
-
 	return w.natsService.DeleteWebhookData(roomId)
 }
 
@@ -188,13 +149,10 @@ func (w *WebhookNotifier) SendWebhookEvent(event *plugnmeet.CommonNotifyEvent) e
 		}
 	}
 
-	// Use the dedicated notifier for this room
-	notifier := w.getOrCreateNotifier(roomId)
-	notifier.AddInNotifyQueue(event, w.app.Client.ApiKey, w.app.Client.Secret, d.Urls)
-	return nil
+	return w.enqueueDelivery(event, d.Urls, d.TenantId)
 }
 
-// ForceToPutInQueue sends a webhook event synchronously without using the room's queue.
+// ForceToPutInQueue puts a webhook event in the delivery queue without checking the room's webhook data.
 // This method should be used for one-shot events outside the normal room lifecycle.
 // It directly queries the database for webhook URLs.
 func (w *WebhookNotifier) ForceToPutInQueue(event *plugnmeet.CommonNotifyEvent) {
@@ -211,20 +169,45 @@ func (w *WebhookNotifier) ForceToPutInQueue(event *plugnmeet.CommonNotifyEvent)
 		urls = append(urls, w.defaultUrl)
 	}
 
-	if w.enabledForPerMeeting {
-		roomInfo, _ := w.ds.GetRoomInfoBySid(event.Room.GetSid(), nil)
-		if roomInfo != nil && roomInfo.WebhookUrl != "" {
+	var tenantId string
+	roomInfo, _ := w.ds.GetRoomInfoBySid(event.Room.GetSid(), nil)
+	if roomInfo != nil {
+		tenantId = roomInfo.TenantId
+		if w.enabledForPerMeeting && roomInfo.WebhookUrl != "" {
 			urls = append(urls, roomInfo.WebhookUrl)
 		}
 	}
 
-	if len(urls) < 1 {
+	err := w.enqueueDelivery(event, urls, tenantId)
+	if err != nil {
+		log.Errorln(err)
+	}
+}
+
+// ForceToPutInQueueWithUrl works like ForceToPutInQueue but for events
+// which do not belong to any room session yet, e.g. scheduled rooms.
+// Per meeting url & tenant will be used from the provided values instead of DB.
+func (w *WebhookNotifier) ForceToPutInQueueWithUrl(event *plugnmeet.CommonNotifyEvent, perMeetingUrl, tenantId string) {
+	if !w.isEnabled {
+		return
+	}
+	if event.Room.GetRoomId() == "" {
+		log.Errorln("empty room info for", event.GetEvent())
 		return
 	}
 
-	notifier := webhook.NewNotifier(config.DefaultWebhookQueueSize, w.app.Client.Debug, config.GetLogger())
-	defer notifier.StopGracefully()
-	notifier.AddInNotifyQueue(event, w.app.Client.ApiKey, w.app.Client.Secret, urls)
+	var urls []string
+	if w.defaultUrl != "" {
+		urls = append(urls, w.defaultUrl)
+	}
+	if w.enabledForPerMeeting && perMeetingUrl != "" {
+		urls = append(urls, perMeetingUrl)
+	}
+
+	err := w.enqueueDelivery(event, urls, tenantId)
+	if err != nil {
+		log.Errorln(err)
+	}
 }
 
 func (w *WebhookNotifier) saveData(roomId string, d *webhookRedisFields) error {
@@ -0,0 +1,329 @@
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// every driver has its own directory with files in the format:
+// {version}_{name}.up.sql & {version}_{name}.down.sql
+//
+//go:embed mysql/*.sql postgres/*.sql
+var migrationFiles embed.FS
+
+// tablePrefixPlaceholder will be replaced by database_info.prefix
+const tablePrefixPlaceholder = "{{prefix}}"
+
+const migrationLockName = "plugnmeet_schema_migrations"
+const migrationLockTimeout = 60 * time.Second
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	driver     string
+	prefix     string
+	migrations []*Migration
+}
+
+func New(app *config.AppConfig) (*Migrator, error) {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if app.DB == nil {
+		return nil, errors.New("database connection isn't ready")
+	}
+
+	driver := app.DatabaseInfo.DriverName
+	if driver == "" {
+		driver = config.DatabaseDriverMysql
+	}
+
+	list, err := loadMigrations(migrationFiles, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         app.DB,
+		driver:     driver,
+		prefix:     app.DatabaseInfo.Prefix,
+		migrations: list,
+	}, nil
+}
+
+// Up will apply all the pending migrations & return the number of applied migrations
+func (m *Migrator) Up() (int, error) {
+	applied := 0
+	err := m.withLock(func(tx *gorm.DB) error {
+		done, err := m.appliedVersions(tx)
+		if err != nil {
+			return err
+		}
+
+		for _, mg := range m.migrations {
+			if _, ok := done[mg.Version]; ok {
+				continue
+			}
+			log.Infoln(fmt.Sprintf("applying migration %d_%s", mg.Version, mg.Name))
+			if err := m.exec(tx, mg.Up); err != nil {
+				return fmt.Errorf("migration %d_%s failed: %s", mg.Version, mg.Name, err.Error())
+			}
+			if err := tx.Create(&dbmodels.SchemaMigration{Version: mg.Version, Name: mg.Name}).Error; err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down will roll back the latest applied migrations
+func (m *Migrator) Down(steps int) (int, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	reverted := 0
+	err := m.withLock(func(tx *gorm.DB) error {
+		done, err := m.appliedVersions(tx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			mg := m.migrations[i]
+			if _, ok := done[mg.Version]; !ok {
+				continue
+			}
+			log.Infoln(fmt.Sprintf("reverting migration %d_%s", mg.Version, mg.Name))
+			if err := m.exec(tx, mg.Down); err != nil {
+				return fmt.Errorf("migration %d_%s failed: %s", mg.Version, mg.Name, err.Error())
+			}
+			if err := tx.Where("version = ?", mg.Version).Delete(&dbmodels.SchemaMigration{}).Error; err != nil {
+				return err
+			}
+			reverted++
+		}
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status will return all the known migrations with their state
+func (m *Migrator) Status() ([]*MigrationStatus, error) {
+	if err := m.db.AutoMigrate(&dbmodels.SchemaMigration{}); err != nil {
+		return nil, err
+	}
+	done, err := m.appliedVersions(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*MigrationStatus
+	for _, mg := range m.migrations {
+		st := &MigrationStatus{
+			Version: mg.Version,
+			Name:    mg.Name,
+		}
+		if sm, ok := done[mg.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = &sm.AppliedAt
+		}
+		list = append(list, st)
+	}
+
+	return list, nil
+}
+
+func (m *Migrator) appliedVersions(tx *gorm.DB) (map[uint64]dbmodels.SchemaMigration, error) {
+	var rows []dbmodels.SchemaMigration
+	if err := tx.Order("version ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	done := make(map[uint64]dbmodels.SchemaMigration, len(rows))
+	for _, r := range rows {
+		done[r.Version] = r
+	}
+	return done, nil
+}
+
+func (m *Migrator) exec(tx *gorm.DB, content string) error {
+	content = strings.ReplaceAll(content, tablePrefixPlaceholder, m.prefix)
+	// mysql driver doesn't allow multiple statements in a single query
+	for _, stmt := range splitStatements(content) {
+		if err := tx.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withLock will make sure that only one server of the cluster runs migrations at the same time.
+// Database level lock belongs to the connection, so the same connection will be used for everything
+func (m *Migrator) withLock(fn func(tx *gorm.DB) error) error {
+	return m.db.Connection(func(tx *gorm.DB) error {
+		var unlock string
+		switch m.driver {
+		case config.DatabaseDriverPostgres:
+			if err := tx.Exec("SELECT pg_advisory_lock(hashtext(?))", migrationLockName).Error; err != nil {
+				return err
+			}
+			unlock = "SELECT pg_advisory_unlock(hashtext(?))"
+		default:
+			var locked int
+			if err := tx.Raw("SELECT GET_LOCK(?, ?)", migrationLockName, int(migrationLockTimeout.Seconds())).Scan(&locked).Error; err != nil {
+				return err
+			}
+			if locked != 1 {
+				return errors.New("timeout waiting for the migration lock")
+			}
+			unlock = "SELECT RELEASE_LOCK(?)"
+		}
+		defer func() {
+			if err := tx.Exec(unlock, migrationLockName).Error; err != nil {
+				log.Errorln(err)
+			}
+		}()
+
+		if err := tx.AutoMigrate(&dbmodels.SchemaMigration{}); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+func loadMigrations(fsys fs.FS, driver string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, driver)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations found for database driver: %s", driver)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		version, name, direction, err := parseMigrationFileName(e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(driver, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &Migration{Version: version, Name: name}
+			byVersion[version] = mg
+		} else if mg.Name != name {
+			return nil, fmt.Errorf("duplicate migration version: %d", version)
+		}
+
+		if direction == "up" {
+			mg.Up = string(content)
+		} else {
+			mg.Down = string(content)
+		}
+	}
+
+	list := make([]*Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s doesn't have up file", mg.Version, mg.Name)
+		}
+		list = append(list, mg)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version < list[j].Version
+	})
+
+	return list, nil
+}
+
+// parseMigrationFileName will parse 0001_initial_schema.up.sql
+func parseMigrationFileName(fileName string) (uint64, string, string, error) {
+	base, ok := strings.CutSuffix(fileName, ".sql")
+	if !ok {
+		return 0, "", "", fmt.Errorf("invalid migration file: %s", fileName)
+	}
+
+	direction := ""
+	if b, ok := strings.CutSuffix(base, ".up"); ok {
+		base, direction = b, "up"
+	} else if b, ok := strings.CutSuffix(base, ".down"); ok {
+		base, direction = b, "down"
+	} else {
+		return 0, "", "", fmt.Errorf("invalid migration file: %s", fileName)
+	}
+
+	v, name, ok := strings.Cut(base, "_")
+	if !ok || name == "" {
+		return 0, "", "", fmt.Errorf("invalid migration file: %s", fileName)
+	}
+	version, err := strconv.ParseUint(v, 10, 64)
+	if err != nil || version == 0 {
+		return 0, "", "", fmt.Errorf("invalid migration version: %s", fileName)
+	}
+
+	return version, name, direction, nil
+}
+
+// splitStatements will split the content by semicolon at the end of line.
+// Comments & empty statements will be ignored
+func splitStatements(content string) []string {
+	var statements []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			stmt := strings.TrimSuffix(strings.TrimSpace(current.String()), ";")
+			if stmt != "" {
+				statements = append(statements, stmt)
+			}
+			current.Reset()
+		}
+	}
+
+	if stmt := strings.TrimSpace(current.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+
+	return statements
+}
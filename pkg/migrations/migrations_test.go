@@ -0,0 +1,115 @@
+package migrations
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseMigrationFileName(t *testing.T) {
+	version, name, direction, err := parseMigrationFileName("0002_add_chat_archive.down.sql")
+	if err != nil {
+		t.Error(err)
+	}
+	if version != 2 || name != "add_chat_archive" || direction != "down" {
+		t.Errorf("unexpected result, version: %d, name: %s, direction: %s", version, name, direction)
+	}
+
+	for _, f := range []string{"0001_initial.sql", "initial.up.sql", "0000_initial.up.sql", "0001_.up.sql", "0001_initial.up.txt"} {
+		if _, _, _, err := parseMigrationFileName(f); err == nil {
+			t.Errorf("expected error for %s", f)
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	content := `-- comment
+CREATE TABLE a (
+  id int
+);
+
+CREATE INDEX a_id ON a (id);
+DROP TABLE b`
+
+	statements := splitStatements(content)
+	if len(statements) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(statements))
+	}
+	if statements[1] != "CREATE INDEX a_id ON a (id)" {
+		t.Errorf("unexpected statement: %s", statements[1])
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"mysql/0002_second.up.sql":    {Data: []byte("SELECT 2;")},
+		"mysql/0001_first.up.sql":     {Data: []byte("SELECT 1;")},
+		"mysql/0001_first.down.sql":   {Data: []byte("SELECT -1;")},
+		"postgres/0001_only.up.sql":   {Data: []byte("SELECT 1;")},
+		"postgres/0002_only.down.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	list, err := loadMigrations(fsys, "mysql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Version != 1 || list[1].Version != 2 {
+		t.Errorf("migrations should be sorted by version, got %+v", list)
+	}
+	if list[0].Down != "SELECT -1;" {
+		t.Errorf("unexpected down content: %s", list[0].Down)
+	}
+
+	// down without up must not be accepted
+	if _, err = loadMigrations(fsys, "postgres"); err == nil {
+		t.Error("expected error for migration without up file")
+	}
+
+	if _, err = loadMigrations(fsys, "sqlite"); err == nil {
+		t.Error("expected error for unknown driver")
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	for _, driver := range []string{"mysql", "postgres"} {
+		list, err := loadMigrations(migrationFiles, driver)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if len(list) == 0 {
+			t.Errorf("no migrations found for %s", driver)
+		}
+		for _, mg := range list {
+			if mg.Down == "" {
+				t.Errorf("%s migration %d_%s doesn't have down file", driver, mg.Version, mg.Name)
+			}
+		}
+	}
+}
+
+// install dumps already contain the full schema,
+// so they must mark every migration as applied
+func TestInstallDumpsHaveAllMigrations(t *testing.T) {
+	dumps := map[string]string{
+		"mysql":    "../../sql_dump/install.sql",
+		"postgres": "../../sql_dump/install_postgres.sql",
+	}
+	for driver, file := range dumps {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list, err := loadMigrations(migrationFiles, driver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, mg := range list {
+			if !strings.Contains(string(content), fmt.Sprintf("(%d, '%s')", mg.Version, mg.Name)) {
+				t.Errorf("%s doesn't contain migration %d_%s", file, mg.Version, mg.Name)
+			}
+		}
+	}
+}
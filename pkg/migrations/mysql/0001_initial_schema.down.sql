@@ -0,0 +1,3 @@
+-- Tables of the initial schema may have been created before migrations were introduced,
+-- so they won't be dropped here to avoid losing existing data.
+-- Drop them manually if you really want to remove everything.
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `{{prefix}}scheduled_rooms` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `schedule_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `start_at` int(11) NOT NULL,
+  `create_room_req` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `status` int(1) NOT NULL DEFAULT 0,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `error_msg` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `schedule_id` (`schedule_id`),
+  KEY `room_id` (`room_id`),
+  KEY `status_start_at` (`status`, `start_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
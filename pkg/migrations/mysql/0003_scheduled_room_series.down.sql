@@ -0,0 +1,9 @@
+ALTER TABLE `{{prefix}}scheduled_rooms`
+  DROP KEY `series_id`,
+  DROP COLUMN `series_id`;
+
+ALTER TABLE `{{prefix}}room_info`
+  DROP KEY `series_id`,
+  DROP COLUMN `series_id`;
+
+DROP TABLE IF EXISTS `{{prefix}}scheduled_room_series`;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS `{{prefix}}scheduled_room_series` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `recurrence_rule` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `timezone` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'UTC',
+  `start_at` int(11) NOT NULL,
+  `occurrences` int(10) NOT NULL DEFAULT 0,
+  `create_room_req` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `status` int(1) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series_id` (`series_id`),
+  KEY `room_id` (`room_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE `{{prefix}}room_info`
+  ADD COLUMN `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `parent_room_id`,
+  ADD KEY `series_id` (`series_id`);
+
+ALTER TABLE `{{prefix}}scheduled_rooms`
+  ADD COLUMN `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `schedule_id`,
+  ADD KEY `series_id` (`series_id`);
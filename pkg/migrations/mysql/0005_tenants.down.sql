@@ -0,0 +1,20 @@
+ALTER TABLE `{{prefix}}scheduled_room_series`
+  DROP COLUMN `tenant_id`;
+
+ALTER TABLE `{{prefix}}scheduled_rooms`
+  DROP KEY `tenant_id`,
+  DROP COLUMN `tenant_id`;
+
+ALTER TABLE `{{prefix}}room_analytics`
+  DROP KEY `tenant_id`,
+  DROP COLUMN `tenant_id`;
+
+ALTER TABLE `{{prefix}}recordings`
+  DROP KEY `tenant_id`,
+  DROP COLUMN `tenant_id`;
+
+ALTER TABLE `{{prefix}}room_info`
+  DROP KEY `tenant_id_is_running`,
+  DROP COLUMN `tenant_id`;
+
+DROP TABLE IF EXISTS `{{prefix}}tenants`;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS `{{prefix}}tenants` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `api_key` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `secret` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `max_concurrent_rooms` int(10) NOT NULL DEFAULT 0,
+  `max_participants` int(10) NOT NULL DEFAULT 0,
+  `is_active` int(1) NOT NULL DEFAULT 1,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tenant_id` (`tenant_id`),
+  UNIQUE KEY `api_key` (`api_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE `{{prefix}}room_info`
+  ADD COLUMN `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `series_id`,
+  ADD KEY `tenant_id_is_running` (`tenant_id`, `is_running`);
+
+ALTER TABLE `{{prefix}}recordings`
+  ADD COLUMN `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `published`,
+  ADD KEY `tenant_id` (`tenant_id`);
+
+ALTER TABLE `{{prefix}}room_analytics`
+  ADD COLUMN `tenant_id` varchar(64) NOT NULL DEFAULT '' AFTER `creation_time`,
+  ADD KEY `tenant_id` (`tenant_id`);
+
+ALTER TABLE `{{prefix}}scheduled_rooms`
+  ADD COLUMN `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `error_msg`,
+  ADD KEY `tenant_id` (`tenant_id`);
+
+ALTER TABLE `{{prefix}}scheduled_room_series`
+  ADD COLUMN `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `occurrences`;
@@ -0,0 +1,2 @@
+ALTER TABLE `{{prefix}}room_info`
+  ALTER COLUMN `ended` SET DEFAULT '0000-00-00 00:00:00';
@@ -0,0 +1,2 @@
+ALTER TABLE `{{prefix}}room_info`
+  ALTER COLUMN `ended` SET DEFAULT '1970-01-01 00:00:00';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `{{prefix}}room_templates` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `template_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `room_features` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `default_lock_settings` text COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_duration` int(10) NOT NULL DEFAULT 0,
+  `empty_timeout` int(10) NOT NULL DEFAULT 0,
+  `max_participants` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `template_id` (`template_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `{{prefix}}chat_archives`;
+ALTER TABLE `{{prefix}}room_info` DROP COLUMN `chat_archive`;
@@ -0,0 +1,21 @@
+ALTER TABLE `{{prefix}}room_info` ADD COLUMN `chat_archive` int(1) NOT NULL DEFAULT 0 AFTER `tenant_id`;
+
+CREATE TABLE IF NOT EXISTS `{{prefix}}chat_archives` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `archive_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_table_id` int(11) NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `file_size` double NOT NULL DEFAULT 0,
+  `total_messages` int(10) NOT NULL DEFAULT 0,
+  `room_creation_time` int(10) NOT NULL DEFAULT 0,
+  `creation_time` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `archive_id` (`archive_id`),
+  UNIQUE KEY `room_sid` (`room_sid`),
+  KEY `room_id` (`room_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `{{prefix}}whiteboard_snapshots` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `snapshot_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_table_id` int(11) NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `total_pages` int(10) NOT NULL DEFAULT 0,
+  `total_files` int(10) NOT NULL DEFAULT 0,
+  `total_events` int(10) NOT NULL DEFAULT 0,
+  `room_creation_time` int(10) NOT NULL DEFAULT 0,
+  `creation_time` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `snapshot_id` (`snapshot_id`),
+  UNIQUE KEY `room_sid` (`room_sid`),
+  KEY `room_id` (`room_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
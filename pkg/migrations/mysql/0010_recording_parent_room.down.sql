@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}recordings`
+  DROP KEY `parent_room_id`,
+  DROP COLUMN `parent_room_id`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}recordings`
+  ADD COLUMN `parent_room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `room_id`,
+  ADD KEY `parent_room_id` (`parent_room_id`);
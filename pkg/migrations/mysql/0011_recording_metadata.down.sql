@@ -0,0 +1,4 @@
+ALTER TABLE `{{prefix}}recordings`
+  DROP COLUMN `metadata`,
+  DROP COLUMN `description`,
+  DROP COLUMN `title`;
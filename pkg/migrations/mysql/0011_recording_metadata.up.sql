@@ -0,0 +1,4 @@
+ALTER TABLE `{{prefix}}recordings`
+  ADD COLUMN `title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `published`,
+  ADD COLUMN `description` text COLLATE utf8mb4_unicode_ci DEFAULT NULL AFTER `title`,
+  ADD COLUMN `metadata` text COLLATE utf8mb4_unicode_ci DEFAULT NULL AFTER `description`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}recordings` DROP COLUMN `retention_days`;
+
+ALTER TABLE `{{prefix}}room_info` DROP COLUMN `recording_retention_days`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}room_info` ADD COLUMN `recording_retention_days` int(10) NOT NULL DEFAULT 0 AFTER `chat_archive`;
+
+ALTER TABLE `{{prefix}}recordings` ADD COLUMN `retention_days` int(10) NOT NULL DEFAULT 0 AFTER `metadata`;
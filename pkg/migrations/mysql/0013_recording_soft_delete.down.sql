@@ -0,0 +1,5 @@
+DELETE FROM `{{prefix}}recordings` WHERE `deleted_at` IS NOT NULL;
+
+ALTER TABLE `{{prefix}}recordings`
+  DROP KEY `deleted_at`,
+  DROP COLUMN `deleted_at`;
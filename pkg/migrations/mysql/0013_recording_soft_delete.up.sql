@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}recordings`
+  ADD COLUMN `deleted_at` datetime DEFAULT NULL AFTER `modified`,
+  ADD KEY `deleted_at` (`deleted_at`);
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}scheduled_room_series` DROP COLUMN `create_room_opts`;
+
+ALTER TABLE `{{prefix}}scheduled_rooms` DROP COLUMN `create_room_opts`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}scheduled_rooms` ADD COLUMN `create_room_opts` varchar(1000) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `create_room_req`;
+
+ALTER TABLE `{{prefix}}scheduled_room_series` ADD COLUMN `create_room_opts` varchar(1000) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `create_room_req`;
@@ -0,0 +1,5 @@
+ALTER TABLE `{{prefix}}room_info` ADD COLUMN `recording_retention_days` int(10) NOT NULL DEFAULT 0 AFTER `chat_archive`;
+
+UPDATE `{{prefix}}room_info` SET `recording_retention_days` = COALESCE(JSON_EXTRACT(`metadata`, '$.recording_retention_days'), 0) WHERE `metadata` IS NOT NULL;
+
+ALTER TABLE `{{prefix}}room_info` DROP COLUMN `metadata`;
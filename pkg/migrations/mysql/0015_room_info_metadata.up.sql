@@ -0,0 +1,5 @@
+ALTER TABLE `{{prefix}}room_info` ADD COLUMN `metadata` text COLLATE utf8mb4_unicode_ci DEFAULT NULL AFTER `chat_archive`;
+
+UPDATE `{{prefix}}room_info` SET `metadata` = CONCAT('{"recording_retention_days":', `recording_retention_days`, '}') WHERE `recording_retention_days` <> 0;
+
+ALTER TABLE `{{prefix}}room_info` DROP COLUMN `recording_retention_days`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}webhook_endpoints`
+  DROP KEY `tenant_id`,
+  DROP COLUMN `tenant_id`;
@@ -0,0 +1,3 @@
+ALTER TABLE `{{prefix}}webhook_endpoints`
+  ADD COLUMN `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' AFTER `is_active`,
+  ADD KEY `tenant_id` (`tenant_id`);
@@ -0,0 +1,62 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}room_info (
+  id bigserial NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  "roomId" varchar(64) NOT NULL,
+  sid varchar(64) NOT NULL,
+  joined_participants integer NOT NULL DEFAULT 0,
+  is_running smallint NOT NULL DEFAULT 0,
+  is_recording smallint NOT NULL DEFAULT 0,
+  recorder_id varchar(36) NOT NULL DEFAULT '',
+  is_active_rtmp smallint NOT NULL DEFAULT 0,
+  rtmp_node_id varchar(36) NOT NULL DEFAULT '',
+  webhook_url varchar(255) NOT NULL DEFAULT '',
+  is_breakout_room smallint NOT NULL DEFAULT 0,
+  parent_room_id varchar(64) NOT NULL DEFAULT '',
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  ended timestamp NOT NULL DEFAULT '1970-01-01 00:00:00',
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}room_info_sid UNIQUE (sid)
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}room_info_roomid ON {{prefix}}room_info ("roomId");
+CREATE INDEX IF NOT EXISTS {{prefix}}room_info_is_running_roomid ON {{prefix}}room_info (is_running, "roomId");
+
+CREATE TABLE IF NOT EXISTS {{prefix}}recordings (
+  id bigserial NOT NULL,
+  record_id varchar(64) NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_sid varchar(64) DEFAULT NULL,
+  recorder_id varchar(36) NOT NULL,
+  file_path varchar(255) NOT NULL,
+  size double precision NOT NULL,
+  published smallint NOT NULL DEFAULT 1,
+  creation_time bigint NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}recordings_record_id UNIQUE (record_id),
+  FOREIGN KEY (room_sid) REFERENCES {{prefix}}room_info (sid)
+     ON DELETE SET NULL
+     ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}recordings_room_id ON {{prefix}}recordings (room_id);
+
+CREATE TABLE IF NOT EXISTS {{prefix}}room_analytics (
+  id bigserial NOT NULL,
+  room_table_id bigint NULL,
+  room_id varchar(64) NOT NULL,
+  file_id varchar(255) NOT NULL,
+  file_name varchar(255) NOT NULL,
+  file_size double precision NOT NULL CHECK (file_size >= 0),
+  room_creation_time bigint NOT NULL,
+  creation_time bigint NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (room_table_id) REFERENCES {{prefix}}room_info (id)
+     ON DELETE SET NULL
+     ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}room_analytics_room_id ON {{prefix}}room_analytics (room_id);
+CREATE INDEX IF NOT EXISTS {{prefix}}room_analytics_file_id ON {{prefix}}room_analytics (file_id);
+
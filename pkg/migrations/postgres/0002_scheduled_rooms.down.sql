@@ -0,0 +1 @@
+DROP TABLE IF EXISTS {{prefix}}scheduled_rooms;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}scheduled_rooms (
+  id bigserial NOT NULL,
+  schedule_id varchar(64) NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  start_at bigint NOT NULL,
+  create_room_req text NOT NULL,
+  status smallint NOT NULL DEFAULT 0,
+  room_sid varchar(64) NOT NULL DEFAULT '',
+  error_msg varchar(255) NOT NULL DEFAULT '',
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}scheduled_rooms_schedule_id UNIQUE (schedule_id)
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}scheduled_rooms_room_id ON {{prefix}}scheduled_rooms (room_id);
+CREATE INDEX IF NOT EXISTS {{prefix}}scheduled_rooms_status_start_at ON {{prefix}}scheduled_rooms (status, start_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS {{prefix}}scheduled_rooms_series_id;
+ALTER TABLE {{prefix}}scheduled_rooms DROP COLUMN IF EXISTS series_id;
+
+DROP INDEX IF EXISTS {{prefix}}room_info_series_id;
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS {{prefix}}scheduled_room_series;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}scheduled_room_series (
+  id bigserial NOT NULL,
+  series_id varchar(64) NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  recurrence_rule varchar(255) NOT NULL,
+  timezone varchar(64) NOT NULL DEFAULT 'UTC',
+  start_at bigint NOT NULL,
+  occurrences integer NOT NULL DEFAULT 0,
+  create_room_req text NOT NULL,
+  status smallint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}scheduled_room_series_series_id UNIQUE (series_id)
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}scheduled_room_series_room_id ON {{prefix}}scheduled_room_series (room_id);
+
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS series_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}room_info_series_id ON {{prefix}}room_info (series_id);
+
+ALTER TABLE {{prefix}}scheduled_rooms ADD COLUMN IF NOT EXISTS series_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}scheduled_rooms_series_id ON {{prefix}}scheduled_rooms (series_id);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}webhook_endpoints (
+  id bigserial NOT NULL,
+  endpoint_id varchar(64) NOT NULL,
+  name varchar(255) NOT NULL DEFAULT '',
+  url varchar(255) NOT NULL,
+  secret varchar(255) NOT NULL,
+  events text NOT NULL,
+  is_active smallint NOT NULL DEFAULT 1,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}webhook_endpoints_endpoint_id UNIQUE (endpoint_id)
+);
+CREATE INDEX IF NOT EXISTS {{prefix}}webhook_endpoints_is_active ON {{prefix}}webhook_endpoints (is_active);
@@ -0,0 +1,15 @@
+ALTER TABLE {{prefix}}scheduled_room_series DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS {{prefix}}scheduled_rooms_tenant_id;
+ALTER TABLE {{prefix}}scheduled_rooms DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS {{prefix}}room_analytics_tenant_id;
+ALTER TABLE {{prefix}}room_analytics DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS {{prefix}}recordings_tenant_id;
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS {{prefix}}room_info_tenant_id_is_running;
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS tenant_id;
+
+DROP TABLE IF EXISTS {{prefix}}tenants;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}tenants (
+  id bigserial NOT NULL,
+  tenant_id varchar(64) NOT NULL,
+  name varchar(255) NOT NULL DEFAULT '',
+  api_key varchar(64) NOT NULL,
+  secret varchar(255) NOT NULL,
+  max_concurrent_rooms integer NOT NULL DEFAULT 0,
+  max_participants integer NOT NULL DEFAULT 0,
+  is_active smallint NOT NULL DEFAULT 1,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}tenants_tenant_id UNIQUE (tenant_id),
+  CONSTRAINT {{prefix}}tenants_api_key UNIQUE (api_key)
+);
+
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}room_info_tenant_id_is_running ON {{prefix}}room_info (tenant_id, is_running);
+
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}recordings_tenant_id ON {{prefix}}recordings (tenant_id);
+
+ALTER TABLE {{prefix}}room_analytics ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}room_analytics_tenant_id ON {{prefix}}room_analytics (tenant_id);
+
+ALTER TABLE {{prefix}}scheduled_rooms ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}scheduled_rooms_tenant_id ON {{prefix}}scheduled_rooms (tenant_id);
+
+ALTER TABLE {{prefix}}scheduled_room_series ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+-- postgres doesn't support zero dates, so the default was always the same.
+-- It's kept to use the same versions for every driver.
+ALTER TABLE {{prefix}}room_info ALTER COLUMN ended SET DEFAULT '1970-01-01 00:00:00';
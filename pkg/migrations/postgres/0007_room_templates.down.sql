@@ -0,0 +1 @@
+DROP TABLE IF EXISTS {{prefix}}room_templates;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}room_templates (
+  id bigserial NOT NULL,
+  template_id varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  name varchar(255) NOT NULL DEFAULT '',
+  room_features text NOT NULL,
+  default_lock_settings text NOT NULL,
+  room_duration integer NOT NULL DEFAULT 0,
+  empty_timeout integer NOT NULL DEFAULT 0,
+  max_participants integer NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}room_templates_template_id UNIQUE (template_id)
+);
+
+CREATE INDEX IF NOT EXISTS {{prefix}}room_templates_tenant_id ON {{prefix}}room_templates (tenant_id);
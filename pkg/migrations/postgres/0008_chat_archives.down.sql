@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS {{prefix}}chat_archives;
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS chat_archive;
@@ -0,0 +1,22 @@
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS chat_archive smallint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS {{prefix}}chat_archives (
+  id bigserial NOT NULL,
+  archive_id varchar(64) NOT NULL,
+  room_table_id bigint NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_sid varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  file_name varchar(255) NOT NULL,
+  file_size double precision NOT NULL DEFAULT 0,
+  total_messages integer NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}chat_archives_archive_id UNIQUE (archive_id),
+  CONSTRAINT {{prefix}}chat_archives_room_sid UNIQUE (room_sid)
+);
+
+CREATE INDEX IF NOT EXISTS {{prefix}}chat_archives_room_id ON {{prefix}}chat_archives (room_id);
+CREATE INDEX IF NOT EXISTS {{prefix}}chat_archives_tenant_id ON {{prefix}}chat_archives (tenant_id);
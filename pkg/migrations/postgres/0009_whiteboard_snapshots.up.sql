@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS {{prefix}}whiteboard_snapshots (
+  id bigserial NOT NULL,
+  snapshot_id varchar(64) NOT NULL,
+  room_table_id bigint NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_sid varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  file_id varchar(64) NOT NULL DEFAULT '',
+  file_name varchar(255) NOT NULL DEFAULT '',
+  total_pages integer NOT NULL DEFAULT 0,
+  total_files integer NOT NULL DEFAULT 0,
+  total_events integer NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT {{prefix}}whiteboard_snapshots_snapshot_id UNIQUE (snapshot_id),
+  CONSTRAINT {{prefix}}whiteboard_snapshots_room_sid UNIQUE (room_sid)
+);
+
+CREATE INDEX IF NOT EXISTS {{prefix}}whiteboard_snapshots_room_id ON {{prefix}}whiteboard_snapshots (room_id);
+CREATE INDEX IF NOT EXISTS {{prefix}}whiteboard_snapshots_tenant_id ON {{prefix}}whiteboard_snapshots (tenant_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS {{prefix}}recordings_parent_room_id;
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS parent_room_id;
@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS parent_room_id varchar(64) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS {{prefix}}recordings_parent_room_id ON {{prefix}}recordings (parent_room_id);
@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS metadata;
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS description;
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS title;
@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS title varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS description text DEFAULT NULL;
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS metadata text DEFAULT NULL;
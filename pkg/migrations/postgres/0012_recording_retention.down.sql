@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS retention_days;
+
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS recording_retention_days;
@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS recording_retention_days integer NOT NULL DEFAULT 0;
+
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS retention_days integer NOT NULL DEFAULT 0;
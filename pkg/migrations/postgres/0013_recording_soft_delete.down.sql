@@ -0,0 +1,4 @@
+DELETE FROM {{prefix}}recordings WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS {{prefix}}recordings_deleted_at;
+ALTER TABLE {{prefix}}recordings DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}recordings ADD COLUMN IF NOT EXISTS deleted_at timestamp DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS {{prefix}}recordings_deleted_at ON {{prefix}}recordings (deleted_at);
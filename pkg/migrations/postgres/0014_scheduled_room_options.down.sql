@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}scheduled_room_series DROP COLUMN IF EXISTS create_room_opts;
+
+ALTER TABLE {{prefix}}scheduled_rooms DROP COLUMN IF EXISTS create_room_opts;
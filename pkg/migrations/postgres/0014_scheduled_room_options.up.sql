@@ -0,0 +1,3 @@
+ALTER TABLE {{prefix}}scheduled_rooms ADD COLUMN IF NOT EXISTS create_room_opts varchar(1000) NOT NULL DEFAULT '';
+
+ALTER TABLE {{prefix}}scheduled_room_series ADD COLUMN IF NOT EXISTS create_room_opts varchar(1000) NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS recording_retention_days integer NOT NULL DEFAULT 0;
+
+UPDATE {{prefix}}room_info SET recording_retention_days = COALESCE((metadata::json->>'recording_retention_days')::integer, 0) WHERE metadata IS NOT NULL;
+
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS metadata;
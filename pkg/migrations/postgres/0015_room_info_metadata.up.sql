@@ -0,0 +1,5 @@
+ALTER TABLE {{prefix}}room_info ADD COLUMN IF NOT EXISTS metadata text DEFAULT NULL;
+
+UPDATE {{prefix}}room_info SET metadata = '{"recording_retention_days":' || recording_retention_days || '}' WHERE recording_retention_days <> 0;
+
+ALTER TABLE {{prefix}}room_info DROP COLUMN IF EXISTS recording_retention_days;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS {{prefix}}webhook_endpoints_tenant_id;
+ALTER TABLE {{prefix}}webhook_endpoints DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,2 @@
+ALTER TABLE {{prefix}}webhook_endpoints ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS {{prefix}}webhook_endpoints_tenant_id ON {{prefix}}webhook_endpoints (tenant_id);
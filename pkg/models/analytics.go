@@ -6,6 +6,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	"sync"
 )
 
@@ -16,6 +17,7 @@ type AnalyticsModel struct {
 	ds          *dbservice.DatabaseService
 	rs          *redisservice.RedisService
 	natsService *natsservice.NatsService
+	storage     storageservice.Storage
 }
 
 func NewAnalyticsModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *AnalyticsModel {
@@ -34,5 +36,11 @@ func NewAnalyticsModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs
 		ds:          ds,
 		rs:          rs,
 		natsService: natsservice.New(app),
+		storage:     storageservice.New(app, storageservice.AreaAnalytics),
 	}
 }
+
+// Storage returns the storage of the analytics files
+func (m *AnalyticsModel) Storage() storageservice.Storage {
+	return m.storage
+}
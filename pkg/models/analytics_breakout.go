@@ -0,0 +1,231 @@
+package models
+
+import (
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// analyticsBreakoutRoomId will be used instead of the breakout room id to build the keys,
+	// so that all the records stay under the parent room
+	analyticsBreakoutRoomId   = "%s:breakout:%s"
+	analyticsBreakoutRoomsKey = analyticsRoomKey + ":breakoutRooms"
+)
+
+type AnalyticsBreakoutRoomInfo struct {
+	RoomId   string `json:"room_id"`
+	Title    string `json:"title"`
+	Created  int64  `json:"created"`
+	Ended    int64  `json:"ended"`
+	Duration int64  `json:"duration"`
+	// TotalTalkTime in milliseconds
+	TotalTalkTime     int64                          `json:"total_talk_time"`
+	TotalChatMessages int64                          `json:"total_chat_messages"`
+	TotalUsers        int64                          `json:"total_users"`
+	Members           []*AnalyticsBreakoutRoomMember `json:"members"`
+}
+
+type AnalyticsBreakoutRoomMember struct {
+	UserId   string  `json:"user_id"`
+	Name     string  `json:"name"`
+	IsAdmin  bool    `json:"is_admin"`
+	ExUserId *string `json:"ex_user_id,omitempty"`
+	// TalkTime in milliseconds
+	TalkTime     int64 `json:"talk_time"`
+	ChatMessages int64 `json:"chat_messages"`
+}
+
+// AddBreakoutRoom will register the breakout room, so that its events will be stored under the parent room
+func (m *AnalyticsModel) AddBreakoutRoom(parentRoomId, bkRoomId, title string) {
+	if m.app.AnalyticsSettings == nil || !m.app.AnalyticsSettings.Enabled {
+		return
+	}
+
+	marshal, err := json.Marshal(&AnalyticsBreakoutRoomInfo{
+		RoomId:  bkRoomId,
+		Title:   title,
+		Created: time.Now().Unix(),
+	})
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	key := fmt.Sprintf(analyticsBreakoutRoomsKey, parentRoomId)
+	if err = m.rs.AddAnalyticsHSETType(key, map[string]string{bkRoomId: string(marshal)}); err != nil {
+		log.Errorln(err)
+		return
+	}
+	if err = m.rs.AnalyticsAddBreakoutRoom(bkRoomId, parentRoomId); err != nil {
+		log.Errorln(err)
+	}
+}
+
+// analyticsRoomId will return the id to build the keys with
+func (m *AnalyticsModel) analyticsRoomId(roomId string) string {
+	parentRoomId, err := m.rs.AnalyticsGetBreakoutRoomParent(roomId)
+	if err != nil || parentRoomId == "" {
+		return roomId
+	}
+	return fmt.Sprintf(analyticsBreakoutRoomId, parentRoomId, roomId)
+}
+
+// onBreakoutRoomEnded will return false if the room wasn't registered as a breakout room,
+// otherwise records will be exported with the parent room
+func (m *AnalyticsModel) onBreakoutRoomEnded(parentRoomId, bkRoomId string) bool {
+	registered, err := m.rs.AnalyticsGetBreakoutRoomParent(bkRoomId)
+	if err != nil || registered == "" || registered != parentRoomId {
+		return false
+	}
+
+	key := fmt.Sprintf(analyticsBreakoutRoomsKey, parentRoomId)
+	infos, err := m.rs.GetAnalyticsAllHashTypeVals(key)
+	if err != nil {
+		log.Errorln(err)
+		return true
+	}
+
+	info := new(AnalyticsBreakoutRoomInfo)
+	if v, ok := infos[bkRoomId]; ok {
+		_ = json.Unmarshal([]byte(v), info)
+	}
+	info.RoomId = bkRoomId
+	info.Ended = time.Now().Unix()
+
+	marshal, err := json.Marshal(info)
+	if err != nil {
+		log.Errorln(err)
+		return true
+	}
+	if err = m.rs.AddAnalyticsHSETType(key, map[string]string{bkRoomId: string(marshal)}); err != nil {
+		log.Errorln(err)
+	}
+	return true
+}
+
+// exportBreakoutRoomsAnalytics will collect records of all breakout rooms of the parent room.
+// It will return the keys to delete too
+func (m *AnalyticsModel) exportBreakoutRoomsAnalytics(parentRoomId string) ([]*AnalyticsBreakoutRoomInfo, []string) {
+	key := fmt.Sprintf(analyticsBreakoutRoomsKey, parentRoomId)
+	allKeys := []string{key}
+
+	infos, err := m.rs.GetAnalyticsAllHashTypeVals(key)
+	if err != nil {
+		log.Errorln(err)
+		return nil, allKeys
+	}
+	if len(infos) == 0 {
+		return nil, allKeys
+	}
+
+	var rooms []*AnalyticsBreakoutRoomInfo
+	var bkRoomIds []string
+	for bkRoomId, v := range infos {
+		bkRoomIds = append(bkRoomIds, bkRoomId)
+		info := new(AnalyticsBreakoutRoomInfo)
+		if err := json.Unmarshal([]byte(v), info); err != nil {
+			log.Errorln(err)
+			continue
+		}
+		info.RoomId = bkRoomId
+		if info.Ended > info.Created {
+			info.Duration = info.Ended - info.Created
+		}
+
+		keys := m.buildBreakoutRoomMembers(fmt.Sprintf(analyticsBreakoutRoomId, parentRoomId, bkRoomId), info)
+		allKeys = append(allKeys, keys...)
+		rooms = append(rooms, info)
+	}
+
+	if err = m.rs.AnalyticsDeleteBreakoutRooms(bkRoomIds...); err != nil {
+		log.Errorln(err)
+	}
+
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].RoomId < rooms[j].RoomId
+	})
+	return rooms, allKeys
+}
+
+func (m *AnalyticsModel) buildBreakoutRoomMembers(aRoomId string, info *AnalyticsBreakoutRoomInfo) []string {
+	roomKey := fmt.Sprintf(analyticsRoomKey+":room", aRoomId)
+	var allKeys []string
+	var userEvents []string
+	for _, ev := range plugnmeet.AnalyticsEvents_name {
+		if strings.Contains(ev, "ANALYTICS_EVENT_ROOM_") {
+			allKeys = append(allKeys, fmt.Sprintf(roomKey+":%s", ev))
+		} else if strings.Contains(ev, "ANALYTICS_EVENT_USER_") {
+			userEvents = append(userEvents, ev)
+		}
+	}
+	userEvents = append(userEvents, serverUserAnalyticsEvents...)
+
+	usersKey := fmt.Sprintf("%s:users", roomKey)
+	allKeys = append(allKeys, usersKey)
+	users, err := m.rs.AnalyticsGetAllUsers(usersKey)
+	if err != nil {
+		log.Errorln(err)
+		return allKeys
+	}
+	info.TotalUsers = int64(len(users))
+
+	for userId, n := range users {
+		uf := new(plugnmeet.AnalyticsRedisUserInfo)
+		_ = protojson.Unmarshal([]byte(n), uf)
+		member := &AnalyticsBreakoutRoomMember{
+			UserId:   userId,
+			Name:     uf.GetName(),
+			IsAdmin:  uf.GetIsAdmin(),
+			ExUserId: uf.ExUserId,
+		}
+
+		userKey := fmt.Sprintf(analyticsUserKey, aRoomId, userId)
+		for _, ev := range userEvents {
+			allKeys = append(allKeys, fmt.Sprintf("%s:%s", userKey, ev))
+		}
+
+		member.TalkTime = m.getEventTotal(fmt.Sprintf("%s:%s", userKey, plugnmeet.AnalyticsEvents_ANALYTICS_EVENT_USER_TALKED_DURATION.String()))
+		member.ChatMessages = m.getEventTotal(fmt.Sprintf("%s:%s", userKey, plugnmeet.AnalyticsEvents_ANALYTICS_EVENT_USER_PUBLIC_CHAT.String())) +
+			m.getEventTotal(fmt.Sprintf("%s:%s", userKey, plugnmeet.AnalyticsEvents_ANALYTICS_EVENT_USER_PRIVATE_CHAT.String()))
+
+		info.TotalTalkTime += member.TalkTime
+		info.TotalChatMessages += member.ChatMessages
+		info.Members = append(info.Members, member)
+	}
+
+	sort.Slice(info.Members, func(i, j int) bool {
+		return info.Members[i].Name < info.Members[j].Name
+	})
+	return allKeys
+}
+
+func (m *AnalyticsModel) getEventTotal(ekey string) int64 {
+	eventInfo := new(plugnmeet.AnalyticsEventData)
+	if err := m.buildEventInfo(ekey, eventInfo); err != nil {
+		return 0
+	}
+	return int64(eventInfo.Total)
+}
+
+// addBreakoutRoomsToResult will add breakout_rooms section to the exported analytics,
+// as plugnmeet.AnalyticsResult doesn't have any field for it
+func addBreakoutRoomsToResult(marshal []byte, rooms []*AnalyticsBreakoutRoomInfo) ([]byte, error) {
+	result := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(marshal, &result); err != nil {
+		return nil, err
+	}
+
+	bk, err := json.Marshal(rooms)
+	if err != nil {
+		return nil, err
+	}
+	result["breakout_rooms"] = bk
+
+	return json.Marshal(result)
+}
@@ -2,11 +2,10 @@ package models
 
 import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
-	"os"
 )
 
-func (m *AnalyticsModel) AddAnalyticsFileToDB(roomTableId uint64, roomCreationTime int64, roomId, fileId string, stat os.FileInfo) (int64, error) {
-	fSize := float64(stat.Size())
+func (m *AnalyticsModel) AddAnalyticsFileToDB(roomTableId uint64, roomCreationTime int64, roomId, fileId string, fileSize int64) (int64, error) {
+	fSize := float64(fileSize)
 	// we'll convert bytes to KB
 	if fSize > 1000 {
 		fSize = fSize / 1000.0
@@ -22,6 +21,10 @@ func (m *AnalyticsModel) AddAnalyticsFileToDB(roomTableId uint64, roomCreationTi
 		FileSize:         fSize,
 		RoomCreationTime: roomCreationTime,
 	}
+	// analytics will belong to the same tenant as the room
+	if room, err := m.ds.GetRoomInfoByTableId(roomTableId); err == nil && room != nil {
+		info.TenantId = room.TenantId
+	}
 
 	return m.ds.InsertAnalyticsData(info)
 }
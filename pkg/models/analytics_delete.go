@@ -1,11 +1,9 @@
 package models
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
-	"github.com/mynaparrot/plugnmeet-server/pkg/config"
-	"os"
 	"strings"
 )
 
@@ -15,20 +13,17 @@ func (m *AnalyticsModel) DeleteAnalytics(r *plugnmeet.DeleteAnalyticsReq) error
 		return err
 	}
 
-	path := fmt.Sprintf("%s/%s", *config.GetConfig().AnalyticsSettings.FilesStorePath, analytic.FileName)
-
+	ctx := context.Background()
 	// delete the main file
-	err = os.Remove(path)
+	// if file not exists then we can delete it from record without showing any error
+	err = m.storage.Delete(ctx, analytic.FileName)
 	if err != nil {
-		// if file not exists then we can delete it from record without showing any error
-		if !os.IsNotExist(err) {
-			ms := strings.SplitN(err.Error(), "/", -1)
-			return errors.New(ms[len(ms)-1])
-		}
+		ms := strings.SplitN(err.Error(), "/", -1)
+		return errors.New(ms[len(ms)-1])
 	}
 
 	// delete compressed, if any
-	_ = os.Remove(path + ".fiber.gz")
+	_ = m.storage.Delete(ctx, analytic.FileName+".fiber.gz")
 
 	// no error, so we'll delete record from DB
 	_, err = m.ds.DeleteAnalyticByFileId(analytic.FileId)
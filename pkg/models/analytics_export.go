@@ -1,6 +1,7 @@
 package models
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -11,7 +12,6 @@ import (
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/encoding/protojson"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +34,11 @@ func (m *AnalyticsModel) PrepareToExportAnalytics(roomId, sid, meta string) {
 		return
 	}
 
+	// records of the breakout room will be exported with the parent room
+	if metadata.IsBreakoutRoom && m.onBreakoutRoomEnded(metadata.ParentRoomId, roomId) {
+		return
+	}
+
 	// let's wait a few seconds so that all other processes will finish
 	time.Sleep(config.WaitBeforeAnalyticsStartProcessing)
 
@@ -58,14 +63,6 @@ func (m *AnalyticsModel) PrepareToExportAnalytics(roomId, sid, meta string) {
 		}()
 	}
 
-	if _, err := os.Stat(*m.app.AnalyticsSettings.FilesStorePath); os.IsNotExist(err) {
-		err = os.MkdirAll(*m.app.AnalyticsSettings.FilesStorePath, os.ModePerm)
-		if err != nil {
-			log.Errorln(err)
-			return
-		}
-	}
-
 	isRunning := 0
 	room, _ := m.ds.GetRoomInfoBySid(sid, &isRunning)
 	if room == nil || room.ID == 0 {
@@ -73,10 +70,9 @@ func (m *AnalyticsModel) PrepareToExportAnalytics(roomId, sid, meta string) {
 	}
 
 	fileId := fmt.Sprintf("%s-%d", room.Sid, room.CreationTime)
-	path := fmt.Sprintf("%s/%s.json", *m.app.AnalyticsSettings.FilesStorePath, fileId)
 
 	// export file
-	stat, err := m.exportAnalyticsToFile(room, path, metadata)
+	fileSize, err := m.exportAnalyticsToFile(room, fileId+".json", metadata)
 	if err != nil {
 		log.Errorln(err)
 		return
@@ -88,7 +84,7 @@ func (m *AnalyticsModel) PrepareToExportAnalytics(roomId, sid, meta string) {
 	// and won't record to DB
 	if metadata.RoomFeatures.EnableAnalytics {
 		// record in db
-		_, err = m.AddAnalyticsFileToDB(room.ID, room.CreationTime, room.RoomId, fileId, stat)
+		_, err = m.AddAnalyticsFileToDB(room.ID, room.CreationTime, room.RoomId, fileId, fileSize)
 		if err != nil {
 			log.Errorln(err)
 		}
@@ -97,7 +93,8 @@ func (m *AnalyticsModel) PrepareToExportAnalytics(roomId, sid, meta string) {
 	}
 }
 
-func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path string, metadata *plugnmeet.RoomMetadata) (os.FileInfo, error) {
+// exportAnalyticsToFile will store the file in the storage & return the size of the file
+func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, fileName string, metadata *plugnmeet.RoomMetadata) (int64, error) {
 	roomInfo := &plugnmeet.AnalyticsRoomInfo{
 		RoomId:       room.RoomId,
 		RoomTitle:    room.RoomTitle,
@@ -135,6 +132,7 @@ func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path str
 			userRedisKeys = append(userRedisKeys, ev)
 		}
 	}
+	userRedisKeys = append(userRedisKeys, serverUserAnalyticsEvents...)
 
 	// get users first
 	k := fmt.Sprintf("%s:users", key)
@@ -142,7 +140,7 @@ func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path str
 	allKeys = append(allKeys, fmt.Sprintf("%s:users", key))
 	if err != nil {
 		log.Errorln(err)
-		return nil, err
+		return 0, err
 	}
 	roomInfo.RoomTotalUsers = int64(len(users))
 	roomInfo.RoomDuration = roomInfo.RoomEnded - roomInfo.RoomCreation
@@ -181,7 +179,10 @@ func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path str
 		usersInfo = append(usersInfo, userInfo)
 	}
 
-	var stat os.FileInfo
+	breakoutRooms, bkKeys := m.exportBreakoutRoomsAnalytics(room.RoomId)
+	allKeys = append(allKeys, bkKeys...)
+
+	var fileSize int64
 	// it's not possible to get room metadata as always
 	// so, if room didn't have activated analytics feature,
 	// we will simply won't create the file & delete all records
@@ -197,19 +198,22 @@ func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path str
 		marshal, err := op.Marshal(result)
 		if err != nil {
 			log.Errorln(err)
-			return nil, err
+			return 0, err
 		}
-
-		err = os.WriteFile(path, marshal, 0644)
-		if err != nil {
-			log.Errorln(err)
-			return nil, err
+		if len(breakoutRooms) > 0 {
+			marshal, err = addBreakoutRoomsToResult(marshal, breakoutRooms)
+			if err != nil {
+				log.Errorln(err)
+				return 0, err
+			}
 		}
-		stat, err = os.Stat(path)
+
+		err = m.storage.Put(context.Background(), fileName, bytes.NewReader(marshal), int64(len(marshal)), "application/json")
 		if err != nil {
 			log.Errorln(err)
-			return nil, err
+			return 0, err
 		}
+		fileSize = int64(len(marshal))
 
 	}
 
@@ -219,7 +223,7 @@ func (m *AnalyticsModel) exportAnalyticsToFile(room *dbmodels.RoomInfo, path str
 		log.Errorln(err)
 	}
 
-	return stat, err
+	return fileSize, err
 }
 
 func (m *AnalyticsModel) buildEventInfo(ekey string, eventInfo *plugnmeet.AnalyticsEventData) error {
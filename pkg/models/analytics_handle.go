@@ -4,14 +4,25 @@ import (
 	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	log "github.com/sirupsen/logrus"
 	"time"
 )
 
 const (
 	analyticsRoomKey = "pnm:analytics:%s"
 	analyticsUserKey = analyticsRoomKey + ":user:%s"
+
+	// server side user events, which aren't part of plugnmeet.AnalyticsEvents
+	analyticsEventUserChatMessageBlocked  = "ANALYTICS_EVENT_USER_CHAT_MESSAGE_BLOCKED"
+	analyticsEventUserWaitingRoomDuration = "ANALYTICS_EVENT_USER_WAITING_ROOM_DURATION"
 )
 
+// serverUserAnalyticsEvents will be exported with the user events
+var serverUserAnalyticsEvents = []string{
+	analyticsEventUserChatMessageBlocked,
+	analyticsEventUserWaitingRoomDuration,
+}
+
 func (m *AnalyticsModel) HandleEvent(d *plugnmeet.AnalyticsDataMsg) {
 	if config.GetConfig().AnalyticsSettings == nil ||
 		!config.GetConfig().AnalyticsSettings.Enabled {
@@ -21,6 +32,8 @@ func (m *AnalyticsModel) HandleEvent(d *plugnmeet.AnalyticsDataMsg) {
 	defer m.Unlock()
 	// we'll use unix milliseconds to make sure fields are unique
 	d.Time = time.Now().UnixMilli()
+	// events of breakout rooms will be stored under the parent room
+	d.RoomId = m.analyticsRoomId(d.RoomId)
 	m.data = d
 
 	switch d.EventType {
@@ -54,3 +67,19 @@ func (m *AnalyticsModel) handleUserTypeEvents() {
 	key := fmt.Sprintf(analyticsUserKey, m.data.RoomId, m.data.GetUserId())
 	m.insertEventData(key)
 }
+
+// handleServerUserEvent will store server side user events
+// which can't be represented by plugnmeet.AnalyticsEvents
+func (m *AnalyticsModel) handleServerUserEvent(roomId, userId, eventName, value string) {
+	if m.app.AnalyticsSettings == nil || !m.app.AnalyticsSettings.Enabled {
+		return
+	}
+
+	key := fmt.Sprintf(analyticsUserKey+":%s", m.analyticsRoomId(roomId), userId, eventName)
+	val := map[string]string{
+		fmt.Sprintf("%d", time.Now().UnixMilli()): value,
+	}
+	if err := m.rs.AddAnalyticsHSETType(key, val); err != nil {
+		log.Errorln(err)
+	}
+}
@@ -5,14 +5,14 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 )
 
-func (m *AnalyticsModel) FetchAnalytics(r *plugnmeet.FetchAnalyticsReq) (*plugnmeet.FetchAnalyticsResult, error) {
+func (m *AnalyticsModel) FetchAnalytics(r *plugnmeet.FetchAnalyticsReq, tenantId string) (*plugnmeet.FetchAnalyticsResult, error) {
 	if r.Limit <= 0 {
 		r.Limit = 20
 	}
 	if r.OrderBy == "" {
 		r.OrderBy = "DESC"
 	}
-	data, total, err := m.ds.GetAnalytics(r.RoomIds, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	data, total, err := m.ds.GetAnalytics(r.RoomIds, tenantId, uint64(r.From), uint64(r.Limit), &r.OrderBy)
 	if err != nil {
 		return nil, err
 	}
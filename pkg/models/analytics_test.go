@@ -5,6 +5,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -22,7 +23,7 @@ func TestAnalyticsAuthModel_AddAnalyticsFileToDB(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = analyticsModel.AddAnalyticsFileToDB(roomTableId, roomCreationTime, roomId, fileId, stat)
+	_, err = analyticsModel.AddAnalyticsFileToDB(roomTableId, roomCreationTime, roomId, fileId, stat.Size())
 	if err != nil {
 		t.Error(err)
 	}
@@ -31,7 +32,7 @@ func TestAnalyticsAuthModel_AddAnalyticsFileToDB(t *testing.T) {
 func TestAnalyticsAuthModel_FetchAnalytics(t *testing.T) {
 	result, err := analyticsModel.FetchAnalytics(&plugnmeet.FetchAnalyticsReq{
 		RoomIds: []string{roomId},
-	})
+	}, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -106,3 +107,20 @@ func TestAnalyticsAuthModel_generateTokenAndVerify(t *testing.T) {
 
 	t.Logf("%+v, response: %d", err, fiber.StatusNotFound)
 }
+
+func TestAnalyticsModel_addBreakoutRoomsToResult(t *testing.T) {
+	marshal, err := addBreakoutRoomsToResult([]byte(`{"room":{"room_id":"test"},"users":[]}`), []*AnalyticsBreakoutRoomInfo{
+		{RoomId: "test-1", Title: "Room 1", TotalUsers: 1},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !strings.Contains(string(marshal), `"breakout_rooms":[{"room_id":"test-1"`) {
+		t.Errorf("breakout_rooms section missing: %s", string(marshal))
+	}
+	if !strings.Contains(string(marshal), `"room":{"room_id":"test"}`) {
+		t.Errorf("room section should be kept as it is: %s", string(marshal))
+	}
+}
@@ -12,8 +12,36 @@ func (m *AuthModel) GeneratePNMJoinToken(c *plugnmeet.PlugNmeetTokenClaims) (str
 	return auth.GeneratePlugNmeetJWTAccessToken(m.app.Client.ApiKey, m.app.Client.Secret, c.UserId, *m.app.Client.TokenValidity, c)
 }
 
+// VerifyPlugNmeetAccessToken will try all the accepted secrets,
+// so that tokens signed before rotating the secret will remain valid
 func (m *AuthModel) VerifyPlugNmeetAccessToken(token string, withTime bool) (*plugnmeet.PlugNmeetTokenClaims, error) {
-	return auth.VerifyPlugNmeetAccessToken(m.app.Client.ApiKey, m.app.Client.Secret, token, withTime)
+	var firstErr error
+	for _, secret := range m.app.Client.AcceptedSecrets() {
+		claims, err := auth.VerifyPlugNmeetAccessToken(m.app.Client.ApiKey, secret, token, withTime)
+		if err == nil {
+			return claims, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// verifyClaimsWithSecrets will verify the signature using the accepted secrets,
+// error of the primary secret will be returned if none of them matched
+func verifyClaimsWithSecrets(tok *jwt.JSONWebToken, secrets []string, out ...interface{}) error {
+	var firstErr error
+	for _, secret := range secrets {
+		err := tok.Claims([]byte(secret), out...)
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
 func (m *AuthModel) UnsafeClaimsWithoutVerification(token string) (*plugnmeet.PlugNmeetTokenClaims, error) {
@@ -8,7 +8,8 @@ import (
 	"time"
 )
 
-func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetRecordingsReq) ([]*bbbapiwrapper.RecordingInfo, *bbbapiwrapper.Pagination, error) {
+// GetRecordings will return recordings by the requested state. nil published will return all recordings
+func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetRecordingsReq, published *bool) ([]*bbbapiwrapper.RecordingInfo, *bbbapiwrapper.Pagination, error) {
 	oriIds := make(map[string]string)
 	if r.Limit == 0 {
 		// let's make it 50 for BBB as not all plugin still support pagination
@@ -26,7 +27,7 @@ func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetReco
 		}
 	}
 
-	data, total, err := m.ds.GetRecordingsForBBB(rIds, mIds, r.Offset, r.Limit)
+	data, total, err := m.ds.GetRecordingsForBBB(rIds, mIds, published, r.Offset, r.Limit)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -36,8 +37,12 @@ func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetReco
 		recording := &bbbapiwrapper.RecordingInfo{
 			RecordID:          v.RecordID,
 			InternalMeetingID: v.RoomSid.String,
-			Published:         true,
+			Published:         v.Published == 1,
 			State:             "published",
+			Metadata:          decodeRecordingMeta(v.Metadata.String),
+		}
+		if !recording.Published {
+			recording.State = "unpublished"
 		}
 
 		if oriIds[v.RoomID] != "" {
@@ -46,17 +51,20 @@ func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetReco
 			recording.MeetingID = v.RoomID
 		}
 
-		// for path, let's create a download link directly
-		url, err := m.createPlayBackURL(host, v.FilePath)
-		if err != nil {
-			log.Errorln(err)
-			continue
-		}
-		recording.Playback.PlayBackFormat = []bbbapiwrapper.PlayBackFormat{
-			{
-				Type: "presentation",
-				URL:  url,
-			},
+		// for path, let's create a download link directly.
+		// Unpublished recordings can't be downloaded, so no playback for them
+		if recording.Published {
+			url, err := m.createPlayBackURL(host, v.FilePath)
+			if err != nil {
+				log.Errorln(err)
+				continue
+			}
+			recording.Playback.PlayBackFormat = []bbbapiwrapper.PlayBackFormat{
+				{
+					Type: "presentation",
+					URL:  url,
+				},
+			}
 		}
 
 		if mInfo, err := m.ds.GetRoomInfoBySid(v.RoomSid.String, nil); err == nil && mInfo != nil {
@@ -67,6 +75,9 @@ func (m *BBBApiWrapperModel) GetRecordings(host string, r *bbbapiwrapper.GetReco
 			}
 			recording.Participants = uint64(mInfo.JoinedParticipants)
 		}
+		if v.Title != "" {
+			recording.Name = v.Title
+		}
 
 		if v.Size > 0 {
 			recording.RawSize = int64(v.Size * 1000000)
@@ -9,7 +9,7 @@ func TestBBBApiWrapperModel_GetRecordings(t *testing.T) {
 	bbbm := NewBBBApiWrapperModel(nil, nil, nil)
 	recordings, pag, err := bbbm.GetRecordings("https://demo.plugnmeet.com", &bbbapiwrapper.GetRecordingsReq{
 		MeetingID: roomId,
-	})
+	}, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -21,7 +21,7 @@ func TestBBBApiWrapperModel_GetRecordings(t *testing.T) {
 
 	recordings, pag, err = bbbm.GetRecordings("https://demo.plugnmeet.com", &bbbapiwrapper.GetRecordingsReq{
 		RecordID: recordId,
-	})
+	}, nil)
 	if err != nil {
 		t.Error(err)
 	}
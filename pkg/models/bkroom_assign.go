@@ -0,0 +1,328 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	BreakoutRoomAssignRandom     = "random"
+	BreakoutRoomAssignByCount    = "count"
+	BreakoutRoomAssignPrevious   = "previous"
+	BreakoutRoomAssignSelfSelect = "self_select"
+
+	// breakoutRoomGroupingTTL defines how long we'll remember the last grouping of a room
+	breakoutRoomGroupingTTL = time.Hour * 24
+)
+
+// AutoCreateBreakoutRooms will create breakout rooms by computing the users of every room
+// from the online participants of the parent room based on the requested strategy
+func (m *BreakoutRoomModel) AutoCreateBreakoutRooms(ctx context.Context, r *protocol.AutoCreateBreakoutRoomsReq) error {
+	if r.Duration == 0 {
+		return errors.New("duration is required")
+	}
+
+	users, err := m.getAssignableUsers(r.RoomId)
+	if err != nil {
+		return err
+	}
+
+	var groups [][]*plugnmeet.BreakoutRoomUser
+	switch r.Strategy {
+	case BreakoutRoomAssignRandom:
+		if r.NumberOfRooms < 1 {
+			return errors.New("number_of_rooms is required")
+		}
+		if len(users) == 0 {
+			return errors.New("no participants found to assign")
+		}
+		groups = m.assignEvenly(users, int(r.NumberOfRooms))
+	case BreakoutRoomAssignByCount:
+		if r.UsersPerRoom < 1 {
+			return errors.New("users_per_room is required")
+		}
+		if len(users) == 0 {
+			return errors.New("no participants found to assign")
+		}
+		groups = m.assignEvenly(users, breakoutRoomsByCount(len(users), int(r.UsersPerRoom)))
+	case BreakoutRoomAssignPrevious:
+		groups, err = m.assignByPreviousGrouping(r.RoomId, users, int(r.NumberOfRooms))
+		if err != nil {
+			return err
+		}
+	case BreakoutRoomAssignSelfSelect:
+		if r.NumberOfRooms < 1 {
+			return errors.New("number_of_rooms is required")
+		}
+		groups = make([][]*plugnmeet.BreakoutRoomUser, int(r.NumberOfRooms))
+	default:
+		return fmt.Errorf("invalid strategy: %s", r.Strategy)
+	}
+
+	req := &plugnmeet.CreateBreakoutRoomsReq{
+		RoomId:          r.RoomId,
+		RequestedUserId: r.RequestedUserId,
+		Duration:        r.Duration,
+		WelcomeMsg:      r.WelcomeMsg,
+	}
+	for i, g := range groups {
+		title := fmt.Sprintf("Room %d", i+1)
+		if i < len(r.Titles) && r.Titles[i] != "" {
+			title = r.Titles[i]
+		}
+		req.Rooms = append(req.Rooms, &plugnmeet.BreakoutRoom{
+			Id:    strconv.Itoa(i + 1),
+			Title: title,
+			Users: g,
+		})
+	}
+
+	err = m.CreateBreakoutRooms(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if r.Strategy == BreakoutRoomAssignSelfSelect {
+		// keep it a bit longer than the duration, it will be removed when all rooms are ended
+		ttl := time.Duration(r.Duration)*time.Minute + time.Hour
+		err = m.rs.BreakoutRoomEnableSelfSelect(r.RoomId, int(r.UsersPerRoom), ttl)
+		if err != nil {
+			return err
+		}
+		_ = m.natsService.NotifyI18nInfoMsg(r.RoomId, "notifications.breakout-room-self-select-ready", nil, true, nil)
+	}
+
+	return nil
+}
+
+// SelectBreakoutRoom will assign the user to the selected breakout room
+// when self-selection is active & return the join token
+func (m *BreakoutRoomModel) SelectBreakoutRoom(ctx context.Context, r *protocol.SelectBreakoutRoomReq) (string, error) {
+	enabled, maxUsers, err := m.rs.BreakoutRoomGetSelfSelect(r.RoomId)
+	if err != nil {
+		return "", err
+	}
+	if !enabled {
+		return "", errors.New("self selection of breakout room isn't enabled")
+	}
+
+	err = m.assignUserToBreakoutRoom(r.RoomId, r.UserId, r.BreakoutRoomId, maxUsers)
+	if err != nil {
+		return "", err
+	}
+
+	return m.JoinBreakoutRoom(ctx, &plugnmeet.JoinBreakoutRoomReq{
+		RoomId:         r.RoomId,
+		BreakoutRoomId: r.BreakoutRoomId,
+		UserId:         r.UserId,
+	})
+}
+
+func (m *BreakoutRoomModel) assignUserToBreakoutRoom(roomId, userId, bkRoomId string, maxUsers int) error {
+	// multiple users may select at the same time,
+	// so we'll need to make sure that the room info isn't overwritten
+	locked := false
+	for range 10 {
+		ok, err := m.rs.BreakoutRoomLockSelection(roomId, time.Second*5)
+		if err != nil {
+			return err
+		}
+		if ok {
+			locked = true
+			break
+		}
+		time.Sleep(time.Millisecond * 200)
+	}
+	if !locked {
+		return errors.New("too many requests, please try again")
+	}
+	defer m.rs.BreakoutRoomUnlockSelection(roomId)
+
+	rooms, err := m.fetchBreakoutRooms(roomId)
+	if err != nil {
+		return err
+	}
+
+	var target *plugnmeet.BreakoutRoom
+	for _, rr := range rooms {
+		if rr.Id == bkRoomId {
+			target = rr
+			break
+		}
+	}
+	if target == nil {
+		return errors.New("breakout room not found")
+	}
+
+	if slices.ContainsFunc(target.Users, func(u *plugnmeet.BreakoutRoomUser) bool { return u.Id == userId }) {
+		return nil
+	}
+	if maxUsers > 0 && len(target.Users) >= maxUsers {
+		return errors.New("selected breakout room is full")
+	}
+
+	p, err := m.natsService.GetUserInfo(roomId, userId)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return errors.New("user not found")
+	}
+
+	// user can change the room, so remove from the previous one
+	for _, rr := range rooms {
+		if rr.Id == bkRoomId {
+			continue
+		}
+		l := len(rr.Users)
+		rr.Users = slices.DeleteFunc(rr.Users, func(u *plugnmeet.BreakoutRoomUser) bool { return u.Id == userId })
+		if len(rr.Users) != l {
+			if err := m.updateBreakoutRoom(roomId, rr); err != nil {
+				return err
+			}
+		}
+	}
+
+	target.Users = append(target.Users, &plugnmeet.BreakoutRoomUser{
+		Id:   p.UserId,
+		Name: p.Name,
+	})
+	if err := m.updateBreakoutRoom(roomId, target); err != nil {
+		return err
+	}
+
+	err = m.rs.BreakoutRoomSetUserGroup(roomId, userId, strings.TrimPrefix(bkRoomId, roomId+"-"), breakoutRoomGroupingTTL)
+	if err != nil {
+		log.Errorln(err)
+	}
+
+	return nil
+}
+
+func (m *BreakoutRoomModel) updateBreakoutRoom(roomId string, room *plugnmeet.BreakoutRoom) error {
+	// joined status is computed during fetching
+	for _, u := range room.Users {
+		u.Joined = false
+	}
+	marshal, err := protojson.Marshal(room)
+	if err != nil {
+		return err
+	}
+	return m.natsService.InsertOrUpdateBreakoutRoom(roomId, room.Id, marshal)
+}
+
+// getAssignableUsers will return online users of the room excluding admins & hidden users
+func (m *BreakoutRoomModel) getAssignableUsers(roomId string) ([]*plugnmeet.BreakoutRoomUser, error) {
+	participants, err := m.natsService.GetOnlineUsersList(roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*plugnmeet.BreakoutRoomUser
+	for _, p := range participants {
+		if p.IsAdmin || p.UserId == config.RecorderBot || p.UserId == config.RtmpBot {
+			continue
+		}
+		users = append(users, &plugnmeet.BreakoutRoomUser{
+			Id:   p.UserId,
+			Name: p.Name,
+		})
+	}
+
+	return users, nil
+}
+
+// breakoutRoomsByCount will return the number of rooms required
+// so that no room has more than usersPerRoom users
+func breakoutRoomsByCount(totalUsers, usersPerRoom int) int {
+	return (totalUsers + usersPerRoom - 1) / usersPerRoom
+}
+
+// assignEvenly will shuffle the users & distribute them among the rooms
+// so that the size of the rooms differs by at most one
+func (m *BreakoutRoomModel) assignEvenly(users []*plugnmeet.BreakoutRoomUser, numberOfRooms int) [][]*plugnmeet.BreakoutRoomUser {
+	rand.Shuffle(len(users), func(i, j int) {
+		users[i], users[j] = users[j], users[i]
+	})
+
+	groups := make([][]*plugnmeet.BreakoutRoomUser, numberOfRooms)
+	for i, u := range users {
+		groups[i%numberOfRooms] = append(groups[i%numberOfRooms], u)
+	}
+	return groups
+}
+
+// assignByPreviousGrouping will keep the users in the same room as last time.
+// New users will be added to the smallest room
+func (m *BreakoutRoomModel) assignByPreviousGrouping(roomId string, users []*plugnmeet.BreakoutRoomUser, numberOfRooms int) ([][]*plugnmeet.BreakoutRoomUser, error) {
+	grouping, err := m.rs.BreakoutRoomGetGrouping(roomId)
+	if err != nil {
+		return nil, err
+	}
+	return groupByPreviousGrouping(grouping, users, numberOfRooms)
+}
+
+// groupByPreviousGrouping will use userId => breakout room id of the last grouping,
+// 0 numberOfRooms means the same number of rooms as last time
+func groupByPreviousGrouping(grouping map[string]string, users []*plugnmeet.BreakoutRoomUser, numberOfRooms int) ([][]*plugnmeet.BreakoutRoomUser, error) {
+	if len(grouping) == 0 {
+		return nil, errors.New("no previous grouping found")
+	}
+
+	// previous room ids are "1", "2"... but can be anything if rooms were created manually
+	var previousIds []string
+	for _, id := range grouping {
+		if !slices.Contains(previousIds, id) {
+			previousIds = append(previousIds, id)
+		}
+	}
+	sort.Slice(previousIds, func(i, j int) bool {
+		a, errA := strconv.Atoi(previousIds[i])
+		b, errB := strconv.Atoi(previousIds[j])
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return previousIds[i] < previousIds[j]
+	})
+
+	if numberOfRooms == 0 {
+		numberOfRooms = len(previousIds)
+	} else if numberOfRooms < len(previousIds) {
+		return nil, fmt.Errorf("number_of_rooms can't be less than the number of previous rooms: %d", len(previousIds))
+	}
+	groups := make([][]*plugnmeet.BreakoutRoomUser, numberOfRooms)
+
+	var newUsers []*plugnmeet.BreakoutRoomUser
+	for _, u := range users {
+		if id, ok := grouping[u.Id]; ok {
+			i := slices.Index(previousIds, id)
+			groups[i] = append(groups[i], u)
+		} else {
+			newUsers = append(newUsers, u)
+		}
+	}
+
+	for _, u := range newUsers {
+		smallest := 0
+		for i, g := range groups {
+			if len(g) < len(groups[smallest]) {
+				smallest = i
+			}
+		}
+		groups[smallest] = append(groups[smallest], u)
+	}
+
+	return groups, nil
+}
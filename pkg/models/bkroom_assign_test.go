@@ -0,0 +1,154 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func newTestBreakoutRoomUsers(ids ...string) []*plugnmeet.BreakoutRoomUser {
+	var users []*plugnmeet.BreakoutRoomUser
+	for _, id := range ids {
+		users = append(users, &plugnmeet.BreakoutRoomUser{Id: id, Name: id})
+	}
+	return users
+}
+
+func breakoutRoomGroupIds(groups [][]*plugnmeet.BreakoutRoomUser) []string {
+	var res []string
+	for _, g := range groups {
+		var ids []string
+		for _, u := range g {
+			ids = append(ids, u.Id)
+		}
+		sort.Strings(ids)
+		res = append(res, strings.Join(ids, ","))
+	}
+	return res
+}
+
+func TestBreakoutRoomModel_AssignEvenly(t *testing.T) {
+	m := &BreakoutRoomModel{}
+	tests := []struct {
+		totalUsers    int
+		numberOfRooms int
+		want          []int
+	}{
+		{totalUsers: 6, numberOfRooms: 3, want: []int{2, 2, 2}},
+		{totalUsers: 7, numberOfRooms: 3, want: []int{3, 2, 2}},
+		{totalUsers: 2, numberOfRooms: 4, want: []int{1, 1, 0, 0}},
+		{totalUsers: 5, numberOfRooms: 1, want: []int{5}},
+	}
+
+	for _, tt := range tests {
+		var ids []string
+		for i := range tt.totalUsers {
+			ids = append(ids, fmt.Sprintf("user%d", i))
+		}
+		groups := m.assignEvenly(newTestBreakoutRoomUsers(ids...), tt.numberOfRooms)
+
+		var sizes []int
+		seen := make(map[string]bool)
+		for _, g := range groups {
+			sizes = append(sizes, len(g))
+			for _, u := range g {
+				if seen[u.Id] {
+					t.Errorf("user %s was assigned more than once", u.Id)
+				}
+				seen[u.Id] = true
+			}
+		}
+		if fmt.Sprint(sizes) != fmt.Sprint(tt.want) {
+			t.Errorf("assignEvenly(%d users, %d rooms) sizes = %v, want %v", tt.totalUsers, tt.numberOfRooms, sizes, tt.want)
+		}
+		if len(seen) != tt.totalUsers {
+			t.Errorf("assignEvenly(%d users, %d rooms) assigned %d users", tt.totalUsers, tt.numberOfRooms, len(seen))
+		}
+	}
+}
+
+func TestBreakoutRoomsByCount(t *testing.T) {
+	tests := []struct {
+		totalUsers   int
+		usersPerRoom int
+		want         int
+	}{
+		{totalUsers: 10, usersPerRoom: 5, want: 2},
+		{totalUsers: 11, usersPerRoom: 5, want: 3},
+		{totalUsers: 4, usersPerRoom: 5, want: 1},
+		{totalUsers: 1, usersPerRoom: 1, want: 1},
+		{totalUsers: 7, usersPerRoom: 2, want: 4},
+	}
+
+	for _, tt := range tests {
+		if got := breakoutRoomsByCount(tt.totalUsers, tt.usersPerRoom); got != tt.want {
+			t.Errorf("breakoutRoomsByCount(%d, %d) = %d, want %d", tt.totalUsers, tt.usersPerRoom, got, tt.want)
+		}
+	}
+}
+
+func TestGroupByPreviousGrouping(t *testing.T) {
+	grouping := map[string]string{
+		"u1": "1",
+		"u2": "1",
+		"u3": "2",
+		"u4": "10",
+		// user who isn't online anymore
+		"u5": "2",
+	}
+
+	tests := []struct {
+		name          string
+		users         []string
+		numberOfRooms int
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:  "same rooms in numeric order",
+			users: []string{"u1", "u2", "u3", "u4"},
+			want:  []string{"u1,u2", "u3", "u4"},
+		},
+		{
+			name:  "new users will be added to the smallest room",
+			users: []string{"u1", "u2", "u3", "u4", "n1", "n2", "n3"},
+			want:  []string{"n3,u1,u2", "n1,u3", "n2,u4"},
+		},
+		{
+			name:          "extra rooms",
+			users:         []string{"u1", "u2", "u3", "u4", "n1"},
+			numberOfRooms: 4,
+			want:          []string{"u1,u2", "u3", "u4", "n1"},
+		},
+		{
+			name:          "less rooms than previous",
+			users:         []string{"u1"},
+			numberOfRooms: 2,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := groupByPreviousGrouping(grouping, newTestBreakoutRoomUsers(tt.users...), tt.numberOfRooms)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := breakoutRoomGroupIds(groups); strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("groups = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := groupByPreviousGrouping(nil, newTestBreakoutRoomUsers("u1"), 0); err == nil {
+		t.Error("expected error without previous grouping")
+	}
+}
@@ -42,21 +42,28 @@ func (m *BreakoutRoomModel) CreateBreakoutRooms(ctx context.Context, r *plugnmee
 	meta.RoomFeatures.BreakoutRoomFeatures.IsAllow = false
 	meta.RoomFeatures.WaitingRoomFeatures.IsActive = false
 
-	// we'll disable now. in the future, we can think about those
-	meta.RoomFeatures.RecordingFeatures.IsAllow = false
-	meta.RoomFeatures.AllowRtmp = false
+	// recording & rtmp will be inherited from the parent room,
+	// but auto recording may use all the recorders when many rooms start together
+	meta.RoomFeatures.RecordingFeatures.EnableAutoCloudRecording = false
 
 	// clear few main room data
 	meta.RoomFeatures.DisplayExternalLinkFeatures.IsActive = false
 	meta.RoomFeatures.ExternalMediaPlayerFeatures.IsActive = false
 
+	analyticsModel := NewAnalyticsModel(m.app, m.ds, m.rs)
 	e := make(map[string]bool)
+	// userId => breakout room id, will be used to preserve the grouping next time
+	grouping := make(map[string]string)
 
 	for _, room := range r.Rooms {
 		bRoom := new(plugnmeet.CreateRoomReq)
 		bRoom.RoomId = fmt.Sprintf(BreakoutRoomFormat, r.RoomId, room.Id)
 		meta.RoomTitle = room.Title
 		bRoom.Metadata = meta
+		if meta.RoomFeatures.EnableAnalytics {
+			// should be registered before creating the room to collect all the events
+			analyticsModel.AddBreakoutRoom(r.RoomId, bRoom.RoomId, room.Title)
+		}
 		_, err := m.rm.CreateRoom(ctx, bRoom)
 
 		if err != nil {
@@ -84,6 +91,7 @@ func (m *BreakoutRoomModel) CreateBreakoutRooms(ctx context.Context, r *plugnmee
 
 		// now send invitation notification
 		for _, u := range room.Users {
+			grouping[u.Id] = room.Id
 			err = m.natsService.BroadcastSystemEventToRoom(plugnmeet.NatsMsgServerToClientEvents_JOIN_BREAKOUT_ROOM, r.RoomId, bRoom.RoomId, &u.Id)
 			if err != nil {
 				log.Error(err)
@@ -96,6 +104,12 @@ func (m *BreakoutRoomModel) CreateBreakoutRooms(ctx context.Context, r *plugnmee
 		return errors.New("breakout room creation wasn't successful")
 	}
 
+	if len(grouping) > 0 {
+		if err := m.rs.BreakoutRoomSaveGrouping(r.RoomId, grouping, breakoutRoomGroupingTTL); err != nil {
+			log.Errorln(err)
+		}
+	}
+
 	// again here for update
 	origMeta, err := m.natsService.UnmarshalRoomMetadata(mainRoom.Metadata)
 	if err != nil {
@@ -105,7 +119,6 @@ func (m *BreakoutRoomModel) CreateBreakoutRooms(ctx context.Context, r *plugnmee
 	err = m.natsService.UpdateAndBroadcastRoomMetadata(r.RoomId, origMeta)
 
 	// send analytics
-	analyticsModel := NewAnalyticsModel(m.app, m.ds, m.rs)
 	analyticsModel.HandleEvent(&plugnmeet.AnalyticsDataMsg{
 		EventType: plugnmeet.AnalyticsEventType_ANALYTICS_EVENT_TYPE_ROOM,
 		EventName: plugnmeet.AnalyticsEvents_ANALYTICS_EVENT_ROOM_BREAKOUT_ROOM,
@@ -53,6 +53,8 @@ func (m *BreakoutRoomModel) onAfterBkRoomEnded(parentRoomId, bkRoomId string) {
 	if c, err := m.natsService.CountBreakoutRooms(parentRoomId); err == nil && c == 0 {
 		// no room left so, delete breakoutRoomKey key for this room
 		m.natsService.DeleteAllBreakoutRoomsByParentRoomId(parentRoomId)
+		_ = m.rs.BreakoutRoomDisableSelfSelect(parentRoomId)
+		_, _ = m.rs.BreakoutRoomReturnFinish(parentRoomId)
 		_ = m.updateParentRoomMetadata(parentRoomId)
 	}
 	// notify to the room for updating list
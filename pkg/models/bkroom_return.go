@@ -0,0 +1,252 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+const (
+	BreakoutRoomUserReturnedToMainRoom = "main_room"
+	BreakoutRoomUserInBreakoutRoom     = "breakout_room"
+	BreakoutRoomUserLeft               = "left"
+
+	// breakoutRoomReturnLastWarning is the remaining time when participants will be warned again
+	breakoutRoomReturnLastWarning = 10 * time.Second
+)
+
+// BreakoutRoomReturnedUser will be sent with breakout_rooms_returned webhook event
+type BreakoutRoomReturnedUser struct {
+	UserId         string `json:"user_id"`
+	Name           string `json:"name"`
+	BreakoutRoomId string `json:"breakout_room_id"`
+	// Status can be main_room, breakout_room or left
+	Status string `json:"status"`
+}
+
+// ReturnToMainRoom will start the countdown to bring participants back from all breakout rooms.
+// The scheduler will send the main room tokens after the countdown & end the rooms after the grace period
+func (m *BreakoutRoomModel) ReturnToMainRoom(r *protocol.ReturnToMainRoomReq) error {
+	c, err := m.natsService.CountBreakoutRooms(r.RoomId)
+	if err != nil {
+		return err
+	}
+	if c == 0 {
+		return errors.New("no breakout rooms found")
+	}
+
+	countdown := m.app.BreakoutRoomSettings.ReturnCountdown
+	if countdown <= 0 {
+		countdown = config.DefaultBreakoutRoomReturnCountdown
+	}
+	if r.Countdown != nil && *r.Countdown >= 0 {
+		countdown = time.Duration(*r.Countdown) * time.Second
+	}
+	gracePeriod := m.app.BreakoutRoomSettings.ReturnGracePeriod
+	if r.GracePeriod != nil && *r.GracePeriod >= 0 {
+		gracePeriod = time.Duration(*r.GracePeriod) * time.Second
+	}
+
+	now := time.Now()
+	returnAt := now.Add(countdown)
+	endAt := returnAt.Add(gracePeriod)
+	ok, err := m.rs.BreakoutRoomReturnStart(r.RoomId, returnAt.Unix(), endAt.Unix(), countdown+gracePeriod+time.Hour)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("returning to the main room is already in progress")
+	}
+
+	if countdown > 0 {
+		m.notifyReturnCountdown(r.RoomId, int64(countdown.Seconds()))
+		return nil
+	}
+
+	m.CheckReturnToMainRoom(context.Background(), r.RoomId)
+	return nil
+}
+
+// CheckReturnToMainRoom will be called by the scheduler to proceed with the return
+func (m *BreakoutRoomModel) CheckReturnToMainRoom(ctx context.Context, roomId string) {
+	info, err := m.rs.BreakoutRoomReturnGet(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	if info == nil {
+		return
+	}
+
+	returnAt, _ := strconv.ParseInt(info[redisservice.BreakoutRoomReturnAtField], 10, 64)
+	endAt, _ := strconv.ParseInt(info[redisservice.BreakoutRoomReturnEndAtField], 10, 64)
+	now := time.Now().Unix()
+
+	if now < returnAt {
+		remaining := returnAt - now
+		if remaining <= int64(breakoutRoomReturnLastWarning.Seconds()) {
+			if marked, _ := m.rs.BreakoutRoomReturnMark(roomId, redisservice.BreakoutRoomReturnWarnedField); marked {
+				m.notifyReturnCountdown(roomId, remaining)
+			}
+		}
+		return
+	}
+
+	if marked, _ := m.rs.BreakoutRoomReturnMark(roomId, redisservice.BreakoutRoomReturnSentField); marked {
+		m.sendMainRoomTokens(ctx, roomId)
+	}
+
+	if now < endAt {
+		return
+	}
+
+	finished, err := m.rs.BreakoutRoomReturnFinish(roomId)
+	if err != nil || !finished {
+		return
+	}
+
+	// users' status should be collected before ending the rooms
+	users := m.getReturnedUsers(roomId)
+	if err = m.EndAllBreakoutRoomsByParentRoomId(ctx, roomId); err != nil {
+		log.Errorln(err)
+	}
+	m.sendReturnedWebhook(roomId, users)
+}
+
+// sendMainRoomTokens will re-issue tokens of the main room to the participants who aren't online there.
+// Client will use the token of RETURN_TO_MAIN_ROOM event to reconnect to the main room
+func (m *BreakoutRoomModel) sendMainRoomTokens(ctx context.Context, roomId string) {
+	rooms, err := m.fetchBreakoutRooms(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	um := NewUserModel(m.app, m.ds, m.rs)
+	for _, rr := range rooms {
+		for _, u := range rr.Users {
+			status, err := m.natsService.GetRoomUserStatus(roomId, u.Id)
+			if err == nil && status == natsservice.UserStatusOnline {
+				// already online in the main room, generating a new token will log out the user
+				continue
+			}
+
+			p, meta, err := m.natsService.GetUserWithMetadata(roomId, u.Id)
+			if err != nil || p == nil {
+				log.Errorln(fmt.Sprintf("roomId: %s; unable to get info of userId: %s; error: %v", roomId, u.Id, err))
+				continue
+			}
+
+			// user was already admitted to the main room, so no need to wait again
+			token, err := um.getPNMJoinToken(ctx, &plugnmeet.GenerateTokenReq{
+				RoomId: roomId,
+				UserInfo: &plugnmeet.UserInfo{
+					UserId:       u.Id,
+					Name:         p.Name,
+					IsAdmin:      meta.IsAdmin,
+					UserMetadata: meta,
+				},
+			}, true)
+			if err != nil {
+				log.Errorln(err)
+				continue
+			}
+
+			event := plugnmeet.NatsMsgServerToClientEvents(protocol.NatsMsgServerToClientExtraEvents_RETURN_TO_MAIN_ROOM)
+			err = m.natsService.BroadcastSystemEventToRoom(event, rr.Id, &protocol.ReturnToMainRoomMsg{
+				MainRoomId: roomId,
+				Token:      token,
+			}, &u.Id)
+			if err != nil {
+				log.Errorln(err)
+			}
+		}
+	}
+}
+
+func (m *BreakoutRoomModel) getReturnedUsers(roomId string) []*BreakoutRoomReturnedUser {
+	rooms, err := m.fetchBreakoutRooms(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return nil
+	}
+
+	var users []*BreakoutRoomReturnedUser
+	for _, rr := range rooms {
+		for _, u := range rr.Users {
+			ru := &BreakoutRoomReturnedUser{
+				UserId:         u.Id,
+				Name:           u.Name,
+				BreakoutRoomId: rr.Id,
+				Status:         BreakoutRoomUserLeft,
+			}
+			if status, err := m.natsService.GetRoomUserStatus(roomId, u.Id); err == nil && status == natsservice.UserStatusOnline {
+				ru.Status = BreakoutRoomUserReturnedToMainRoom
+			} else if u.Joined {
+				ru.Status = BreakoutRoomUserInBreakoutRoom
+			}
+			users = append(users, ru)
+		}
+	}
+
+	return users
+}
+
+func (m *BreakoutRoomModel) sendReturnedWebhook(roomId string, users []*BreakoutRoomReturnedUser) {
+	n := helpers.GetWebhookNotifier(m.app)
+	if n == nil {
+		return
+	}
+
+	marshal, err := json.Marshal(users)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	// protocol doesn't have any dedicated field for this,
+	// so the list of users will be sent as metadata
+	e := "breakout_rooms_returned"
+	metadata := string(marshal)
+	msg := &plugnmeet.CommonNotifyEvent{
+		Event: &e,
+		Room: &plugnmeet.NotifyEventRoom{
+			RoomId:   &roomId,
+			Metadata: &metadata,
+		},
+	}
+	if info, err := m.natsService.GetRoomInfo(roomId); err == nil && info != nil {
+		msg.Room.Sid = &info.RoomSid
+	}
+
+	if err = n.SendWebhookEvent(msg); err != nil {
+		log.Errorln(err)
+	}
+}
+
+func (m *BreakoutRoomModel) notifyReturnCountdown(roomId string, seconds int64) {
+	ids, err := m.natsService.GetBreakoutRoomIdsByParentRoomId(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	params := map[string]string{
+		"seconds": strconv.FormatInt(seconds, 10),
+	}
+	for _, id := range ids {
+		if err := m.natsService.NotifyI18nWarningMsg(id, "notifications.breakout-room-return-countdown", params, true, nil); err != nil {
+			log.Errorln(err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+)
+
+const (
+	ChatArchiveFormatJson = "json"
+	ChatArchiveFormatText = "txt"
+)
+
+type ChatArchiveModel struct {
+	app         *config.AppConfig
+	ds          *dbservice.DatabaseService
+	natsService *natsservice.NatsService
+	storage     storageservice.Storage
+}
+
+func NewChatArchiveModel(app *config.AppConfig, ds *dbservice.DatabaseService) *ChatArchiveModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if ds == nil {
+		ds = dbservice.New(app.DB)
+	}
+
+	return &ChatArchiveModel{
+		app:         app,
+		ds:          ds,
+		natsService: natsservice.New(app),
+		storage:     storageservice.New(app, storageservice.AreaChatArchives),
+	}
+}
+
+// Storage returns the storage of the chat archive files
+func (m *ChatArchiveModel) Storage() storageservice.Storage {
+	return m.storage
+}
+
+// ChatTranscript is the content of the stored json file
+type ChatTranscript struct {
+	RoomId        string                   `json:"room_id"`
+	RoomSid       string                   `json:"room_sid"`
+	RoomTitle     string                   `json:"room_title"`
+	RoomCreation  int64                    `json:"room_creation"`
+	RoomEnded     int64                    `json:"room_ended"`
+	TotalMessages int64                    `json:"total_messages"`
+	Messages      []*ChatTranscriptMessage `json:"messages"`
+}
+
+type ChatTranscriptMessage struct {
+	Id         string `json:"id"`
+	FromUserId string `json:"from_user_id"`
+	FromName   string `json:"from_name"`
+	FromAdmin  bool   `json:"from_admin"`
+	ToUserId   string `json:"to_user_id,omitempty"`
+	IsPrivate  bool   `json:"is_private"`
+	SentAt     int64  `json:"sent_at"`
+	Message    string `json:"message"`
+}
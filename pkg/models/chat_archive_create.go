@@ -0,0 +1,158 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"time"
+)
+
+// ArchiveRoomChat will store chat messages of the session if the room had enabled it.
+// It must be called before the room stream has been deleted
+func (m *ChatArchiveModel) ArchiveRoomChat(roomId, roomSid string) error {
+	if m.app.ChatArchiveSettings == nil || !m.app.ChatArchiveSettings.Enabled || roomSid == "" {
+		return nil
+	}
+
+	isRunning := 0
+	room, err := m.ds.GetRoomInfoBySid(roomSid, &isRunning)
+	if err != nil {
+		return err
+	}
+	if room == nil || room.ChatArchive == 0 {
+		return nil
+	}
+
+	data, err := m.natsService.GetRoomChatMessages(roomId)
+	if err != nil {
+		return err
+	}
+
+	transcript := &ChatTranscript{
+		RoomId:       room.RoomId,
+		RoomSid:      room.Sid,
+		RoomTitle:    room.RoomTitle,
+		RoomCreation: room.Created.Unix(),
+		RoomEnded:    time.Now().Unix(),
+		Messages:     []*ChatTranscriptMessage{},
+	}
+	for _, d := range data {
+		msg := new(plugnmeet.ChatMessage)
+		if err := proto.Unmarshal(d, msg); err != nil {
+			log.Errorln(fmt.Sprintf("roomSid: %s, unable to read chat message: %s", roomSid, err.Error()))
+			continue
+		}
+		transcript.Messages = append(transcript.Messages, &ChatTranscriptMessage{
+			Id:         msg.GetId(),
+			FromUserId: msg.GetFromUserId(),
+			FromName:   msg.GetFromName(),
+			FromAdmin:  msg.GetFromAdmin(),
+			ToUserId:   msg.GetToUserId(),
+			IsPrivate:  msg.GetIsPrivate(),
+			SentAt:     msg.GetSentAt(),
+			Message:    msg.GetMessage(),
+		})
+	}
+	transcript.TotalMessages = int64(len(transcript.Messages))
+
+	fileName := fmt.Sprintf("%s-%d", room.Sid, room.CreationTime)
+	size, err := m.writeTranscriptFiles(fileName, transcript)
+	if err != nil {
+		return err
+	}
+
+	info := &dbmodels.ChatArchive{
+		ArchiveId:        uuid.NewString(),
+		RoomTableID:      room.ID,
+		RoomId:           room.RoomId,
+		RoomSid:          room.Sid,
+		TenantId:         room.TenantId,
+		FileName:         fileName,
+		FileSize:         size,
+		TotalMessages:    transcript.TotalMessages,
+		RoomCreationTime: room.CreationTime,
+	}
+	_, err = m.ds.InsertChatArchive(info)
+	if err != nil {
+		return err
+	}
+
+	log.Infoln(fmt.Sprintf("chat of roomId: %s, roomSid: %s had been archived with %d messages", room.RoomId, room.Sid, transcript.TotalMessages))
+	go m.sendToWebhookNotifier(info)
+
+	return nil
+}
+
+// writeTranscriptFiles will write both json & plain text files
+// and will return the size of the json file in KB
+func (m *ChatArchiveModel) writeTranscriptFiles(fileName string, transcript *ChatTranscript) (float64, error) {
+	marshal, err := json.MarshalIndent(transcript, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := context.Background()
+	key := fmt.Sprintf("%s.%s", fileName, ChatArchiveFormatJson)
+	if err = m.storage.Put(ctx, key, bytes.NewReader(marshal), int64(len(marshal)), "application/json"); err != nil {
+		return 0, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Room: %s (%s)\n", transcript.RoomTitle, transcript.RoomId))
+	sb.WriteString(fmt.Sprintf("Session: %s\n", transcript.RoomSid))
+	sb.WriteString(fmt.Sprintf("Started: %s\n", time.Unix(transcript.RoomCreation, 0).UTC().Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("Ended: %s\n\n", time.Unix(transcript.RoomEnded, 0).UTC().Format(time.RFC3339)))
+	for _, msg := range transcript.Messages {
+		from := msg.FromName
+		if msg.IsPrivate && msg.ToUserId != "" {
+			from = fmt.Sprintf("%s (private to %s)", from, msg.ToUserId)
+		}
+		sb.WriteString(fmt.Sprintf("[%s] %s: %s\n", time.UnixMilli(msg.SentAt).UTC().Format(time.RFC3339), from, msg.Message))
+	}
+
+	key = fmt.Sprintf("%s.%s", fileName, ChatArchiveFormatText)
+	if err = m.storage.Put(ctx, key, strings.NewReader(sb.String()), int64(sb.Len()), "text/plain; charset=utf-8"); err != nil {
+		return 0, err
+	}
+
+	fSize := float64(len(marshal))
+	if fSize > 1000 {
+		fSize = fSize / 1000.0
+	} else {
+		fSize = 1
+	}
+
+	return helpers.ToFixed(fSize, 2), nil
+}
+
+func (m *ChatArchiveModel) sendToWebhookNotifier(info *dbmodels.ChatArchive) {
+	n := helpers.GetWebhookNotifier(m.app)
+	if n != nil {
+		e := "chat_archived"
+		msg := &plugnmeet.CommonNotifyEvent{
+			Event: &e,
+			Room: &plugnmeet.NotifyEventRoom{
+				Sid:    &info.RoomSid,
+				RoomId: &info.RoomId,
+			},
+			// protocol doesn't have any dedicated field for chat,
+			// so archive_id will be sent as file_id
+			Analytics: &plugnmeet.AnalyticsEvent{
+				FileId: &info.ArchiveId,
+			},
+		}
+
+		err := n.SendWebhookEvent(msg)
+		if err != nil {
+			log.Errorln(err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// FetchChatArchives will return archives, empty tenantId will return archives of all tenants
+func (m *ChatArchiveModel) FetchChatArchives(r *protocol.FetchChatArchivesReq, tenantId string) (*protocol.FetchChatArchivesResult, error) {
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+	if r.OrderBy == "" {
+		r.OrderBy = "DESC"
+	}
+
+	archives, total, err := m.ds.GetChatArchives(r.RoomIds, tenantId, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.ChatArchiveInfo
+	for _, v := range archives {
+		list = append(list, prepareChatArchiveInfo(&v))
+	}
+
+	return &protocol.FetchChatArchivesResult{
+		TotalArchives: total,
+		From:          r.From,
+		Limit:         r.Limit,
+		OrderBy:       r.OrderBy,
+		ArchivesList:  list,
+	}, nil
+}
+
+// fetchChatArchive will return the archive if the tenant has access to it
+func (m *ChatArchiveModel) fetchChatArchive(archiveId, tenantId string) (*dbmodels.ChatArchive, error) {
+	info, err := m.ds.GetChatArchive(archiveId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return nil, errors.New("no archive found")
+	}
+
+	return info, nil
+}
+
+func prepareChatArchiveInfo(info *dbmodels.ChatArchive) *protocol.ChatArchiveInfo {
+	return &protocol.ChatArchiveInfo{
+		ArchiveId:        info.ArchiveId,
+		RoomId:           info.RoomId,
+		RoomSid:          info.RoomSid,
+		TotalMessages:    info.TotalMessages,
+		FileSize:         info.FileSize,
+		RoomCreationTime: info.RoomCreationTime,
+		CreationTime:     info.CreationTime,
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"strings"
+	"time"
+)
+
+// GetChatArchiveDownloadToken will use the same JWT token generator as plugNmeet is using
+func (m *ChatArchiveModel) GetChatArchiveDownloadToken(r *protocol.GetChatArchiveDownloadTokenReq, tenantId string) (string, error) {
+	if m.app.ChatArchiveSettings == nil {
+		return "", errors.New("chat archive isn't enabled")
+	}
+
+	format := r.GetFormat()
+	if format == "" {
+		format = ChatArchiveFormatJson
+	}
+
+	archive, err := m.fetchChatArchive(r.GetArchiveId(), tenantId)
+	if err != nil {
+		return "", err
+	}
+
+	return m.generateToken(fmt.Sprintf("%s.%s", archive.FileName, format))
+}
+
+func (m *ChatArchiveModel) generateToken(fileName string) (string, error) {
+	return generateFileToken(m.app, chatArchiveDownloadAudience, fileName, time.Now().UTC().Add(*m.app.ChatArchiveSettings.TokenValidity))
+}
+
+// VerifyChatArchiveToken verify token & provide the key of the file in the storage
+func (m *ChatArchiveModel) VerifyChatArchiveToken(token string) (string, int, error) {
+	if m.app.ChatArchiveSettings == nil {
+		return "", fiber.StatusNotFound, errors.New("chat archive isn't enabled")
+	}
+
+	key, status, err := verifyFileToken(m.app, token, chatArchiveDownloadAudience)
+	if err != nil {
+		return "", status, err
+	}
+
+	_, err = m.storage.Stat(context.Background(), key)
+	if err != nil {
+		ms := strings.SplitN(err.Error(), "/", -1)
+		return "", fiber.StatusNotFound, errors.New(ms[len(ms)-1])
+	}
+
+	return key, fiber.StatusOK, nil
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	log "github.com/sirupsen/logrus"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	ChatModerationReasonBannedWord = "banned_word"
+	ChatModerationReasonPattern    = "pattern"
+	ChatModerationReasonLink       = "link"
+)
+
+var chatModerationLinkRegex = regexp.MustCompile(`(?i)\b(?:https?://|ftp://|www\.)\S+`)
+
+type chatModerationRule struct {
+	reason string
+	regex  *regexp.Regexp
+	// wholeWord will ignore the matches which are part of another word
+	wholeWord bool
+}
+
+type ChatModerationModel struct {
+	app            *config.AppConfig
+	rs             *redisservice.RedisService
+	natsService    *natsservice.NatsService
+	analyticsModel *AnalyticsModel
+	rateLimiter    *natsRateLimiter
+	rules          []*chatModerationRule
+}
+
+func NewChatModerationModel(app *config.AppConfig, rs *redisservice.RedisService) *ChatModerationModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if rs == nil {
+		rs = redisservice.New(app.RDS)
+	}
+
+	natsService := natsservice.New(app)
+
+	m := &ChatModerationModel{
+		app:            app,
+		rs:             rs,
+		natsService:    natsService,
+		analyticsModel: NewAnalyticsModel(app, nil, rs),
+		rateLimiter:    newNatsRateLimiter(app, rs, natsService, NewUserModel(app, nil, rs)),
+	}
+	if app.ChatModerationSettings != nil && app.ChatModerationSettings.Enabled {
+		m.rules = prepareChatModerationRules(app.ChatModerationSettings)
+	}
+
+	return m
+}
+
+// prepareChatModerationRules will compile all the rules once,
+// invalid patterns will be ignored
+func prepareChatModerationRules(s *config.ChatModerationSettings) []*chatModerationRule {
+	var rules []*chatModerationRule
+
+	var words []string
+	for _, w := range s.BannedWords {
+		if w = strings.TrimSpace(w); w != "" {
+			words = append(words, regexp.QuoteMeta(w))
+		}
+	}
+	if len(words) > 0 {
+		// longer words first, otherwise a shorter prefix would hide the full word
+		sort.SliceStable(words, func(i, j int) bool {
+			return len(words[i]) > len(words[j])
+		})
+		// \b of regexp is ASCII only, so boundaries will be checked by the rule
+		rules = append(rules, &chatModerationRule{
+			reason:    ChatModerationReasonBannedWord,
+			regex:     regexp.MustCompile(fmt.Sprintf(`(?i)(?:%s)`, strings.Join(words, "|"))),
+			wholeWord: true,
+		})
+	}
+
+	for _, p := range s.Patterns {
+		rg, err := regexp.Compile(p)
+		if err != nil {
+			log.Errorln(fmt.Sprintf("invalid chat moderation pattern: %s, error: %s", p, err.Error()))
+			continue
+		}
+		rules = append(rules, &chatModerationRule{
+			reason: ChatModerationReasonPattern,
+			regex:  rg,
+		})
+	}
+
+	if s.BlockLinks {
+		rules = append(rules, &chatModerationRule{
+			reason: ChatModerationReasonLink,
+			regex:  chatModerationLinkRegex,
+		})
+	}
+
+	return rules
+}
+
+// applyRules will return the reasons of all matched rules with the masked message
+func (m *ChatModerationModel) applyRules(msg string) ([]string, string) {
+	var reasons []string
+	for _, r := range m.rules {
+		matches := r.findAll(msg)
+		if len(matches) == 0 {
+			continue
+		}
+		reasons = append(reasons, r.reason)
+
+		var sb strings.Builder
+		last := 0
+		for _, loc := range matches {
+			sb.WriteString(msg[last:loc[0]])
+			sb.WriteString(strings.Repeat("*", utf8.RuneCountInString(msg[loc[0]:loc[1]])))
+			last = loc[1]
+		}
+		sb.WriteString(msg[last:])
+		msg = sb.String()
+	}
+
+	return reasons, msg
+}
+
+// findAll will return the positions of all the matches of the rule
+func (r *chatModerationRule) findAll(msg string) [][]int {
+	matches := r.regex.FindAllStringIndex(msg, -1)
+	if !r.wholeWord {
+		return matches
+	}
+
+	var words [][]int
+	for _, loc := range matches {
+		before, _ := utf8.DecodeLastRuneInString(msg[:loc[0]])
+		after, _ := utf8.DecodeRuneInString(msg[loc[1]:])
+		if isChatModerationWordRune(before) || isChatModerationWordRune(after) {
+			continue
+		}
+		words = append(words, loc)
+	}
+	return words
+}
+
+func isChatModerationWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+// HandleChatMessage will check the chat message received from the ingress worker
+// & publish it to the room if allowed
+func (m *ChatModerationModel) HandleChatMessage(roomId, userId string, data []byte) {
+	msg := new(plugnmeet.ChatMessage)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		log.Errorln(fmt.Sprintf("roomId: %s, userId: %s, unable to read chat message: %s", roomId, userId, err.Error()))
+		return
+	}
+	// user can't send messages on behalf of others
+	if msg.FromUserId != userId {
+		log.Warnln(fmt.Sprintf("roomId: %s, userId: %s, tried to send chat message as: %s", roomId, userId, msg.FromUserId))
+		return
+	}
+
+	if !m.rateLimiter.allow(roomId, userId, NatsRateLimitEventChat) {
+		return
+	}
+
+	settings := m.app.ChatModerationSettings
+	reasons, masked := m.applyRules(msg.Message)
+	if len(reasons) > 0 {
+		m.onModerated(roomId, msg, settings.Action, reasons)
+		switch settings.Action {
+		case config.ChatModerationActionDrop:
+			return
+		case config.ChatModerationActionMask:
+			msg.Message = masked
+			marshal, err := proto.Marshal(msg)
+			if err != nil {
+				log.Errorln(err)
+				return
+			}
+			data = marshal
+		}
+	}
+
+	if err := m.natsService.PublishCheckedMessage(roomId, userId, natsservice.IngressChat, data); err != nil {
+		log.Errorln(fmt.Sprintf("roomId: %s, userId: %s, error publishing chat message: %s", roomId, userId, err.Error()))
+	}
+}
+
+// onModerated will record the analytics event & notify the sender & admins
+func (m *ChatModerationModel) onModerated(roomId string, msg *plugnmeet.ChatMessage, action string, reasons []string) {
+	reason := strings.Join(reasons, ",")
+	log.Infoln(fmt.Sprintf("roomId: %s, chat message from userId: %s has been moderated with action: %s, reason: %s", roomId, msg.FromUserId, action, reason))
+
+	m.analyticsModel.handleServerUserEvent(roomId, msg.FromUserId, analyticsEventUserChatMessageBlocked, fmt.Sprintf("%s:%s", action, reason))
+
+	if action == config.ChatModerationActionDrop {
+		_ = m.natsService.NotifyErrorMsg(roomId, "notifications.chat-message-blocked", &msg.FromUserId)
+	}
+
+	if !m.app.ChatModerationSettings.NotifyAdmins {
+		return
+	}
+	users, err := m.natsService.GetOnlineUsersList(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	params := map[string]string{
+		"name":   msg.FromName,
+		"action": action,
+		"reason": reason,
+	}
+	for _, u := range users {
+		if u.IsAdmin && u.UserId != msg.FromUserId {
+			_ = m.natsService.NotifyI18nWarningMsg(roomId, "notifications.chat-message-moderated", params, false, &u.UserId)
+		}
+	}
+}
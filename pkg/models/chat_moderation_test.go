@@ -0,0 +1,111 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"strings"
+	"testing"
+)
+
+func TestPrepareChatModerationRules(t *testing.T) {
+	rules := prepareChatModerationRules(&config.ChatModerationSettings{
+		BannedWords: []string{" badword ", "", "c++"},
+		// invalid pattern will be ignored
+		Patterns:   []string{`\d{4}-\d{4}`, `(invalid`},
+		BlockLinks: true,
+	})
+
+	var reasons []string
+	for _, r := range rules {
+		reasons = append(reasons, r.reason)
+	}
+	want := []string{ChatModerationReasonBannedWord, ChatModerationReasonPattern, ChatModerationReasonLink}
+	if strings.Join(reasons, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected rules: %v, want: %v", reasons, want)
+	}
+
+	if rules := prepareChatModerationRules(&config.ChatModerationSettings{BannedWords: []string{" "}}); len(rules) != 0 {
+		t.Errorf("empty banned words should not create any rule, got: %d", len(rules))
+	}
+}
+
+func TestChatModerationModel_ApplyRules(t *testing.T) {
+	m := &ChatModerationModel{
+		rules: prepareChatModerationRules(&config.ChatModerationSettings{
+			BannedWords: []string{"c", "badword", "c++", "ñoño"},
+			Patterns:    []string{`\d{4}-\d{4}`},
+			BlockLinks:  true,
+		}),
+	}
+
+	tests := []struct {
+		name    string
+		msg     string
+		reasons []string
+		masked  string
+	}{
+		{
+			name:   "clean message",
+			msg:    "hello everyone",
+			masked: "hello everyone",
+		},
+		{
+			name:    "banned word is case insensitive",
+			msg:     "this is BadWord here",
+			reasons: []string{ChatModerationReasonBannedWord},
+			masked:  "this is ******* here",
+		},
+		{
+			name:   "banned word inside another word",
+			msg:    "badwords",
+			masked: "badwords",
+		},
+		{
+			name:    "banned word with special characters",
+			msg:     "learn c++ now",
+			reasons: []string{ChatModerationReasonBannedWord},
+			masked:  "learn *** now",
+		},
+		{
+			name:    "mask by rune length",
+			msg:     "hola ñoño amigo",
+			reasons: []string{ChatModerationReasonBannedWord},
+			masked:  "hola **** amigo",
+		},
+		{
+			name:    "shorter banned word won't hide the longer one",
+			msg:     "c++ or c",
+			reasons: []string{ChatModerationReasonBannedWord},
+			masked:  "*** or *",
+		},
+		{
+			name:    "pattern",
+			msg:     "call 1234-5678",
+			reasons: []string{ChatModerationReasonPattern},
+			masked:  "call *********",
+		},
+		{
+			name:    "link",
+			msg:     "visit https://example.com/a?b=1 or www.example.org",
+			reasons: []string{ChatModerationReasonLink},
+			masked:  "visit ************************* or ***************",
+		},
+		{
+			name:    "multiple rules",
+			msg:     "badword 1234-5678 http://x.io",
+			reasons: []string{ChatModerationReasonBannedWord, ChatModerationReasonPattern, ChatModerationReasonLink},
+			masked:  "******* ********* ***********",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reasons, masked := m.applyRules(tt.msg)
+			if strings.Join(reasons, ",") != strings.Join(tt.reasons, ",") {
+				t.Errorf("reasons = %v, want %v", reasons, tt.reasons)
+			}
+			if masked != tt.masked {
+				t.Errorf("masked = %q, want %q", masked, tt.masked)
+			}
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 )
 
 type FileModel struct {
 	app         *config.AppConfig
 	ds          *dbservice.DatabaseService
 	natsService *natsservice.NatsService
+	storage     storageservice.Storage
 }
 
 func NewFileModel(app *config.AppConfig, ds *dbservice.DatabaseService, natsService *natsservice.NatsService) *FileModel {
@@ -27,5 +29,12 @@ func NewFileModel(app *config.AppConfig, ds *dbservice.DatabaseService, natsServ
 		app:         app,
 		ds:          ds,
 		natsService: natsService,
+		storage:     storageservice.New(app, storageservice.AreaUploads),
 	}
 }
+
+// Storage returns the storage of the uploaded files.
+// Files are always processed in the local upload path first
+func (m *FileModel) Storage() storageservice.Storage {
+	return m.storage
+}
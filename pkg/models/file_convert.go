@@ -76,6 +76,7 @@ func (m *FileModel) ConvertAndBroadcastWhiteboardFile(roomId, roomSid, filePath
 	if err != nil {
 		return nil, err
 	}
+	m.copyToStorage(filepath.Join(roomSid, fileId))
 
 	res := &ConvertWhiteboardFileRes{
 		Status:     true,
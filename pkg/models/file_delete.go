@@ -1,8 +1,10 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
 	"os"
 )
@@ -16,5 +18,11 @@ func (m *FileModel) DeleteRoomUploadedDir(roomSid string) error {
 	if err != nil {
 		log.Errorln(err)
 	}
+
+	if !storageservice.IsLocal(m.storage) {
+		if err := m.storage.DeletePrefix(context.Background(), roomSid); err != nil {
+			log.Errorln(err)
+		}
+	}
 	return err
 }
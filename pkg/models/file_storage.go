@@ -0,0 +1,52 @@
+package models
+
+import (
+	"context"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	log "github.com/sirupsen/logrus"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+// copyToStorage will copy the file or all the files of the directory
+// from the local upload path to the storage in the background,
+// so the request won't wait for the upload. The local copy will be kept
+// because conversion & whiteboard snapshots need them during the session
+func (m *FileModel) copyToStorage(relPath string) {
+	if storageservice.IsLocal(m.storage) {
+		return
+	}
+	go m.uploadToStorage(relPath)
+}
+
+func (m *FileModel) uploadToStorage(relPath string) {
+	ctx := context.Background()
+	root := m.app.UploadFileSettings.Path
+
+	err := filepath.WalkDir(filepath.Join(root, relPath), func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		key, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		stat, err := f.Stat()
+		if err != nil {
+			return err
+		}
+
+		return m.storage.Put(ctx, filepath.ToSlash(key), f, stat.Size(), mime.TypeByExtension(filepath.Ext(p)))
+	})
+	if err != nil {
+		log.Errorln("failed to copy:", relPath, "to storage, error:", err)
+	}
+}
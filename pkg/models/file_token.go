@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"time"
+)
+
+// every kind of file token has its own audience,
+// so that a token of one route can't be used for another one
+const (
+	analyticsDownloadAudience          = "analytics_download"
+	chatArchiveDownloadAudience        = "chat_archive_download"
+	whiteboardSnapshotDownloadAudience = "whiteboard_snapshot_download"
+	// recordingPlaybackAudience is used to separate playback tokens from download tokens
+	recordingPlaybackAudience = "recording_playback"
+)
+
+// generateFileToken will use the same JWT token generator as plugNmeet is using
+func generateFileToken(app *config.AppConfig, audience, subject string, expireAt time.Time) (string, error) {
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(app.Client.Secret)}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+
+	cl := jwt.Claims{
+		Issuer:    app.Client.ApiKey,
+		Audience:  jwt.Audience{audience},
+		NotBefore: jwt.NewNumericDate(time.Now().UTC()),
+		Expiry:    jwt.NewNumericDate(expireAt),
+		Subject:   subject,
+	}
+
+	return jwt.Signed(sig).Claims(cl).Serialize()
+}
+
+// verifyFileToken will verify the token & return the subject.
+// Empty audience is used for recording download tokens,
+// which are generated without any audience by plugnmeet-protocol
+func verifyFileToken(app *config.AppConfig, token, audience string) (string, int, error) {
+	tok, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.HS256})
+	if err != nil {
+		return "", fiber.StatusUnauthorized, err
+	}
+
+	out := jwt.Claims{}
+	if err = verifyClaimsWithSecrets(tok, app.Client.AcceptedSecrets(), &out); err != nil {
+		return "", fiber.StatusUnauthorized, err
+	}
+
+	expected := jwt.Expected{
+		Issuer: app.Client.ApiKey,
+		Time:   time.Now().UTC(),
+	}
+	if audience != "" {
+		expected.AnyAudience = jwt.Audience{audience}
+	} else if len(out.Audience) > 0 {
+		return "", fiber.StatusUnauthorized, errors.New("invalid audience")
+	}
+
+	if err = out.Validate(expected); err != nil {
+		return "", fiber.StatusUnauthorized, err
+	}
+
+	return out.Subject, fiber.StatusOK, nil
+}
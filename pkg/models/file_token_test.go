@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"testing"
+	"time"
+)
+
+func TestFileToken_Audience(t *testing.T) {
+	app := &config.AppConfig{
+		Client: config.ClientInfo{
+			ApiKey: "plugnmeet",
+			Secret: "zumyyYWqv7KR2kUqvYdq4z4sXg7XTBD2ljT6",
+		},
+	}
+
+	token, err := generateFileToken(app, chatArchiveDownloadAudience, "file.json", time.Now().UTC().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subject, status, err := verifyFileToken(app, token, chatArchiveDownloadAudience)
+	if err != nil || status != fiber.StatusOK || subject != "file.json" {
+		t.Errorf("expected valid token but got subject: %s, status: %d, error: %v", subject, status, err)
+	}
+
+	for _, audience := range []string{analyticsDownloadAudience, recordingPlaybackAudience, ""} {
+		if _, status, err = verifyFileToken(app, token, audience); err == nil || status != fiber.StatusUnauthorized {
+			t.Errorf("token should not be accepted for audience: %q", audience)
+		}
+	}
+}
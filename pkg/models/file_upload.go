@@ -154,6 +154,8 @@ func (m *FileModel) UploadedFileMerge(req *ResumableUploadedFileMergeReq) (*Uplo
 	}
 
 	finalPath := filepath.Join(req.RoomSid, req.ResumableFilename)
+	m.copyToStorage(finalPath)
+
 	res := &UploadedFileResponse{
 		Status:        true,
 		Msg:           "file uploaded successfully",
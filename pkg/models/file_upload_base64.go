@@ -46,6 +46,7 @@ func (m *FileModel) UploadBase64EncodedData(req *plugnmeet.UploadBase64EncodedDa
 	if err := os.WriteFile(filePath, data, 0644); err != nil {
 		return nil, fmt.Errorf("failed to write file: %w", err)
 	}
+	m.copyToStorage(filepath.Join(roomInfo.Sid, req.GetFileName()))
 
 	return &plugnmeet.UploadBase64EncodedDataRes{
 		Status:        true,
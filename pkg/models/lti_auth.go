@@ -10,16 +10,17 @@ import (
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	log "github.com/sirupsen/logrus"
+	"maps"
 	"net/url"
 	"strings"
 	"time"
 )
 
+// VerifyAuth will verify the signature of the launch request.
+// All the accepted secrets will be tried, so that the LMS can be updated during the rotation
 func (m *LtiV1Model) VerifyAuth(requests, signingURL string) (*url.Values, error) {
 	r := strings.Split(requests, "&")
-	p := lti.NewProvider(config.GetConfig().Client.Secret, signingURL)
-	p.Method = "POST"
-	p.ConsumerKey = config.GetConfig().Client.ApiKey
+	values := url.Values{}
 	var providedSignature string
 
 	for _, f := range r {
@@ -28,26 +29,35 @@ func (m *LtiV1Model) VerifyAuth(requests, signingURL string) (*url.Values, error
 		if t[0] == "oauth_signature" {
 			providedSignature = b
 		} else {
-			p.Add(t[0], b)
+			values.Set(t[0], b)
 		}
 	}
 
-	if p.Get("oauth_consumer_key") != p.ConsumerKey {
+	if values.Get("oauth_consumer_key") != config.GetConfig().Client.ApiKey {
 		return nil, errors.New(config.InvalidConsumerKey)
 	}
 
-	sign, err := p.Sign()
-	if err != nil {
-		return nil, err
-	}
-	params := p.Params()
+	var calculated []string
+	for _, secret := range config.GetConfig().Client.AcceptedSecrets() {
+		p := lti.NewProvider(secret, signingURL)
+		p.Method = "POST"
+		p.ConsumerKey = config.GetConfig().Client.ApiKey
+		// Sign will add missing oauth fields & the signature to the params
+		p.SetParams(maps.Clone(values))
 
-	if sign != providedSignature {
-		log.Errorln("Calculated: " + sign + " provided: " + providedSignature)
-		return nil, errors.New(config.VerificationFailed)
+		sign, err := p.Sign()
+		if err != nil {
+			return nil, err
+		}
+		if sign == providedSignature {
+			params := p.Params()
+			return &params, nil
+		}
+		calculated = append(calculated, sign)
 	}
 
-	return &params, nil
+	log.Errorln("Calculated: " + strings.Join(calculated, ", ") + " provided: " + providedSignature)
+	return nil, errors.New(config.VerificationFailed)
 }
 
 func (m *LtiV1Model) genHashId(id string) string {
@@ -58,6 +68,8 @@ func (m *LtiV1Model) genHashId(id string) string {
 	return hash
 }
 
+// ToJWT will sign the claims with the primary secret,
+// other accepted secrets are only used for verification
 func (m *LtiV1Model) ToJWT(c *plugnmeet.LtiClaims) (string, error) {
 	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(config.GetConfig().Client.Secret)},
 		(&jose.SignerOptions{}).WithType("JWT"))
@@ -83,7 +95,7 @@ func (m *LtiV1Model) LTIV1VerifyHeaderToken(token string) (*LtiClaims, error) {
 
 	out := jwt.Claims{}
 	claims := &LtiClaims{}
-	if err = tok.Claims([]byte(config.GetConfig().Client.Secret), &out, claims); err != nil {
+	if err = verifyClaimsWithSecrets(tok, config.GetConfig().Client.AcceptedSecrets(), &out, claims); err != nil {
 		return nil, err
 	}
 	if err = out.Validate(jwt.Expected{Issuer: config.GetConfig().Client.ApiKey, Time: time.Now().UTC()}); err != nil {
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/jordic/lti"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"testing"
+)
+
+func signLtiLaunchRequest(t *testing.T, secret, signingURL string) string {
+	p := lti.NewProvider(secret, signingURL)
+	p.Method = "POST"
+	p.ConsumerKey = config.GetConfig().Client.ApiKey
+	p.Add("user_id", "1")
+	p.Add("resource_link_id", "course 101")
+	if _, err := p.Sign(); err != nil {
+		t.Fatal(err)
+	}
+	return p.Params().Encode()
+}
+
+func TestLtiV1Model_VerifyAuth(t *testing.T) {
+	client := &config.GetConfig().Client
+	secrets := client.Secrets
+	client.Secrets = append([]config.ClientSecret{}, config.ClientSecret{Secret: "old-lti-secret"})
+	t.Cleanup(func() {
+		client.Secrets = secrets
+	})
+
+	m := &LtiV1Model{}
+	signingURL := "https://demo.plugnmeet.com/lti/v1"
+
+	for _, secret := range []string{client.Secret, "old-lti-secret"} {
+		params, err := m.VerifyAuth(signLtiLaunchRequest(t, secret, signingURL), signingURL)
+		if err != nil {
+			t.Errorf("request signed by accepted secret should be valid but got: %v", err)
+			continue
+		}
+		if params.Get("resource_link_id") != "course 101" {
+			t.Errorf("unexpected resource_link_id: %s", params.Get("resource_link_id"))
+		}
+	}
+
+	if _, err := m.VerifyAuth(signLtiLaunchRequest(t, "unknown-secret", signingURL), signingURL); err == nil {
+		t.Error("request signed by unknown secret should not be valid")
+	}
+}
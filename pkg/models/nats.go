@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
@@ -19,6 +20,9 @@ type NatsModel struct {
 	natsService    *natsservice.NatsService
 	userModel      *UserModel
 	analyticsModel *AnalyticsModel
+	rateLimiter    *natsRateLimiter
+	waitingRoom    *WaitingRoomModel
+	roomLobby      *RoomLobbyModel
 }
 
 func NewNatsModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *NatsModel {
@@ -32,6 +36,7 @@ func NewNatsModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redi
 		rs = redisservice.New(app.RDS)
 	}
 	natsService := natsservice.New(app)
+	userModel := NewUserModel(app, ds, rs)
 
 	return &NatsModel{
 		app:            app,
@@ -40,12 +45,19 @@ func NewNatsModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redi
 		analytics:      NewAnalyticsModel(app, ds, rs),
 		authModel:      NewAuthModel(app, natsService),
 		natsService:    natsService,
-		userModel:      NewUserModel(app, ds, rs),
+		userModel:      userModel,
 		analyticsModel: NewAnalyticsModel(app, ds, rs),
+		rateLimiter:    newNatsRateLimiter(app, rs, natsService, userModel),
+		waitingRoom:    NewWaitingRoomModel(app, rs),
+		roomLobby:      NewRoomLobbyModel(app, rs),
 	}
 }
 
 func (m *NatsModel) HandleFromClientToServerReq(roomId, userId string, req *plugnmeet.NatsMsgClientToServer) {
+	if !m.rateLimiter.allow(roomId, userId, req.Event.String()) {
+		return
+	}
+
 	switch req.Event {
 	case plugnmeet.NatsMsgClientToServerEvents_REQ_RENEW_PNM_TOKEN:
 		m.RenewPNMToken(roomId, userId, req.Msg)
@@ -71,3 +83,15 @@ func (m *NatsModel) HandleFromClientToServerReq(roomId, userId string, req *plug
 		m.analytics.HandleEvent(ad)
 	}
 }
+
+// HandleDataChannelMessage will publish the dataChannel message received from the ingress worker
+// to the room if the user hasn't exceeded the rate limit
+func (m *NatsModel) HandleDataChannelMessage(roomId, userId string, data []byte) {
+	if !m.rateLimiter.allow(roomId, userId, NatsRateLimitEventDataChannel) {
+		return
+	}
+
+	if err := m.natsService.PublishCheckedMessage(roomId, userId, natsservice.IngressDataChannel, data); err != nil {
+		log.Errorln(fmt.Sprintf("roomId: %s, userId: %s, error publishing dataChannel message: %s", roomId, userId, err.Error()))
+	}
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// NatsRateLimitEventChat is used for chat messages received by the ingress worker
+	NatsRateLimitEventChat = "CHAT"
+	// NatsRateLimitEventDataChannel is used for dataChannel messages received by the ingress worker
+	NatsRateLimitEventDataChannel = "DATA_CHANNEL"
+)
+
+// natsRateLimitExemptEvents are required to keep the session of the user working,
+// so those won't be limited
+var natsRateLimitExemptEvents = map[string]bool{
+	plugnmeet.NatsMsgClientToServerEvents_REQ_INITIAL_DATA.String():      true,
+	plugnmeet.NatsMsgClientToServerEvents_REQ_JOINED_USERS_LIST.String(): true,
+	plugnmeet.NatsMsgClientToServerEvents_REQ_RENEW_PNM_TOKEN.String():   true,
+	plugnmeet.NatsMsgClientToServerEvents_PING.String():                  true,
+}
+
+// natsRateLimiter will limit the messages sent by a user to the server
+// using token bucket per user & event
+type natsRateLimiter struct {
+	app         *config.AppConfig
+	rs          *redisservice.RedisService
+	natsService *natsservice.NatsService
+	userModel   *UserModel
+}
+
+func newNatsRateLimiter(app *config.AppConfig, rs *redisservice.RedisService, natsService *natsservice.NatsService, userModel *UserModel) *natsRateLimiter {
+	return &natsRateLimiter{
+		app:         app,
+		rs:          rs,
+		natsService: natsService,
+		userModel:   userModel,
+	}
+}
+
+// allow will return false if the user has exceeded the limit of the event
+func (l *natsRateLimiter) allow(roomId, userId, event string) bool {
+	rl := l.app.NatsInfo.RateLimit
+	if rl == nil || !rl.Enabled || natsRateLimitExemptEvents[event] {
+		return true
+	}
+
+	bucket, ok := rl.Events[event]
+	if !ok || bucket.Rate <= 0 || bucket.Burst <= 0 {
+		bucket = rl.Default
+	}
+
+	allowed, err := l.rs.RateLimitTake(roomId, userId, event, bucket.Rate, bucket.Burst)
+	if err != nil {
+		// we won't block users because of redis error
+		log.Errorln(fmt.Sprintf("error checking rate limit for %s; roomId: %s; msg: %s", userId, roomId, err.Error()))
+		return true
+	}
+	if !allowed {
+		go l.onViolation(roomId, userId, event)
+	}
+
+	return allowed
+}
+
+// onViolation will warn the user for the first violation within the window
+// & remove the user from the session if reached the limit
+func (l *natsRateLimiter) onViolation(roomId, userId, event string) {
+	rl := l.app.NatsInfo.RateLimit
+	count, err := l.rs.RateLimitAddViolation(roomId, userId, *rl.ViolationWindow)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	log.Warnln(fmt.Sprintf("userId: %s of roomId: %s has exceeded the rate limit of event: %s, violations: %d", userId, roomId, event, count))
+
+	if count == 1 {
+		_ = l.natsService.NotifyWarningMsg(roomId, "notifications.too-many-requests", false, &userId)
+	}
+
+	if rl.RemoveAfter > 0 && count == rl.RemoveAfter {
+		err = l.userModel.RemoveParticipant(&plugnmeet.RemoveParticipantReq{
+			RoomId: roomId,
+			UserId: userId,
+			Msg:    "notifications.removed-for-too-many-requests",
+		})
+		if err != nil {
+			log.Errorln(fmt.Sprintf("error removing user %s for exceeding rate limit; roomId: %s; msg: %s", userId, roomId, err.Error()))
+		}
+	}
+}
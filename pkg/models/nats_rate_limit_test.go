@@ -0,0 +1,32 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"testing"
+)
+
+func TestNatsRateLimiter_ExemptEvents(t *testing.T) {
+	app := &config.AppConfig{
+		NatsInfo: config.NatsInfo{
+			RateLimit: &config.NatsRateLimit{
+				Enabled: true,
+				// any limited event would be rejected
+				Default: config.NatsRateLimitBucket{Rate: 0, Burst: 0},
+			},
+		},
+	}
+	// without redis, so limited events would panic
+	l := newNatsRateLimiter(app, nil, nil, nil)
+
+	for _, e := range []plugnmeet.NatsMsgClientToServerEvents{
+		plugnmeet.NatsMsgClientToServerEvents_REQ_INITIAL_DATA,
+		plugnmeet.NatsMsgClientToServerEvents_REQ_JOINED_USERS_LIST,
+		plugnmeet.NatsMsgClientToServerEvents_REQ_RENEW_PNM_TOKEN,
+		plugnmeet.NatsMsgClientToServerEvents_PING,
+	} {
+		if !l.allow("room01", "user01", e.String()) {
+			t.Errorf("%s should not be limited", e.String())
+		}
+	}
+}
@@ -40,6 +40,16 @@ func (m *NatsModel) OnAfterUserJoined(roomId, userId string) {
 			ExtraData: &userInfo.Metadata,
 			HsetValue: &now,
 		})
+
+		if userInfo.IsAdmin {
+			// users in the lobby will be released when the first admin joins
+			m.roomLobby.ReleaseLobby(roomId)
+		} else if m.roomLobby.IsUserInLobby(roomId, userId) {
+			m.roomLobby.NotifyLobbyUser(roomId, userId)
+		} else {
+			// user will be added to the waiting queue if needs approval
+			m.waitingRoom.AddUserToQueue(roomId, userId, userInfo.Metadata)
+		}
 	}
 }
 
@@ -85,6 +95,7 @@ func (m *NatsModel) handleOfflineStatus(roomId, userId string, userInfo *plugnme
 	_ = m.natsService.BroadcastSystemEventToEveryoneExceptUserId(plugnmeet.NatsMsgServerToClientEvents_USER_OFFLINE, roomId, userInfo, userId)
 
 	// Schedule consumer deletion after 30 seconds.
+	// the user will keep the position of the waiting queue till then
 	time.AfterFunc(30*time.Second, func() {
 		m.cleanupUserConsumer(roomId, userId)
 	})
@@ -98,6 +109,9 @@ func (m *NatsModel) cleanupUserConsumer(roomId, userId string) {
 		return
 	}
 
+	// user has left without waiting for the decision
+	m.waitingRoom.RemoveUserFromQueue(roomId, userId)
+
 	// do not need to delete the user as user may come to online again
 	// when the session is ended, we'll do proper clean up.
 	// delete consumer only
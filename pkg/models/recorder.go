@@ -90,6 +90,16 @@ func (m *RecorderModel) SendMsgToRecorder(req *plugnmeet.RecordingReq) error {
 		}
 	}
 
+	err := m.sendToRecorder(toSend)
+	if err != nil && (req.Task == plugnmeet.RecordingTasks_START_RECORDING || req.Task == plugnmeet.RecordingTasks_START_RTMP) {
+		// the recorder won't start, so the slot can be used by others
+		m.releaseRecorderSlot(recorderPendingStartId(req.RoomId, req.Task))
+	}
+
+	return err
+}
+
+func (m *RecorderModel) sendToRecorder(toSend *plugnmeet.PlugNmeetToRecorder) error {
 	payload, err := proto.Marshal(toSend)
 	if err != nil {
 		return err
@@ -3,17 +3,31 @@ package models
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	log "github.com/sirupsen/logrus"
 	"net/url"
 	"sort"
+	"time"
 )
 
+// recorderPendingStartTTL is the maximum time to wait for the recorder to confirm a start request
+const recorderPendingStartTTL = time.Second * 30
+
+func recorderPendingStartId(roomId string, task plugnmeet.RecordingTasks) string {
+	return fmt.Sprintf("%s:%d", roomId, task)
+}
+
+// addTokenAndRecorder will reserve a slot of the recorders for the request,
+// the caller should release it if the request couldn't be sent
 func (m *RecorderModel) addTokenAndRecorder(ctx context.Context, req *plugnmeet.RecordingReq, rq *plugnmeet.PlugNmeetToRecorder, userId string) error {
 	recorderId := m.selectRecorder()
 	if recorderId == "" {
 		return errors.New("notifications.no-recorder-available")
 	}
+	if !m.reserveRecorderSlot(recorderPendingStartId(req.RoomId, req.Task)) {
+		return errors.New("notifications.no-recorder-available")
+	}
 
 	gt := &plugnmeet.GenerateTokenReq{
 		RoomId: req.RoomId,
@@ -27,6 +41,7 @@ func (m *RecorderModel) addTokenAndRecorder(ctx context.Context, req *plugnmeet.
 	token, err := um.GetPNMJoinToken(ctx, gt)
 	if err != nil {
 		log.Errorln(err)
+		m.releaseRecorderSlot(recorderPendingStartId(req.RoomId, req.Task))
 		return err
 	}
 
@@ -58,3 +73,32 @@ func (m *RecorderModel) selectRecorder() string {
 	// we'll return the first one
 	return recorders[0].RecorderId
 }
+
+// reserveRecorderSlot will check free slots of all active recorders & reserve one atomically.
+// Start requests which weren't confirmed yet are counted too,
+// otherwise starting in many breakout rooms at once may exceed the capacity
+func (m *RecorderModel) reserveRecorderSlot(id string) bool {
+	var free int64
+	for _, r := range m.natsService.GetAllActiveRecorders() {
+		if r.MaxLimit > r.CurrentProgress {
+			free += r.MaxLimit - r.CurrentProgress
+		}
+	}
+	if free < 1 {
+		return false
+	}
+
+	reserved, err := m.rs.RecorderReservePendingStart(id, free, recorderPendingStartTTL)
+	if err != nil {
+		log.Errorln(err)
+		return false
+	}
+
+	return reserved
+}
+
+func (m *RecorderModel) releaseRecorderSlot(id string) {
+	if err := m.rs.RecorderRemovePendingStart(id); err != nil {
+		log.Errorln(err)
+	}
+}
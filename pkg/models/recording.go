@@ -9,6 +9,7 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/livekit"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -20,6 +21,8 @@ type RecordingModel struct {
 	analyticsModel  *AnalyticsModel
 	webhookNotifier *helpers.WebhookNotifier
 	natsService     *natsservice.NatsService
+	storage         storageservice.Storage
+	backupStorage   storageservice.Storage
 }
 
 func NewRecordingModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *RecordingModel {
@@ -40,10 +43,25 @@ func NewRecordingModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs
 		analyticsModel:  NewAnalyticsModel(app, ds, rs),
 		webhookNotifier: helpers.GetWebhookNotifier(app),
 		natsService:     natsservice.New(app),
+		storage:         storageservice.New(app, storageservice.AreaRecordings),
+		backupStorage:   storageservice.New(app, storageservice.AreaRecordingBackup),
 	}
 }
 
+// Storage returns the storage of the recording files
+func (m *RecordingModel) Storage() storageservice.Storage {
+	return m.storage
+}
+
 func (m *RecordingModel) HandleRecorderResp(r *plugnmeet.RecorderToPlugNmeet, roomInfo *dbmodels.RoomInfo) {
+	// recorder has responded, so the request isn't pending anymore
+	switch r.Task {
+	case plugnmeet.RecordingTasks_START_RECORDING, plugnmeet.RecordingTasks_END_RECORDING:
+		_ = m.rs.RecorderRemovePendingStart(recorderPendingStartId(r.RoomId, plugnmeet.RecordingTasks_START_RECORDING))
+	case plugnmeet.RecordingTasks_START_RTMP, plugnmeet.RecordingTasks_END_RTMP:
+		_ = m.rs.RecorderRemovePendingStart(recorderPendingStartId(r.RoomId, plugnmeet.RecordingTasks_START_RTMP))
+	}
+
 	switch r.Task {
 	case plugnmeet.RecordingTasks_START_RECORDING:
 		m.recordingStarted(r)
@@ -62,13 +80,17 @@ func (m *RecordingModel) HandleRecorderResp(r *plugnmeet.RecorderToPlugNmeet, ro
 		go m.sendToWebhookNotifier(r)
 
 	case plugnmeet.RecordingTasks_RECORDING_PROCEEDED:
-		creation, err := m.addRecordingInfoToDB(r, roomInfo.CreationTime)
+		creation, err := m.addRecordingInfoToDB(r, roomInfo)
 		if err != nil {
 			log.Errorln(err)
 		}
 		// keep record of this file
 		m.addRecordingInfoFile(r, creation, roomInfo)
-		go m.sendToWebhookNotifier(r)
+		go func() {
+			// the file should be available in the storage before notifying
+			m.moveRecordingToStorage(r.FilePath)
+			m.sendToWebhookNotifier(r)
+		}()
 	}
 }
 
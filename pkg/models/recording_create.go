@@ -1,15 +1,15 @@
 package models
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
-	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
-	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
 	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/encoding/protojson"
-	"os"
 )
 
 // recordingStarted update when recorder will start recording
@@ -39,7 +39,8 @@ func (m *RecordingModel) recordingStarted(r *plugnmeet.RecorderToPlugNmeet) {
 	}
 }
 
-func (m *RecordingModel) addRecordingInfoToDB(r *plugnmeet.RecorderToPlugNmeet, roomCreationTime int64) (int64, error) {
+// addRecordingInfoToDB will store the recording with the tenant, parent room & retention of the room
+func (m *RecordingModel) addRecordingInfoToDB(r *plugnmeet.RecorderToPlugNmeet, roomInfo *dbmodels.RoomInfo) (int64, error) {
 	v := sql.NullString{
 		String: r.RoomSid,
 		Valid:  true,
@@ -48,11 +49,14 @@ func (m *RecordingModel) addRecordingInfoToDB(r *plugnmeet.RecorderToPlugNmeet,
 	data := &dbmodels.Recording{
 		RecordID:         r.RecordingId,
 		RoomID:           r.RoomId,
+		ParentRoomID:     roomInfo.ParentRoomID,
 		RoomSid:          v,
 		RecorderID:       r.RecorderId,
 		Size:             helpers.ToFixed(float64(r.FileSize), 2),
 		FilePath:         r.FilePath,
-		RoomCreationTime: roomCreationTime,
+		RoomCreationTime: roomInfo.CreationTime,
+		TenantId:         roomInfo.TenantId,
+		RetentionDays:    getRoomInfoMetadata(roomInfo).RecordingRetentionDays,
 	}
 
 	_, err := m.ds.InsertRecordingData(data)
@@ -91,11 +95,27 @@ func (m *RecordingModel) addRecordingInfoFile(r *plugnmeet.RecorderToPlugNmeet,
 		log.Errorln(err)
 		return
 	}
-	path := fmt.Sprintf("%s/%s.json", config.GetConfig().RecorderInfo.RecordingFilesPath, r.FilePath)
-
-	err = os.WriteFile(path, marshal, 0644)
+	err = m.storage.Put(context.Background(), r.FilePath+".json", bytes.NewReader(marshal), int64(len(marshal)), "application/json")
 	if err != nil {
 		log.Errorln(err)
 		return
 	}
 }
+
+// moveRecordingToStorage will upload the recording file to the storage
+// if the recorder has saved it in the local recording path
+func (m *RecordingModel) moveRecordingToStorage(filePath string) {
+	if storageservice.IsLocal(m.storage) {
+		return
+	}
+	ctx := context.Background()
+	local := storageservice.NewLocalStorage(m.app.RecorderInfo.RecordingFilesPath)
+	if _, err := local.Stat(ctx, filePath); err != nil {
+		// the recorder may have uploaded it directly
+		return
+	}
+
+	if err := storageservice.Move(ctx, local, filePath, m.storage, filePath); err != nil {
+		log.Errorln("failed to move recording:", filePath, "to storage, error:", err)
+	}
+}
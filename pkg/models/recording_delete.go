@@ -1,15 +1,14 @@
 package models
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
-	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
 	"io"
-	"io/fs"
 	"os"
-	"path"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -20,14 +19,13 @@ func (m *RecordingModel) DeleteRecording(r *plugnmeet.DeleteRecordingReq) error
 		return err
 	}
 
-	filePath := fmt.Sprintf("%s/%s", config.GetConfig().RecorderInfo.RecordingFilesPath, recording.FilePath)
+	ctx := context.Background()
 	fileExist := true
 
-	f, err := os.Stat(filePath)
+	_, err = m.storage.Stat(ctx, recording.FilePath)
 	if err != nil {
-		var pathError *fs.PathError
-		if errors.As(err, &pathError) {
-			log.Errorln(filePath + " does not exist, so deleting from DB without stopping")
+		if errors.Is(err, storageservice.ErrNotFound) {
+			log.Errorln(recording.FilePath + " does not exist, so deleting from DB without stopping")
 			fileExist = false
 		} else {
 			ms := strings.SplitN(err.Error(), "/", -1)
@@ -41,28 +39,30 @@ func (m *RecordingModel) DeleteRecording(r *plugnmeet.DeleteRecordingReq) error
 		// if enabled backup
 		if m.app.RecorderInfo.EnableDelRecordingBackup {
 			// first with the video file
-			toFile := path.Join(m.app.RecorderInfo.DelRecordingBackupPath, f.Name())
-			err := os.Rename(filePath, toFile)
+			toFile := deletedRecordingBackupFile(recording.FilePath)
+			err := storageservice.Move(ctx, m.storage, recording.FilePath, m.backupStorage, toFile)
 			if err != nil {
 				log.Errorln(err)
 				return err
 			}
 
 			// otherwise during cleanup will be hard to detect
-			newTime := time.Now()
-			if err := os.Chtimes(toFile, newTime, newTime); err != nil {
-				log.Errorln("Failed to update file modification time:", err)
+			if localFile := m.backupStorage.LocalPath(toFile); localFile != "" {
+				newTime := time.Now()
+				if err := os.Chtimes(localFile, newTime, newTime); err != nil {
+					log.Errorln("Failed to update file modification time:", err)
+				}
 			}
 
 			// now the JSON file
-			err = os.Rename(filePath+".json", toFile+".json")
+			err = storageservice.Move(ctx, m.storage, recording.FilePath+".json", m.backupStorage, toFile+".json")
 			if err != nil {
 				// just log
 				log.Errorln(err)
 			}
 
 		} else {
-			err = os.Remove(filePath)
+			err = m.storage.Delete(ctx, recording.FilePath)
 			if err != nil {
 				ms := strings.SplitN(err.Error(), "/", -1)
 				return errors.New(ms[len(ms)-1])
@@ -71,15 +71,15 @@ func (m *RecordingModel) DeleteRecording(r *plugnmeet.DeleteRecordingReq) error
 	}
 
 	// delete compressed, if any
-	_ = os.Remove(filePath + ".fiber.gz")
+	_ = m.storage.Delete(ctx, recording.FilePath+".fiber.gz")
 	// delete record info file too
-	_ = os.Remove(filePath + ".json")
+	_ = m.storage.Delete(ctx, recording.FilePath+".json")
 
 	// we will check if the directory is empty or not
 	// if empty then better to delete that directory
-	if fileExist {
-		dir := strings.Replace(filePath, f.Name(), "", 1)
-		if dir != config.GetConfig().RecorderInfo.RecordingFilesPath {
+	if filePath := m.storage.LocalPath(recording.FilePath); fileExist && filePath != "" {
+		dir := filepath.Dir(filePath)
+		if dir != m.storage.LocalPath("") {
 			empty, err := m.isDirEmpty(dir)
 			if err == nil && empty {
 				err = os.Remove(dir)
@@ -90,8 +90,13 @@ func (m *RecordingModel) DeleteRecording(r *plugnmeet.DeleteRecordingReq) error
 		}
 	}
 
-	// no error, so we'll delete record from DB
-	_, err = m.ds.DeleteRecording(r.RecordId)
+	// no error, so we'll delete record from DB.
+	// If the file was moved to the backup, then we'll keep the record to restore it later
+	if fileExist && m.app.RecorderInfo.EnableDelRecordingBackup {
+		_, err = m.ds.SoftDeleteRecording(r.RecordId)
+	} else {
+		_, err = m.ds.DeleteRecording(r.RecordId)
+	}
 	if err != nil {
 		return err
 	}
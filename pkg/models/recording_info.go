@@ -3,9 +3,11 @@ package models
 import (
 	"errors"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 )
 
-func (m *RecordingModel) FetchRecordings(r *plugnmeet.FetchRecordingsReq) (*plugnmeet.FetchRecordingsResult, error) {
+// FetchRecordings will return only published recordings unless IncludeUnpublished is true
+func (m *RecordingModel) FetchRecordings(r *protocol.FetchRecordingsReq, tenantId string) (*plugnmeet.FetchRecordingsResult, error) {
 	if r.Limit <= 0 {
 		r.Limit = 20
 	}
@@ -13,7 +15,13 @@ func (m *RecordingModel) FetchRecordings(r *plugnmeet.FetchRecordingsReq) (*plug
 		r.OrderBy = "DESC"
 	}
 
-	data, total, err := m.ds.GetRecordings(r.RoomIds, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	var published *bool
+	if !r.IncludeUnpublished {
+		p := true
+		published = &p
+	}
+
+	data, total, err := m.ds.GetRecordings(r.RoomIds, tenantId, published, uint64(r.From), uint64(r.Limit), &r.OrderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"time"
+)
+
+// GetPlaybackToken will generate a token to play the recording in a player.
+// Unlike the download token, it contains the record id,
+// so that the recording will be checked again during playback.
+// It will return the token with its expiry time
+func (m *RecordingModel) GetPlaybackToken(r *protocol.GetPlaybackTokenReq) (string, int64, error) {
+	recording, err := m.ds.GetRecording(r.GetRecordId())
+	if err != nil {
+		return "", 0, err
+	}
+	if recording == nil {
+		return "", 0, errors.New("no info found")
+	}
+	if recording.Published != 1 {
+		return "", 0, errors.New("recording isn't published")
+	}
+
+	expireAt := time.Now().UTC().Add(m.app.RecorderInfo.PlaybackTokenValidity)
+	token, err := generateFileToken(m.app, recordingPlaybackAudience, recording.RecordID, expireAt)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, expireAt.Unix(), nil
+}
+
+// VerifyPlaybackToken will be called for every request of the player,
+// so the recording will be re-validated each time.
+// It will provide the storage key of the file
+func (m *RecordingModel) VerifyPlaybackToken(token string) (string, int, error) {
+	recordId, status, err := verifyFileToken(m.app, token, recordingPlaybackAudience)
+	if err != nil {
+		return "", status, err
+	}
+
+	// recording may have been deleted or unpublished after generating the token
+	recording, err := m.ds.GetRecording(recordId)
+	if err != nil {
+		return "", fiber.StatusInternalServerError, err
+	}
+	if recording == nil {
+		return "", fiber.StatusNotFound, errors.New("no info found")
+	}
+	if recording.Published != 1 {
+		return "", fiber.StatusForbidden, errors.New("recording isn't published")
+	}
+
+	return recording.FilePath, fiber.StatusOK, nil
+}
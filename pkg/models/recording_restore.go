@@ -0,0 +1,137 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	log "github.com/sirupsen/logrus"
+	"path"
+	"strings"
+	"time"
+)
+
+// FetchDeletedRecordings will return the recordings which are in the deleted recordings backup
+func (m *RecordingModel) FetchDeletedRecordings(r *protocol.FetchDeletedRecordingsReq, tenantId string) (*protocol.FetchDeletedRecordingsResult, error) {
+	if r.Limit == 0 {
+		r.Limit = 20
+	}
+
+	data, total, err := m.ds.GetDeletedRecordings(tenantId, uint64(r.From), uint64(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &protocol.FetchDeletedRecordingsResult{
+		TotalRecordings: total,
+		From:            r.From,
+		Limit:           r.Limit,
+	}
+	for _, v := range data {
+		result.RecordingsList = append(result.RecordingsList, &protocol.DeletedRecordingInfo{
+			RecordId:     v.RecordID,
+			RoomId:       v.RoomID,
+			RoomSid:      v.RoomSid.String,
+			FilePath:     v.FilePath,
+			FileSize:     v.Size,
+			CreationTime: v.CreationTime,
+			DeletedAt:    v.DeletedAt.Time.Unix(),
+			PurgeAt:      v.DeletedAt.Time.Add(m.app.RecorderInfo.DelRecordingBackupDuration).Unix(),
+		})
+	}
+
+	return result, nil
+}
+
+// RestoreRecording will move the file & info file back from the backup path
+// and make the recording available again
+func (m *RecordingModel) RestoreRecording(r *protocol.RestoreRecordingReq, tenantId string) error {
+	if !m.app.RecorderInfo.EnableDelRecordingBackup {
+		return errors.New("deleted recording backup isn't enabled")
+	}
+
+	v, err := m.ds.GetDeletedRecording(r.GetRecordId())
+	if err != nil {
+		return err
+	}
+	if v == nil || (tenantId != "" && v.TenantId != tenantId) {
+		return errors.New("no deleted recording found")
+	}
+
+	ctx := context.Background()
+	backupFile := deletedRecordingBackupFile(v.FilePath)
+	if _, err = m.backupStorage.Stat(ctx, backupFile); err != nil {
+		// recordings deleted by the older versions were kept using the file name only
+		backupFile = path.Base(v.FilePath)
+		if _, err = m.backupStorage.Stat(ctx, backupFile); err != nil {
+			return fmt.Errorf("backup file of the recording not found: %s", v.FilePath)
+		}
+	}
+
+	if _, err = m.storage.Stat(ctx, v.FilePath); err == nil {
+		return errors.New("a file already exists in the recording path")
+	}
+
+	// the directory may have been removed if it was empty, Move will take care of it
+	if err = storageservice.Move(ctx, m.backupStorage, backupFile, m.storage, v.FilePath); err != nil {
+		log.Errorln(err)
+		return err
+	}
+	// now the JSON file
+	if err = storageservice.Move(ctx, m.backupStorage, backupFile+".json", m.storage, v.FilePath+".json"); err != nil {
+		// just log
+		log.Errorln(err)
+	}
+
+	_, err = m.ds.RestoreRecording(r.GetRecordId())
+	return err
+}
+
+// PurgeDeletedRecordings will remove the backup files & records of the recordings
+// which were deleted before the time
+func (m *RecordingModel) PurgeDeletedRecordings(before time.Time) {
+	ctx := context.Background()
+	var lastId uint64
+	for {
+		data, err := m.ds.GetRecordingsDeletedBefore(before, lastId, 500)
+		if err != nil {
+			log.Errorln(err)
+			return
+		}
+		if len(data) == 0 {
+			return
+		}
+
+		// failed recordings will be skipped & retried in the next run
+		for _, v := range data {
+			lastId = v.ID
+			m.purgeDeletedRecording(ctx, &v)
+		}
+	}
+}
+
+func (m *RecordingModel) purgeDeletedRecording(ctx context.Context, v *dbmodels.Recording) {
+	backupFile := deletedRecordingBackupFile(v.FilePath)
+	log.Infoln("purging deleted recording:", v.RecordID, "file:", backupFile)
+
+	// recordings deleted by the older versions were kept using the file name only
+	for _, key := range []string{backupFile, path.Base(v.FilePath)} {
+		if err := m.backupStorage.Delete(ctx, key); err != nil {
+			log.Errorln(err)
+			return
+		}
+		_ = m.backupStorage.Delete(ctx, key+".json")
+	}
+
+	if _, err := m.ds.DeleteRecording(v.RecordID); err != nil {
+		log.Errorln(err)
+	}
+}
+
+// deletedRecordingBackupFile will return the key of the file in the backup storage,
+// the full relative path is used so that files with the same name won't overwrite each other
+func deletedRecordingBackupFile(filePath string) string {
+	return strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// RecordingRetentionReport is a dry-run of the retention policy,
+// it will return the recordings which will be deleted without deleting them
+func (m *RecordingModel) RecordingRetentionReport(r *protocol.RecordingRetentionReportReq, tenantId string) (*protocol.RecordingRetentionReport, error) {
+	if r.Limit == 0 {
+		r.Limit = 20
+	}
+	expireAt := time.Now()
+	if r.WithinDays > 0 {
+		expireAt = expireAt.AddDate(0, 0, int(r.WithinDays))
+	}
+
+	defaultDays := m.defaultRetentionDays()
+	data, total, err := m.ds.GetExpiredRecordings(tenantId, defaultDays, expireAt.Unix(), uint64(r.From), uint64(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &protocol.RecordingRetentionReport{
+		Enabled:         m.app.RecorderInfo.Retention != nil && m.app.RecorderInfo.Retention.Enabled,
+		DefaultDays:     int32(defaultDays),
+		TotalRecordings: total,
+		From:            r.From,
+		Limit:           r.Limit,
+	}
+	for _, v := range data {
+		result.RecordingsList = append(result.RecordingsList, &protocol.RecordingRetentionInfo{
+			RecordId:     v.RecordID,
+			RoomId:       v.RoomID,
+			RoomSid:      v.RoomSid.String,
+			FilePath:     v.FilePath,
+			FileSize:     v.Size,
+			CreationTime: v.CreationTime,
+			ExpireAt:     recordingExpireAt(&v, defaultDays),
+		})
+	}
+
+	return result, nil
+}
+
+// DeleteExpiredRecordings will delete the expired recordings using DeleteRecording,
+// so the backup setting will be respected. It will return the number of deleted recordings.
+// Recordings which couldn't be deleted will be skipped & retried in the next run
+func (m *RecordingModel) DeleteExpiredRecordings() int {
+	rt := m.app.RecorderInfo.Retention
+	if rt == nil || !rt.Enabled {
+		return 0
+	}
+
+	expireAt := time.Now().Unix()
+	deleted := 0
+	var lastId uint64
+	for deleted < rt.BatchSize {
+		data, err := m.ds.GetExpiredRecordingsAfterId(rt.DefaultDays, expireAt, lastId, uint64(rt.BatchSize))
+		if err != nil {
+			log.Errorln(err)
+			break
+		}
+		if len(data) == 0 {
+			break
+		}
+
+		for _, v := range data {
+			if deleted == rt.BatchSize {
+				break
+			}
+			lastId = v.ID
+			err = m.DeleteRecording(&plugnmeet.DeleteRecordingReq{
+				RecordId: v.RecordID,
+			})
+			if err != nil {
+				log.Errorln("failed to delete expired recording:", v.RecordID, "error:", err)
+				continue
+			}
+			log.Infoln("deleted expired recording:", v.RecordID, "of room:", v.RoomID)
+			deleted++
+			m.sendRecordingExpiredWebhook(&v)
+		}
+	}
+
+	return deleted
+}
+
+func (m *RecordingModel) defaultRetentionDays() int {
+	if m.app.RecorderInfo.Retention == nil {
+		return 0
+	}
+	return m.app.RecorderInfo.Retention.DefaultDays
+}
+
+func (m *RecordingModel) sendRecordingExpiredWebhook(v *dbmodels.Recording) {
+	n := m.webhookNotifier
+	if n == nil {
+		return
+	}
+
+	e := "recording_expired"
+	fileSize := float32(v.Size)
+	msg := &plugnmeet.CommonNotifyEvent{
+		Event: &e,
+		Room: &plugnmeet.NotifyEventRoom{
+			Sid:    &v.RoomSid.String,
+			RoomId: &v.RoomID,
+		},
+		RecordingInfo: &plugnmeet.RecordingInfoEvent{
+			RecordId:   v.RecordID,
+			RecorderId: v.RecorderID,
+			FilePath:   &v.FilePath,
+			FileSize:   &fileSize,
+		},
+	}
+
+	// room may have ended long ago, so the url will be retrieved from the DB
+	if v.RoomSid.Valid && v.RoomSid.String != "" {
+		n.ForceToPutInQueue(msg)
+	} else {
+		n.ForceToPutInQueueWithUrl(msg, "", v.TenantId)
+	}
+}
+
+// recordingExpireAt will return 0 if the recording will be kept forever
+func recordingExpireAt(v *dbmodels.Recording, defaultDays int) int64 {
+	days := v.RetentionDays
+	if days == 0 {
+		days = defaultDays
+	}
+	if days <= 0 {
+		return 0
+	}
+	return v.CreationTime + int64(days)*86400
+}
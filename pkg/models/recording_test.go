@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"testing"
 	"time"
 )
@@ -13,9 +15,9 @@ var recordingModel *RecordingModel
 func TestAuthRecording_FetchRecordings(t *testing.T) {
 	recordingModel = NewRecordingModel(nil, nil, nil)
 
-	result, err := recordingModel.FetchRecordings(&plugnmeet.FetchRecordingsReq{
+	result, err := recordingModel.FetchRecordings(&protocol.FetchRecordingsReq{
 		RoomIds: []string{roomId},
-	})
+	}, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -64,6 +66,88 @@ func TestAnalyticsAuthModel_RecordingInfo(t *testing.T) {
 	t.Logf("%+v", result)
 }
 
+func TestRecordingModel_PublishAndUpdateRecording(t *testing.T) {
+	props, err := recordingModel.PublishRecording(&protocol.PublishRecordingReq{
+		RecordId: recordId,
+		Publish:  false,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if props.Published {
+		t.Error("recording should be unpublished")
+	}
+
+	_, err = recordingModel.GetDownloadToken(&plugnmeet.GetDownloadTokenReq{
+		RecordId: recordId,
+	})
+	if err == nil {
+		t.Error("should not issue download token for unpublished recording")
+	}
+
+	title := "test recording"
+	props, err = recordingModel.UpdateRecording(&protocol.UpdateRecordingReq{
+		RecordId: recordId,
+		Title:    &title,
+		Meta:     map[string]string{"course": "demo"},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if props.Title != title || props.Meta["course"] != "demo" {
+		t.Errorf("recording wasn't updated, got: %+v", props)
+	}
+
+	props, err = recordingModel.UpdateRecording(&protocol.UpdateRecordingReq{
+		RecordId: recordId,
+		Meta:     map[string]string{"course": ""},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, ok := props.Meta["course"]; ok {
+		t.Error("meta with empty value should be removed")
+	}
+
+	_, err = recordingModel.PublishRecording(&protocol.PublishRecordingReq{
+		RecordId: recordId,
+		Publish:  true,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordingModel_PlaybackToken(t *testing.T) {
+	playbackToken, _, err := recordingModel.GetPlaybackToken(&protocol.GetPlaybackTokenReq{
+		RecordId: recordId,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	_, status, err := recordingModel.VerifyPlaybackToken(playbackToken)
+	if err != nil {
+		t.Error(err)
+	}
+	if status != fiber.StatusOK {
+		t.Errorf("should get response: %d but got %d", fiber.StatusOK, status)
+	}
+
+	// download token can't be used for playback
+	token, err := recordingModel.CreateTokenForDownload("test.mp4")
+	if err != nil {
+		t.Error(err)
+	}
+	if _, status, _ = recordingModel.VerifyPlaybackToken(token); status != fiber.StatusUnauthorized {
+		t.Errorf("should get response: %d but got %d", fiber.StatusUnauthorized, status)
+	}
+}
+
 func TestAnalyticsAuthModel_DeleteRecording(t *testing.T) {
 	err := recordingModel.DeleteRecording(&plugnmeet.DeleteRecordingReq{
 		RecordId: recordId,
@@ -90,3 +174,23 @@ func TestAnalyticsAuthModel_CreateAndVerifyToken(t *testing.T) {
 
 	t.Logf("%+v, response: %d", err, fiber.StatusNotFound)
 }
+
+func TestRecordingExpireAt(t *testing.T) {
+	v := &dbmodels.Recording{CreationTime: 1000}
+	if at := recordingExpireAt(v, 0); at != 0 {
+		t.Errorf("recording should be kept forever, got: %d", at)
+	}
+	if at := recordingExpireAt(v, 2); at != 1000+2*86400 {
+		t.Errorf("default retention should be used, got: %d", at)
+	}
+
+	v.RetentionDays = 1
+	if at := recordingExpireAt(v, 2); at != 1000+86400 {
+		t.Errorf("retention of the room should be used, got: %d", at)
+	}
+
+	v.RetentionDays = -1
+	if at := recordingExpireAt(v, 2); at != 0 {
+		t.Errorf("recording should be kept forever, got: %d", at)
+	}
+}
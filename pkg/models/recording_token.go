@@ -1,25 +1,27 @@
 package models
 
 import (
+	"context"
 	"errors"
-	"fmt"
-	"github.com/go-jose/go-jose/v4"
-	"github.com/go-jose/go-jose/v4/jwt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/mynaparrot/plugnmeet-protocol/auth"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
-	"os"
 	"strings"
-	"time"
 )
 
 // GetDownloadToken will use the same JWT token generator as plugNmeet is using
 func (m *RecordingModel) GetDownloadToken(r *plugnmeet.GetDownloadTokenReq) (string, error) {
-	recording, err := m.FetchRecording(r.RecordId)
+	recording, err := m.ds.GetRecording(r.RecordId)
 	if err != nil {
 		return "", err
 	}
+	if recording == nil {
+		return "", errors.New("no info found")
+	}
+	if recording.Published != 1 {
+		return "", errors.New("recording isn't published")
+	}
 
 	return m.CreateTokenForDownload(recording.FilePath)
 }
@@ -30,29 +32,18 @@ func (m *RecordingModel) CreateTokenForDownload(path string) (string, error) {
 	return auth.GenerateTokenForDownloadRecording(path, m.app.Client.ApiKey, m.app.Client.Secret, m.app.RecorderInfo.TokenValidity)
 }
 
-// VerifyRecordingToken verify token & provide file path
+// VerifyRecordingToken verify token & provide the storage key of the file
 func (m *RecordingModel) VerifyRecordingToken(token string) (string, int, error) {
-	tok, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.HS256})
+	key, status, err := verifyFileToken(config.GetConfig(), token, "")
 	if err != nil {
-		return "", fiber.StatusUnauthorized, err
-	}
-
-	out := jwt.Claims{}
-	if err = tok.Claims([]byte(config.GetConfig().Client.Secret), &out); err != nil {
-		return "", fiber.StatusUnauthorized, err
+		return "", status, err
 	}
 
-	if err = out.Validate(jwt.Expected{Issuer: config.GetConfig().Client.ApiKey, Time: time.Now().UTC()}); err != nil {
-		return "", fiber.StatusUnauthorized, err
-	}
-
-	file := fmt.Sprintf("%s/%s", config.GetConfig().RecorderInfo.RecordingFilesPath, out.Subject)
-	_, err = os.Lstat(file)
-
+	_, err = m.storage.Stat(context.Background(), key)
 	if err != nil {
 		ms := strings.SplitN(err.Error(), "/", -1)
 		return "", fiber.StatusNotFound, errors.New(ms[len(ms)-1])
 	}
 
-	return file, fiber.StatusOK, nil
+	return key, fiber.StatusOK, nil
 }
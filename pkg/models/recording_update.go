@@ -0,0 +1,111 @@
+package models
+
+import (
+	"errors"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"strings"
+)
+
+// PublishRecording will change the published status of a single recording
+func (m *RecordingModel) PublishRecording(r *protocol.PublishRecordingReq) (*protocol.RecordingProperties, error) {
+	if err := m.PublishRecordings([]string{r.GetRecordId()}, r.GetPublish()); err != nil {
+		return nil, err
+	}
+	return m.GetRecordingProperties(r.GetRecordId())
+}
+
+// PublishRecordings will change the published status of the recordings.
+// An error will be returned if any of the recordings doesn't exist
+func (m *RecordingModel) PublishRecordings(recordIds []string, publish bool) error {
+	if len(recordIds) == 0 {
+		return errors.New("record_id is required")
+	}
+	for _, id := range recordIds {
+		v, err := m.ds.GetRecording(id)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			return errors.New("no info found")
+		}
+	}
+
+	_, err := m.ds.UpdateRecordingsPublished(recordIds, publish)
+	return err
+}
+
+// UpdateRecording will update title, description & custom meta of the recording
+func (m *RecordingModel) UpdateRecording(r *protocol.UpdateRecordingReq) (*protocol.RecordingProperties, error) {
+	v, err := m.ds.GetRecording(r.GetRecordId())
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, errors.New("no info found")
+	}
+
+	var metadata *string
+	if len(r.Meta) > 0 {
+		meta := decodeRecordingMeta(v.Metadata.String)
+		for k, val := range r.Meta {
+			k = strings.TrimSpace(k)
+			if k == "" {
+				continue
+			}
+			if val == "" {
+				delete(meta, k)
+			} else {
+				meta[k] = val
+			}
+		}
+
+		s := ""
+		if len(meta) > 0 {
+			marshal, err := json.Marshal(meta)
+			if err != nil {
+				return nil, err
+			}
+			s = string(marshal)
+		}
+		metadata = &s
+	}
+
+	_, err = m.ds.UpdateRecordingMetadata(r.GetRecordId(), r.Title, r.Description, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.GetRecordingProperties(r.GetRecordId())
+}
+
+func (m *RecordingModel) GetRecordingProperties(recordId string) (*protocol.RecordingProperties, error) {
+	v, err := m.ds.GetRecording(recordId)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, errors.New("no info found")
+	}
+
+	return newRecordingProperties(v), nil
+}
+
+func newRecordingProperties(v *dbmodels.Recording) *protocol.RecordingProperties {
+	return &protocol.RecordingProperties{
+		RecordId:    v.RecordID,
+		Published:   v.Published == 1,
+		Title:       v.Title,
+		Description: v.Description.String,
+		Meta:        decodeRecordingMeta(v.Metadata.String),
+	}
+}
+
+func decodeRecordingMeta(metadata string) map[string]string {
+	meta := make(map[string]string)
+	if metadata != "" {
+		_ = json.Unmarshal([]byte(metadata), &meta)
+	}
+	return meta
+}
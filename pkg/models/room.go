@@ -1,11 +1,18 @@
 package models
 
 import (
+	"database/sql"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/livekit"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	log "github.com/sirupsen/logrus"
 )
 
 type RoomModel struct {
@@ -14,9 +21,82 @@ type RoomModel struct {
 	rs          *redisservice.RedisService
 	lk          *livekitservice.LivekitService
 	userModel   *UserModel
+	tenantModel *TenantModel
+	templModel  *RoomTemplateModel
 	natsService *natsservice.NatsService
 }
 
+// CreateRoomOptions holds values of the room create request
+// which aren't part of plugnmeet.CreateRoomReq
+type CreateRoomOptions struct {
+	TemplateId        string `json:"template_id"`
+	EnableChatArchive bool   `json:"enable_chat_archive"`
+	// WaitForModerator will hold non-admin users in the lobby until the first admin joins
+	WaitForModerator bool `json:"wait_for_moderator"`
+	// ModeratorWaitTimeout in seconds, the room will be ended if no admin joins within it
+	ModeratorWaitTimeout uint64 `json:"moderator_wait_timeout"`
+	// RecordingRetentionDays will override the default retention of the recordings.
+	// 0 means the default will be used, negative value to keep forever.
+	// It will be kept in the metadata of the room
+	RecordingRetentionDays int `json:"recording_retention_days"`
+	// WhiteboardSnapshotId will restore the whiteboard of the room from the saved snapshot
+	WhiteboardSnapshotId string `json:"whiteboard_snapshot_id"`
+	// RawRequest is the original CreateRoomReq in protojson format,
+	// so that values of the template can be overridden by the present fields only
+	RawRequest []byte `json:"-"`
+}
+
+// SplitCreateRoomReq will split the request into plugnmeet.CreateRoomReq
+// & the options which are only supported by this server
+func SplitCreateRoomReq(r *protocol.CreateRoomReq) (*plugnmeet.CreateRoomReq, *CreateRoomOptions) {
+	req := &plugnmeet.CreateRoomReq{
+		RoomId:          r.GetRoomId(),
+		EmptyTimeout:    r.EmptyTimeout,
+		MaxParticipants: r.MaxParticipants,
+		Metadata:        r.GetMetadata(),
+	}
+	opts := &CreateRoomOptions{
+		TemplateId:             r.GetTemplateId(),
+		EnableChatArchive:      r.GetEnableChatArchive(),
+		WaitForModerator:       r.GetWaitForModerator(),
+		ModeratorWaitTimeout:   r.GetModeratorWaitTimeout(),
+		RecordingRetentionDays: int(r.GetRecordingRetentionDays()),
+		WhiteboardSnapshotId:   r.GetWhiteboardSnapshotId(),
+	}
+	return req, opts
+}
+
+// RoomInfoMetadata will be stored as the metadata of the room in the DB,
+// so that those values are available even after the room has ended
+type RoomInfoMetadata struct {
+	// RecordingRetentionDays 0 means the default will be used, negative value to keep forever
+	RecordingRetentionDays int `json:"recording_retention_days,omitempty"`
+}
+
+// getRoomInfoMetadata will return empty metadata if nothing was stored
+func getRoomInfoMetadata(info *dbmodels.RoomInfo) *RoomInfoMetadata {
+	meta := new(RoomInfoMetadata)
+	if info == nil || !info.Metadata.Valid || info.Metadata.String == "" {
+		return meta
+	}
+	if err := json.Unmarshal([]byte(info.Metadata.String), meta); err != nil {
+		log.Errorln(fmt.Sprintf("invalid metadata of room sid: %s; msg: %s", info.Sid, err.Error()))
+	}
+	return meta
+}
+
+func setRoomInfoMetadata(info *dbmodels.RoomInfo, meta *RoomInfoMetadata) error {
+	marshal, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	info.Metadata = sql.NullString{
+		String: string(marshal),
+		Valid:  true,
+	}
+	return nil
+}
+
 func NewRoomModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *RoomModel {
 	if app == nil {
 		app = config.GetConfig()
@@ -34,6 +114,8 @@ func NewRoomModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redi
 		rs:          rs,
 		lk:          livekitservice.New(app),
 		userModel:   NewUserModel(app, ds, rs),
+		tenantModel: NewTenantModel(app, ds),
+		templModel:  NewRoomTemplateModel(app, ds),
 		natsService: natsservice.New(app),
 	}
 }
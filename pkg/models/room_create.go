@@ -11,10 +11,22 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 	"time"
 )
 
 func (m *RoomModel) CreateRoom(ctx context.Context, r *plugnmeet.CreateRoomReq) (*plugnmeet.ActiveRoomInfo, error) {
+	return m.CreateTenantRoom(ctx, r, "", nil)
+}
+
+// CreateTenantRoom will create the room for the tenant,
+// empty tenantId means the room was requested using the main API key.
+// opts is optional, if template was set then values of the template will be used as defaults
+func (m *RoomModel) CreateTenantRoom(ctx context.Context, r *plugnmeet.CreateRoomReq, tenantId string, opts *CreateRoomOptions) (*plugnmeet.ActiveRoomInfo, error) {
+	if opts == nil {
+		opts = new(CreateRoomOptions)
+	}
+
 	// we'll lock the same room creation until the room is created
 	lockValue, err := acquireRoomCreationLockWithRetry(ctx, m.rs, r.GetRoomId())
 	if err != nil {
@@ -37,6 +49,25 @@ func (m *RoomModel) CreateRoom(ctx context.Context, r *plugnmeet.CreateRoomReq)
 		return nil, err
 	}
 
+	// breakout room will always belong to the same tenant as parent room
+	// & the recordings will be kept as long as the recordings of the parent room
+	if r.GetMetadata().GetIsBreakoutRoom() {
+		parent, err := m.ds.GetRoomInfoByRoomId(r.GetMetadata().GetParentRoomId(), 1)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil || (tenantId != "" && parent.TenantId != tenantId) {
+			return nil, errors.New("parent room not found")
+		}
+		tenantId = parent.TenantId
+		opts.RecordingRetentionDays = getRoomInfoMetadata(parent).RecordingRetentionDays
+	}
+
+	// room id is unique, so other tenant can't use the same room id while it's running
+	if roomDbInfo != nil && roomDbInfo.TenantId != tenantId {
+		return nil, errors.New("room id already in use")
+	}
+
 	// handle existing room logic
 	if roomDbInfo != nil && roomDbInfo.Sid != "" {
 		ari, err := m.handleExistingRoom(r, roomDbInfo)
@@ -49,11 +80,43 @@ func (m *RoomModel) CreateRoom(ctx context.Context, r *plugnmeet.CreateRoomReq)
 		// otherwise we'll keep going
 	}
 
+	var template *dbmodels.RoomTemplate
+	if opts.TemplateId != "" {
+		template, err = m.templModel.GetRoomTemplate(opts.TemplateId, tenantId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// initialize room defaults
-	m.setRoomDefaults(r)
+	m.setRoomDefaults(r, opts.RawRequest, template)
+
+	if tenantId != "" && !r.Metadata.IsBreakoutRoom && roomDbInfo == nil {
+		if err := m.tenantModel.CheckRoomQuota(tenantId); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.WhiteboardSnapshotId != "" && !r.Metadata.IsBreakoutRoom {
+		wsm := NewWhiteboardSnapshotModel(m.app, m.ds)
+		if _, err := wsm.fetchWhiteboardSnapshot(opts.WhiteboardSnapshotId, tenantId); err != nil {
+			return nil, err
+		}
+	}
 
 	// prepare DB model
 	roomDbInfo, sid := m.prepareRoomDbInfo(r, roomDbInfo)
+	roomDbInfo.TenantId = tenantId
+	roomDbInfo.ChatArchive = 0
+	if opts.EnableChatArchive && m.app.ChatArchiveSettings != nil && m.app.ChatArchiveSettings.Enabled {
+		roomDbInfo.ChatArchive = 1
+	}
+	err = setRoomInfoMetadata(roomDbInfo, &RoomInfoMetadata{
+		RecordingRetentionDays: opts.RecordingRetentionDays,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// save info to db
 	_, err = m.ds.InsertOrUpdateRoomInfo(roomDbInfo)
@@ -80,7 +143,20 @@ func (m *RoomModel) CreateRoom(ctx context.Context, r *plugnmeet.CreateRoomReq)
 
 	// preload whiteboard file if needed
 	if !r.Metadata.IsBreakoutRoom {
-		go m.prepareWhiteboardPreloadFile(r.Metadata, r.RoomId, sid)
+		if opts.WhiteboardSnapshotId != "" {
+			// consumers of the users will need it to deliver the restored events
+			if err = m.natsService.SetRoomWhiteboardSnapshotId(r.RoomId, opts.WhiteboardSnapshotId); err != nil {
+				return nil, err
+			}
+		}
+		go m.prepareWhiteboardPreloadFile(r.Metadata, r.RoomId, sid, tenantId, opts.WhiteboardSnapshotId)
+	}
+
+	if opts.WaitForModerator && !r.Metadata.IsBreakoutRoom {
+		lm := NewRoomLobbyModel(m.app, m.rs)
+		if err = lm.EnableLobby(r.RoomId, time.Duration(opts.ModeratorWaitTimeout)*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	ari := &plugnmeet.ActiveRoomInfo{
@@ -130,7 +206,10 @@ func (m *RoomModel) handleExistingRoom(r *plugnmeet.CreateRoomReq, roomDbInfo *d
 }
 
 // setRoomDefaults to Sets default values and metadata
-func (m *RoomModel) setRoomDefaults(r *plugnmeet.CreateRoomReq) {
+func (m *RoomModel) setRoomDefaults(r *plugnmeet.CreateRoomReq, rawReq []byte, template *dbmodels.RoomTemplate) {
+	if template != nil {
+		mergeRoomTemplate(r, rawReq, template)
+	}
 	utils.PrepareDefaultRoomFeatures(r)
 	utils.SetCreateRoomDefaultValues(r, m.app.UploadFileSettings.MaxSize, m.app.UploadFileSettings.MaxSizeWhiteboardFile, m.app.UploadFileSettings.AllowedTypes, m.app.SharedNotePad.Enabled)
 	utils.SetRoomDefaultLockSettings(r)
@@ -166,10 +245,50 @@ func (m *RoomModel) setRoomDefaults(r *plugnmeet.CreateRoomReq) {
 		}
 		r.Metadata.RoomFeatures.SpeechToTextTranslationFeatures.MaxNumTranLangsAllowSelecting = maxAllow
 	}
+}
+
+// mergeRoomTemplate will use template values as base,
+// so that values sent with the request will take precedence.
+// rawReq is the original request in protojson format,
+// it's required to know which fields were present in the request
+func mergeRoomTemplate(r *plugnmeet.CreateRoomReq, rawReq []byte, template *dbmodels.RoomTemplate) {
+	if len(rawReq) == 0 {
+		// without the original request false values can't be separated from missing ones
+		rawReq, _ = protojson.Marshal(r)
+	}
 
-	if r.Metadata.IsBreakoutRoom && r.Metadata.RoomFeatures.EnableAnalytics {
-		r.Metadata.RoomFeatures.EnableAnalytics = false
+	if r.Metadata == nil {
+		r.Metadata = new(plugnmeet.RoomMetadata)
+	}
+
+	base := fmt.Sprintf(`{"metadata":{"roomFeatures":%s,"defaultLockSettings":%s}}`, templateJsonOrEmpty(template.RoomFeatures), templateJsonOrEmpty(template.DefaultLockSettings))
+	merged := new(plugnmeet.CreateRoomReq)
+	if err := helpers.MergeProtoJson([]byte(base), rawReq, merged); err != nil {
+		log.Errorln(fmt.Sprintf("failed to merge templateId: %s, error: %s", template.TemplateId, err.Error()))
+	} else {
+		r.Metadata.RoomFeatures = merged.GetMetadata().GetRoomFeatures()
+		r.Metadata.DefaultLockSettings = merged.GetMetadata().GetDefaultLockSettings()
+	}
+	if r.Metadata.RoomFeatures == nil {
+		r.Metadata.RoomFeatures = new(plugnmeet.RoomCreateFeatures)
+	}
+
+	if template.RoomDuration > 0 && r.Metadata.RoomFeatures.RoomDuration == nil {
+		r.Metadata.RoomFeatures.RoomDuration = &template.RoomDuration
+	}
+	if template.EmptyTimeout > 0 && r.EmptyTimeout == nil {
+		r.EmptyTimeout = &template.EmptyTimeout
 	}
+	if template.MaxParticipants > 0 && r.MaxParticipants == nil {
+		r.MaxParticipants = &template.MaxParticipants
+	}
+}
+
+func templateJsonOrEmpty(v string) string {
+	if v == "" {
+		return "{}"
+	}
+	return v
 }
 
 // prepareRoomDbInfo Prepares DB model for room
@@ -201,9 +320,28 @@ func (m *RoomModel) prepareRoomDbInfo(r *plugnmeet.CreateRoomReq, existing *dbmo
 }
 
 // prepareWhiteboardPreloadFile preload whiteboard file
-func (m *RoomModel) prepareWhiteboardPreloadFile(meta *plugnmeet.RoomMetadata, roomId, roomSid string) {
+func (m *RoomModel) prepareWhiteboardPreloadFile(meta *plugnmeet.RoomMetadata, roomId, roomSid, tenantId, snapshotId string) {
 	wbf := meta.RoomFeatures.WhiteboardFeatures
-	if wbf == nil || !wbf.AllowedWhiteboard || wbf.PreloadFile == nil || *wbf.PreloadFile == "" {
+	if wbf == nil || !wbf.AllowedWhiteboard {
+		return
+	}
+
+	// the snapshot will have priority over the preload file
+	if snapshotId != "" {
+		log.Infoln(fmt.Sprintf("roomId: %s has whiteboard snapshot: %s so, restoring it", roomId, snapshotId))
+
+		wsm := NewWhiteboardSnapshotModel(m.app, m.ds)
+		if err := wsm.PreloadWhiteboardSnapshot(roomId, roomSid, tenantId, snapshotId); err != nil {
+			log.Errorln(err)
+			_ = m.natsService.NotifyErrorMsg(roomId, "notifications.preloaded-whiteboard-file-processing-error", nil)
+			return
+		}
+
+		log.Infoln(fmt.Sprintf("whiteboard snapshot: %s for roomId: %s had been restored successfully", snapshotId, roomId))
+		return
+	}
+
+	if wbf.PreloadFile == nil || *wbf.PreloadFile == "" {
 		return
 	}
 
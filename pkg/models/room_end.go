@@ -8,9 +8,14 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	log "github.com/sirupsen/logrus"
+	"sync"
 	"time"
 )
 
+// onAfterRoomEndedWg tracks the running cleanups of the ended rooms,
+// so short-lived processes like the admin CLI can wait for them before exit
+var onAfterRoomEndedWg sync.WaitGroup
+
 // EndRoom now accepts context and userIDForLog
 func (m *RoomModel) EndRoom(ctx context.Context, r *plugnmeet.RoomEndReq) (bool, string) {
 	roomID := r.GetRoomId()
@@ -34,7 +39,7 @@ func (m *RoomModel) EndRoom(ctx context.Context, r *plugnmeet.RoomEndReq) (bool,
 	}
 	if info == nil && roomDbInfo.IsRunning == 1 {
 		log.WithFields(log.Fields{"roomId": roomID}).Warn("Room active in DB but not in NATS during EndRoom. Marking as ended and cleaning up.")
-		go m.OnAfterRoomEnded(ctx, roomDbInfo.RoomId, roomDbInfo.Sid, "") // Metadata might be empty
+		m.startOnAfterRoomEnded(ctx, roomDbInfo.RoomId, roomDbInfo.Sid, "") // Metadata might be empty
 		return true, "room ended (NATS info was missing, cleanup initiated)"
 	}
 	if info == nil {
@@ -56,10 +61,23 @@ func (m *RoomModel) EndRoom(ctx context.Context, r *plugnmeet.RoomEndReq) (bool,
 			log.Errorln(err)
 		}
 	}
-	go m.OnAfterRoomEnded(ctx, info.RoomId, info.RoomSid, info.Metadata)
+	m.startOnAfterRoomEnded(ctx, info.RoomId, info.RoomSid, info.Metadata)
 	return true, "success"
 }
 
+func (m *RoomModel) startOnAfterRoomEnded(ctx context.Context, roomID, roomSID, metadata string) {
+	onAfterRoomEndedWg.Add(1)
+	go func() {
+		defer onAfterRoomEndedWg.Done()
+		m.OnAfterRoomEnded(ctx, roomID, roomSID, metadata)
+	}()
+}
+
+// WaitForOnAfterRoomEnded blocks until the cleanups started by EndRoom have finished
+func (m *RoomModel) WaitForOnAfterRoomEnded() {
+	onAfterRoomEndedWg.Wait()
+}
+
 func (m *RoomModel) OnAfterRoomEnded(ctx context.Context, roomID, roomSID, metadata string) {
 	log.WithFields(log.Fields{"roomId": roomID, "roomSid": roomSID, "operation": "OnAfterRoomEnded"}).Info("Starting cleanup.")
 
@@ -118,11 +136,28 @@ func (m *RoomModel) OnAfterRoomEnded(ctx context.Context, roomID, roomSID, metad
 
 	m.natsService.DeleteRoomUsersBlockList(roomID)
 
+	wrm := NewWaitingRoomModel(m.app, m.rs)
+	if err = wrm.CleanUpWaitingQueue(roomID); err != nil {
+		log.WithFields(log.Fields{"roomId": roomID}).Errorf("Error cleaning waiting queue: %v", err)
+	}
+	if _, _, err = m.rs.RoomLobbyRelease(roomID); err != nil {
+		log.WithFields(log.Fields{"roomId": roomID}).Errorf("Error cleaning lobby: %v", err)
+	}
+	if err = m.rs.BreakoutRoomDeleteGrouping(roomID); err != nil {
+		log.WithFields(log.Fields{"roomId": roomID}).Errorf("Error cleaning breakout room grouping: %v", err)
+	}
+
 	recorderModel := NewRecorderModel(m.app, m.ds, m.rs)
 	if err = recorderModel.SendMsgToRecorder(&plugnmeet.RecordingReq{Task: plugnmeet.RecordingTasks_STOP, Sid: roomSID, RoomId: roomID}); err != nil {
 		log.WithFields(log.Fields{"roomId": roomID, "roomSid": roomSID}).Errorf("Error sending stop to recorder: %v", err)
 	}
 
+	// whiteboard snapshot should be created before deleting uploaded files & the room stream
+	wsm := NewWhiteboardSnapshotModel(m.app, m.ds)
+	if err = wsm.CreateRoomWhiteboardSnapshot(roomID, roomSID, metadata); err != nil {
+		log.WithFields(log.Fields{"roomId": roomID, "roomSid": roomSID}).Errorf("Error creating whiteboard snapshot: %v", err)
+	}
+
 	if !m.app.UploadFileSettings.KeepForever {
 		fileM := NewFileModel(m.app, m.ds, m.natsService)
 		if err = fileM.DeleteRoomUploadedDir(roomSID); err != nil {
@@ -153,6 +188,12 @@ func (m *RoomModel) OnAfterRoomEnded(ctx context.Context, roomID, roomSID, metad
 		log.WithFields(log.Fields{"roomId": roomID, "roomSid": roomSID}).Errorf("Error in speech service cleanup: %v", err)
 	}
 
+	// chat messages should be archived before deleting the room stream
+	cam := NewChatArchiveModel(m.app, m.ds)
+	if err = cam.ArchiveRoomChat(roomID, roomSID); err != nil {
+		log.WithFields(log.Fields{"roomId": roomID, "roomSid": roomSID}).Errorf("Error archiving chat: %v", err)
+	}
+
 	m.natsService.OnAfterSessionEndCleanup(roomID)
 	log.WithFields(log.Fields{"roomId": roomID, "operation": "OnAfterRoomEnded"}).Info("Room has been cleaned properly.")
 
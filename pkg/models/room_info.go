@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
 	"time"
 )
@@ -106,7 +107,9 @@ func (m *RoomModel) GetActiveRoomInfo(ctx context.Context, r *plugnmeet.GetActiv
 	return true, "success", res
 }
 
-func (m *RoomModel) GetActiveRoomsInfo() (bool, string, []*plugnmeet.ActiveRoomWithParticipant) {
+// GetActiveRoomsInfo will return all the active rooms,
+// if tenantId isn't empty then only rooms of that tenant will be returned
+func (m *RoomModel) GetActiveRoomsInfo(tenantId string) (bool, string, []*plugnmeet.ActiveRoomWithParticipant) {
 	roomsInfo, err := m.ds.GetActiveRoomsInfo()
 	if err != nil {
 		return false, err.Error(), nil
@@ -117,6 +120,9 @@ func (m *RoomModel) GetActiveRoomsInfo() (bool, string, []*plugnmeet.ActiveRoomW
 
 	var res []*plugnmeet.ActiveRoomWithParticipant
 	for _, r := range roomsInfo {
+		if tenantId != "" && r.TenantId != tenantId {
+			continue
+		}
 		i := &plugnmeet.ActiveRoomWithParticipant{
 			RoomInfo: &plugnmeet.ActiveRoomInfo{
 				RoomTitle:          r.RoomTitle,
@@ -156,14 +162,15 @@ func (m *RoomModel) GetActiveRoomsInfo() (bool, string, []*plugnmeet.ActiveRoomW
 	return true, "success", res
 }
 
-func (m *RoomModel) FetchPastRooms(r *plugnmeet.FetchPastRoomsReq) (*plugnmeet.FetchPastRoomsResult, error) {
+// FetchPastRooms will return ended rooms, optionally filtered by scheduled series id
+func (m *RoomModel) FetchPastRooms(r *protocol.FetchPastRoomsReq, tenantId string) (*plugnmeet.FetchPastRoomsResult, error) {
 	if r.Limit <= 0 {
 		r.Limit = 20
 	}
 	if r.OrderBy == "" {
 		r.OrderBy = "DESC"
 	}
-	rooms, total, err := m.ds.GetPastRooms(r.RoomIds, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	rooms, total, err := m.ds.GetPastRooms(r.RoomIds, r.SeriesId, tenantId, uint64(r.From), uint64(r.Limit), &r.OrderBy)
 	if err != nil {
 		return nil, err
 	}
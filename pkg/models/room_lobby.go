@@ -0,0 +1,183 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+// lobbyNotifyMilestones are the remaining times when users in the lobby will be notified
+var lobbyNotifyMilestones = []time.Duration{30 * time.Minute, 15 * time.Minute, 10 * time.Minute, 5 * time.Minute, 2 * time.Minute, 1 * time.Minute}
+
+// RoomLobbyModel will hold non-admin users of the room until the first admin joins
+type RoomLobbyModel struct {
+	app         *config.AppConfig
+	rs          *redisservice.RedisService
+	natsService *natsservice.NatsService
+	waitingRoom *WaitingRoomModel
+}
+
+func NewRoomLobbyModel(app *config.AppConfig, rs *redisservice.RedisService) *RoomLobbyModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if rs == nil {
+		rs = redisservice.New(app.RDS)
+	}
+
+	return &RoomLobbyModel{
+		app:         app,
+		rs:          rs,
+		natsService: natsservice.New(app),
+		waitingRoom: NewWaitingRoomModel(app, rs),
+	}
+}
+
+// EnableLobby will be called after creating the room,
+// the room will be ended if no admin joins before the timeout
+func (m *RoomLobbyModel) EnableLobby(roomId string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = config.DefaultModeratorWaitTimeout
+	}
+	deadline := time.Now().Add(timeout).Unix()
+	// keep the key a bit longer, so that scheduler can end the room
+	return m.rs.RoomLobbyEnable(roomId, deadline, timeout+time.Hour)
+}
+
+func (m *RoomLobbyModel) IsLobbyActive(roomId string) bool {
+	deadline, err := m.rs.RoomLobbyGetDeadline(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return false
+	}
+	return deadline > 0
+}
+
+// HoldUser will add the user to the lobby, the user should wait for approval until released
+func (m *RoomLobbyModel) HoldUser(roomId, userId string) error {
+	deadline, err := m.rs.RoomLobbyGetDeadline(roomId)
+	if err != nil {
+		return err
+	}
+	ttl := time.Until(time.Unix(deadline, 0)) + time.Hour
+
+	return m.rs.RoomLobbyAddUser(roomId, userId, ttl)
+}
+
+func (m *RoomLobbyModel) IsUserInLobby(roomId, userId string) bool {
+	held, err := m.rs.RoomLobbyIsUserHeld(roomId, userId)
+	if err != nil {
+		log.Errorln(err)
+		return false
+	}
+	return held
+}
+
+// NotifyLobbyUser will send the remaining time before closing the session
+func (m *RoomLobbyModel) NotifyLobbyUser(roomId, userId string) {
+	deadline, err := m.rs.RoomLobbyGetDeadline(roomId)
+	if err != nil || deadline == 0 {
+		return
+	}
+	m.notifyRemainingTime(roomId, userId, deadline)
+}
+
+// ReleaseLobby will be called when an admin has joined,
+// held users will be approved or moved to the waiting queue if waiting room is active
+func (m *RoomLobbyModel) ReleaseLobby(roomId string) {
+	released, userIds, err := m.rs.RoomLobbyRelease(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	if !released {
+		return
+	}
+	log.Infoln(fmt.Sprintf("admin has joined roomId: %s, releasing %d users from the lobby", roomId, len(userIds)))
+
+	meta, err := m.natsService.GetRoomMetadataStruct(roomId)
+	if err != nil || meta == nil {
+		log.Errorln(fmt.Sprintf("roomId: %s, unable to get metadata to release lobby: %v", roomId, err))
+		return
+	}
+	waitingRoomActive := meta.GetRoomFeatures().GetWaitingRoomFeatures().GetIsActive()
+
+	for _, userId := range userIds {
+		info, err := m.natsService.GetUserInfo(roomId, userId)
+		if err != nil || info == nil {
+			continue
+		}
+		if waitingRoomActive {
+			m.waitingRoom.AddUserToQueue(roomId, userId, info.Metadata)
+			continue
+		}
+		if err = m.waitingRoom.approveUser(roomId, userId, info.Metadata); err != nil {
+			log.Errorln(fmt.Sprintf("roomId: %s, unable to release userId: %s from the lobby: %s", roomId, userId, err.Error()))
+		}
+	}
+}
+
+// CheckLobbyDeadline will end the room if no admin has joined before the deadline,
+// otherwise users in the lobby will be notified when the remaining time reaches a milestone
+func (m *RoomLobbyModel) CheckLobbyDeadline(ctx context.Context, roomId string, rm *RoomModel) {
+	deadline, err := m.rs.RoomLobbyGetDeadline(roomId)
+	if err != nil || deadline == 0 {
+		return
+	}
+
+	if time.Now().Unix() < deadline {
+		if !isLobbyMilestone(time.Until(time.Unix(deadline, 0))) {
+			return
+		}
+		userIds, err := m.rs.RoomLobbyGetUsers(roomId)
+		if err != nil {
+			log.Errorln(err)
+			return
+		}
+		for _, userId := range userIds {
+			m.notifyRemainingTime(roomId, userId, deadline)
+		}
+		return
+	}
+
+	released, _, err := m.rs.RoomLobbyRelease(roomId)
+	if err != nil || !released {
+		return
+	}
+
+	log.Infoln(fmt.Sprintf("no admin has joined roomId: %s before the deadline, ending the room", roomId))
+	if ok, msg := rm.EndRoom(ctx, &plugnmeet.RoomEndReq{RoomId: roomId}); !ok {
+		log.Errorln(fmt.Sprintf("unable to end roomId: %s; msg: %s", roomId, msg))
+	}
+}
+
+func (m *RoomLobbyModel) notifyRemainingTime(roomId, userId string, deadline int64) {
+	remaining := time.Until(time.Unix(deadline, 0)).Round(time.Minute)
+	if remaining < time.Minute {
+		remaining = time.Minute
+	}
+
+	params := map[string]string{
+		"minutes": strconv.Itoa(int(remaining.Minutes())),
+	}
+	if err := m.natsService.NotifyI18nInfoMsg(roomId, "notifications.room-lobby-waiting-for-moderator", params, false, &userId); err != nil {
+		log.Errorln(err)
+	}
+}
+
+// isLobbyMilestone will check if the remaining time has reached a milestone,
+// scheduler checks every minute, so each milestone will match only once
+func isLobbyMilestone(remaining time.Duration) bool {
+	for _, d := range lobbyNotifyMilestones {
+		if remaining <= d && remaining > d-time.Minute {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsLobbyMilestone(t *testing.T) {
+	tests := []struct {
+		remaining time.Duration
+		want      bool
+	}{
+		{remaining: 45 * time.Minute, want: false},
+		{remaining: 30 * time.Minute, want: true},
+		{remaining: 29*time.Minute + 30*time.Second, want: true},
+		{remaining: 29 * time.Minute, want: false},
+		{remaining: 12 * time.Minute, want: false},
+		{remaining: 5 * time.Minute, want: true},
+		{remaining: 3 * time.Minute, want: false},
+		{remaining: 40 * time.Second, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := isLobbyMilestone(tt.remaining); got != tt.want {
+			t.Errorf("isLobbyMilestone(%s) = %v, want %v", tt.remaining, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
+)
+
+var scheduledRoomStatusNames = map[int]string{
+	dbmodels.ScheduledRoomStatusPending:   "pending",
+	dbmodels.ScheduledRoomStatusStarted:   "started",
+	dbmodels.ScheduledRoomStatusCancelled: "cancelled",
+	dbmodels.ScheduledRoomStatusFailed:    "failed",
+}
+
+type RoomScheduleModel struct {
+	app             *config.AppConfig
+	ds              *dbservice.DatabaseService
+	rs              *redisservice.RedisService
+	rm              *RoomModel
+	webhookNotifier *helpers.WebhookNotifier
+}
+
+func NewRoomScheduleModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *RoomScheduleModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if ds == nil {
+		ds = dbservice.New(app.DB)
+	}
+	if rs == nil {
+		rs = redisservice.New(app.RDS)
+	}
+
+	return &RoomScheduleModel{
+		app:             app,
+		ds:              ds,
+		rs:              rs,
+		rm:              NewRoomModel(app, ds, rs),
+		webhookNotifier: helpers.GetWebhookNotifier(app),
+	}
+}
@@ -0,0 +1,261 @@
+package models
+
+import (
+	"errors"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// CreateScheduledRoom will store the room creation request,
+// so that the scheduler can create the room at the requested time
+func (m *RoomScheduleModel) CreateScheduledRoom(r *protocol.ScheduleRoomReq, tenantId string) (*protocol.ScheduledRoomInfo, error) {
+	if r.CreateRoomReq == nil {
+		return nil, errors.New("create_room_req is required")
+	}
+	if r.StartAt <= time.Now().Unix() {
+		return nil, errors.New("start_at must be in the future")
+	}
+
+	req, createRoomOpts := SplitCreateRoomReq(r.CreateRoomReq)
+	marshal, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := marshalCreateRoomOpts(createRoomOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &dbmodels.ScheduledRoom{
+		ScheduleId:     uuid.NewString(),
+		RoomId:         req.GetRoomId(),
+		RoomTitle:      req.GetMetadata().GetRoomTitle(),
+		StartAt:        r.StartAt,
+		TenantId:       tenantId,
+		CreateRoomReq:  string(marshal),
+		CreateRoomOpts: opts,
+		Status:         dbmodels.ScheduledRoomStatusPending,
+	}
+
+	_, err = m.ds.InsertOrUpdateScheduledRoom(info)
+	if err != nil {
+		return nil, err
+	}
+
+	go m.sendScheduleWebhook("room_scheduled", info, req)
+
+	return m.prepareScheduledRoomInfo(info), nil
+}
+
+// UpdateScheduledRoom will change start time and/or creation request of a pending schedule
+func (m *RoomScheduleModel) UpdateScheduledRoom(r *protocol.ScheduleRoomReq, tenantId string) (*protocol.ScheduledRoomInfo, error) {
+	if r.ScheduleId == "" {
+		return nil, errors.New("schedule_id is required")
+	}
+	info, err := m.ds.GetScheduledRoom(r.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return nil, errors.New("no schedule found")
+	}
+	if info.Status != dbmodels.ScheduledRoomStatusPending {
+		return nil, errors.New("only pending schedule can be updated")
+	}
+
+	if r.StartAt > 0 {
+		if r.StartAt <= time.Now().Unix() {
+			return nil, errors.New("start_at must be in the future")
+		}
+		info.StartAt = r.StartAt
+	}
+
+	if r.CreateRoomReq != nil {
+		req, createRoomOpts := SplitCreateRoomReq(r.CreateRoomReq)
+		marshal, err := protojson.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := marshalCreateRoomOpts(createRoomOpts)
+		if err != nil {
+			return nil, err
+		}
+		info.RoomId = req.GetRoomId()
+		info.RoomTitle = req.GetMetadata().GetRoomTitle()
+		info.CreateRoomReq = string(marshal)
+		info.CreateRoomOpts = opts
+	}
+
+	if r.RoomTitle != nil || r.RoomDuration != nil || r.RoomFeatures != nil {
+		err = m.applyScheduleOverrides(info, r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	affected, err := m.ds.UpdatePendingScheduledRoom(info)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		// MySQL reports 0 if nothing has changed,
+		// otherwise the scheduler has claimed it in the meantime
+		current, err := m.ds.GetScheduledRoom(info.ScheduleId)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil || current.Status != dbmodels.ScheduledRoomStatusPending {
+			return nil, errors.New("only pending schedule can be updated")
+		}
+	}
+
+	req, err := m.unmarshalCreateRoomReq(info)
+	if err == nil {
+		// we'll notify again with the updated information
+		go m.sendScheduleWebhook("room_scheduled", info, req)
+	}
+
+	return m.prepareScheduledRoomInfo(info), nil
+}
+
+// CancelScheduledRoom will cancel a pending schedule
+func (m *RoomScheduleModel) CancelScheduledRoom(r *protocol.CancelScheduledRoomReq, tenantId string) error {
+	info, err := m.ds.GetScheduledRoom(r.ScheduleId)
+	if err != nil {
+		return err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return errors.New("no schedule found")
+	}
+
+	affected, err := m.ds.UpdateScheduledRoomStatus(info.ScheduleId, dbmodels.ScheduledRoomStatusPending, dbmodels.ScheduledRoomStatusCancelled, "", "")
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("only pending schedule can be cancelled")
+	}
+	info.Status = dbmodels.ScheduledRoomStatusCancelled
+
+	req, err := m.unmarshalCreateRoomReq(info)
+	if err == nil {
+		go m.sendScheduleWebhook("room_schedule_cancelled", info, req)
+	}
+
+	return nil
+}
+
+// applyScheduleOverrides will change title, duration or features of the stored request.
+// This way a single occurrence of a series can be changed without touching the others
+func (m *RoomScheduleModel) applyScheduleOverrides(info *dbmodels.ScheduledRoom, r *protocol.ScheduleRoomReq) error {
+	req, err := m.unmarshalCreateRoomReq(info)
+	if err != nil {
+		return err
+	}
+	if req.Metadata == nil {
+		req.Metadata = new(plugnmeet.RoomMetadata)
+	}
+
+	if r.RoomTitle != nil {
+		req.Metadata.RoomTitle = *r.RoomTitle
+		info.RoomTitle = *r.RoomTitle
+	}
+	if r.RoomFeatures != nil {
+		req.Metadata.RoomFeatures = r.RoomFeatures
+	}
+	if r.RoomDuration != nil {
+		if req.Metadata.RoomFeatures == nil {
+			req.Metadata.RoomFeatures = new(plugnmeet.RoomCreateFeatures)
+		}
+		req.Metadata.RoomFeatures.RoomDuration = r.RoomDuration
+	}
+
+	marshal, err := protojson.Marshal(req)
+	if err != nil {
+		return err
+	}
+	info.CreateRoomReq = string(marshal)
+
+	return nil
+}
+
+func (m *RoomScheduleModel) unmarshalCreateRoomReq(info *dbmodels.ScheduledRoom) (*plugnmeet.CreateRoomReq, error) {
+	req := new(plugnmeet.CreateRoomReq)
+	err := protojson.Unmarshal([]byte(info.CreateRoomReq), req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// unmarshalCreateRoomOpts will return the stored options,
+// schedules created before storing options will get the default one
+func (m *RoomScheduleModel) unmarshalCreateRoomOpts(info *dbmodels.ScheduledRoom) (*CreateRoomOptions, error) {
+	opts := new(CreateRoomOptions)
+	if info.CreateRoomOpts == "" {
+		return opts, nil
+	}
+	err := json.Unmarshal([]byte(info.CreateRoomOpts), opts)
+	if err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+func marshalCreateRoomOpts(opts *CreateRoomOptions) (string, error) {
+	if opts == nil {
+		return "", nil
+	}
+	marshal, err := json.Marshal(opts)
+	if err != nil {
+		return "", err
+	}
+	return string(marshal), nil
+}
+
+// sendScheduleWebhook will send webhook for schedule related events.
+// As no session exists yet, room.metadata will contain the schedule information
+// & room.creation_time the scheduled start time
+func (m *RoomScheduleModel) sendScheduleWebhook(event string, info *dbmodels.ScheduledRoom, req *plugnmeet.CreateRoomReq) {
+	scheduleInfo := m.prepareScheduledRoomInfo(info)
+	// no need to send the full request
+	scheduleInfo.CreateRoomReq = nil
+
+	marshal, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(scheduleInfo)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	m.sendScheduleEventWebhook(event, info.RoomId, info.TenantId, info.StartAt, string(marshal), req)
+}
+
+// sendScheduleEventWebhook will send the webhook with metadata as room.metadata
+func (m *RoomScheduleModel) sendScheduleEventWebhook(event, roomId, tenantId string, startAt int64, metadata string, req *plugnmeet.CreateRoomReq) {
+	if m.webhookNotifier == nil {
+		return
+	}
+
+	creationTime := uint64(startAt)
+	msg := &plugnmeet.CommonNotifyEvent{
+		Event: &event,
+		Room: &plugnmeet.NotifyEventRoom{
+			RoomId:          &roomId,
+			CreationTime:    &creationTime,
+			Metadata:        &metadata,
+			EmptyTimeout:    req.EmptyTimeout,
+			MaxParticipants: req.MaxParticipants,
+		},
+	}
+
+	var perMeetingUrl string
+	if req.GetMetadata().WebhookUrl != nil {
+		perMeetingUrl = req.GetMetadata().GetWebhookUrl()
+	}
+	m.webhookNotifier.ForceToPutInQueueWithUrl(msg, perMeetingUrl, tenantId)
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"time"
+)
+
+func (m *RoomScheduleModel) FetchScheduledRooms(r *protocol.FetchScheduledRoomsReq, tenantId string) (*protocol.FetchScheduledRoomsResult, error) {
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+	if r.OrderBy == "" {
+		r.OrderBy = "ASC"
+	}
+
+	var status *int
+	if r.Status != "" {
+		for k, v := range scheduledRoomStatusNames {
+			if v == r.Status {
+				status = &k
+				break
+			}
+		}
+		if status == nil {
+			return nil, errors.New("invalid status")
+		}
+	}
+
+	rooms, total, err := m.ds.GetScheduledRooms(r.RoomIds, r.SeriesId, tenantId, status, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.ScheduledRoomInfo
+	for _, rr := range rooms {
+		list = append(list, m.prepareScheduledRoomInfo(&rr))
+	}
+
+	return &protocol.FetchScheduledRoomsResult{
+		TotalRooms: total,
+		From:       r.From,
+		Limit:      r.Limit,
+		OrderBy:    r.OrderBy,
+		RoomsList:  list,
+	}, nil
+}
+
+func (m *RoomScheduleModel) prepareScheduledRoomInfo(info *dbmodels.ScheduledRoom) *protocol.ScheduledRoomInfo {
+	scheduleInfo := &protocol.ScheduledRoomInfo{
+		ScheduleId: info.ScheduleId,
+		SeriesId:   info.SeriesId,
+		RoomId:     info.RoomId,
+		RoomTitle:  info.RoomTitle,
+		StartAt:    info.StartAt,
+		Status:     scheduledRoomStatusNames[info.Status],
+		RoomSid:    info.RoomSid,
+		ErrorMsg:   info.ErrorMsg,
+		Created:    info.Created.Format(time.RFC3339),
+	}
+	if req, err := m.unmarshalCreateRoomReq(info); err == nil {
+		scheduleInfo.CreateRoomReq = req
+	}
+	return scheduleInfo
+}
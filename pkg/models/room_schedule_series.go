@@ -0,0 +1,152 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// CreateScheduledRoomSeries will expand the recurrence rule & store every occurrence as a separate schedule.
+// Each occurrence can later be updated or cancelled individually using schedule_id
+func (m *RoomScheduleModel) CreateScheduledRoomSeries(r *protocol.ScheduleRoomSeriesReq, tenantId string) (*protocol.ScheduledRoomSeriesInfo, error) {
+	if r.CreateRoomReq == nil {
+		return nil, errors.New("create_room_req is required")
+	}
+	if r.StartAt <= time.Now().Unix() {
+		return nil, errors.New("start_at must be in the future")
+	}
+
+	timezone := r.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", timezone)
+	}
+
+	rule, err := helpers.ParseRecurrenceRule(r.RecurrenceRule, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	startTimes, err := rule.Occurrences(time.Unix(r.StartAt, 0).In(loc), config.MaxScheduledRoomSeriesOccurrences)
+	if err != nil {
+		return nil, err
+	}
+	if len(startTimes) == 0 {
+		return nil, errors.New("recurrence rule doesn't produce any occurrence")
+	}
+
+	req, createRoomOpts := SplitCreateRoomReq(r.CreateRoomReq)
+	marshal, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := marshalCreateRoomOpts(createRoomOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &dbmodels.ScheduledRoomSeries{
+		SeriesId:       uuid.NewString(),
+		RoomId:         req.GetRoomId(),
+		RoomTitle:      req.GetMetadata().GetRoomTitle(),
+		RecurrenceRule: r.RecurrenceRule,
+		Timezone:       timezone,
+		StartAt:        r.StartAt,
+		Occurrences:    len(startTimes),
+		TenantId:       tenantId,
+		CreateRoomReq:  string(marshal),
+		CreateRoomOpts: opts,
+		Status:         dbmodels.ScheduledRoomSeriesStatusActive,
+	}
+
+	occurrences := make([]*dbmodels.ScheduledRoom, 0, len(startTimes))
+	for _, t := range startTimes {
+		occurrences = append(occurrences, &dbmodels.ScheduledRoom{
+			ScheduleId:     uuid.NewString(),
+			RoomId:         series.RoomId,
+			RoomTitle:      series.RoomTitle,
+			StartAt:        t.Unix(),
+			TenantId:       series.TenantId,
+			CreateRoomReq:  series.CreateRoomReq,
+			CreateRoomOpts: series.CreateRoomOpts,
+			Status:         dbmodels.ScheduledRoomStatusPending,
+		})
+	}
+
+	err = m.ds.InsertScheduledRoomSeries(series, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	info := m.prepareScheduledRoomSeriesInfo(series)
+	for _, o := range occurrences {
+		info.Occurrences = append(info.Occurrences, m.prepareScheduledRoomInfo(o))
+	}
+
+	// a single event for the whole series, occurrences can be fetched using series_id
+	go m.sendSeriesWebhook("room_series_scheduled", series, req)
+
+	return info, nil
+}
+
+// CancelScheduledRoomSeries will cancel all the pending occurrences of the series
+func (m *RoomScheduleModel) CancelScheduledRoomSeries(r *protocol.CancelScheduledRoomSeriesReq, tenantId string) error {
+	series, err := m.ds.GetScheduledRoomSeries(r.SeriesId)
+	if err != nil {
+		return err
+	}
+	if series == nil || (tenantId != "" && series.TenantId != tenantId) {
+		return errors.New("no series found")
+	}
+	if series.Status == dbmodels.ScheduledRoomSeriesStatusCancelled {
+		return errors.New("series was already cancelled")
+	}
+
+	cancelled, err := m.ds.CancelScheduledRoomSeries(series.SeriesId)
+	if err != nil {
+		return err
+	}
+	series.Status = dbmodels.ScheduledRoomSeriesStatusCancelled
+	series.Occurrences = len(cancelled)
+
+	req := new(plugnmeet.CreateRoomReq)
+	if err = protojson.Unmarshal([]byte(series.CreateRoomReq), req); err == nil {
+		go m.sendSeriesWebhook("room_series_cancelled", series, req)
+	}
+
+	return nil
+}
+
+func (m *RoomScheduleModel) prepareScheduledRoomSeriesInfo(series *dbmodels.ScheduledRoomSeries) *protocol.ScheduledRoomSeriesInfo {
+	return &protocol.ScheduledRoomSeriesInfo{
+		SeriesId:         series.SeriesId,
+		RoomId:           series.RoomId,
+		RoomTitle:        series.RoomTitle,
+		RecurrenceRule:   series.RecurrenceRule,
+		Timezone:         series.Timezone,
+		StartAt:          series.StartAt,
+		TotalOccurrences: int32(series.Occurrences),
+	}
+}
+
+// sendSeriesWebhook will send a single webhook for the whole series,
+// room.metadata will contain the series information without occurrences
+func (m *RoomScheduleModel) sendSeriesWebhook(event string, series *dbmodels.ScheduledRoomSeries, req *plugnmeet.CreateRoomReq) {
+	marshal, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m.prepareScheduledRoomSeriesInfo(series))
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	m.sendScheduleEventWebhook(event, series.RoomId, series.TenantId, series.StartAt, string(marshal), req)
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// StartScheduledRoom will create the room using stored request.
+// The schedule will be claimed first, so only one server of the cluster will create the room
+func (m *RoomScheduleModel) StartScheduledRoom(ctx context.Context, info *dbmodels.ScheduledRoom) error {
+	affected, err := m.ds.UpdateScheduledRoomStatus(info.ScheduleId, dbmodels.ScheduledRoomStatusPending, dbmodels.ScheduledRoomStatusStarted, "", "")
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// other server has taken care of it or the schedule was cancelled
+		return nil
+	}
+
+	req, err := m.unmarshalCreateRoomReq(info)
+	if err != nil {
+		m.markScheduledRoomFailed(info, err.Error())
+		return err
+	}
+	opts, err := m.unmarshalCreateRoomOpts(info)
+	if err != nil {
+		m.markScheduledRoomFailed(info, err.Error())
+		return err
+	}
+
+	// if the server was down for long time,
+	// we should not create room which should have already been finished
+	if d := req.GetMetadata().GetRoomFeatures().GetRoomDuration(); d > 0 {
+		validUntil := info.StartAt + int64(d*60)
+		if time.Now().Unix() > validUntil {
+			m.markScheduledRoomFailed(info, "schedule expired")
+			return nil
+		}
+	}
+
+	log.Infoln(fmt.Sprintf("creating scheduled roomId: %s with scheduleId: %s", info.RoomId, info.ScheduleId))
+	room, err := m.rm.CreateTenantRoom(ctx, req, info.TenantId, opts)
+	if err != nil {
+		m.markScheduledRoomFailed(info, err.Error())
+		return err
+	}
+
+	_, err = m.ds.UpdateScheduledRoomStatus(info.ScheduleId, dbmodels.ScheduledRoomStatusStarted, dbmodels.ScheduledRoomStatusStarted, room.GetSid(), "")
+	if err != nil {
+		log.Errorln(err)
+	}
+
+	if info.SeriesId != "" {
+		// so that past rooms can be filtered by series
+		_, err = m.ds.UpdateRoomSeriesId(room.GetSid(), info.SeriesId)
+		if err != nil {
+			log.Errorln(err)
+		}
+	}
+
+	return nil
+}
+
+func (m *RoomScheduleModel) markScheduledRoomFailed(info *dbmodels.ScheduledRoom, msg string) {
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	_, err := m.ds.UpdateScheduledRoomStatus(info.ScheduleId, dbmodels.ScheduledRoomStatusStarted, dbmodels.ScheduledRoomStatusFailed, "", msg)
+	if err != nil {
+		log.Errorln(err)
+	}
+}
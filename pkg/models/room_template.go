@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
+)
+
+type RoomTemplateModel struct {
+	app *config.AppConfig
+	ds  *dbservice.DatabaseService
+}
+
+func NewRoomTemplateModel(app *config.AppConfig, ds *dbservice.DatabaseService) *RoomTemplateModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if ds == nil {
+		ds = dbservice.New(app.DB)
+	}
+
+	return &RoomTemplateModel{
+		app: app,
+		ds:  ds,
+	}
+}
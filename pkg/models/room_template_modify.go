@@ -0,0 +1,171 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// CreateRoomTemplate will store a new named template which can be used during room creation
+func (m *RoomTemplateModel) CreateRoomTemplate(r *protocol.CreateRoomTemplateReq, tenantId string) (*protocol.RoomTemplateInfo, error) {
+	info := &dbmodels.RoomTemplate{
+		TemplateId: uuid.NewString(),
+		TenantId:   tenantId,
+		Name:       r.GetName(),
+	}
+	err := applyRoomTemplateValues(info, r.GetRoomFeatures(), r.GetDefaultLockSettings(), r.RoomDuration, r.EmptyTimeout, r.MaxParticipants)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.ds.InsertOrUpdateRoomTemplate(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return prepareRoomTemplateInfo(info), nil
+}
+
+// UpdateRoomTemplate will update the template, empty fields will be ignored.
+// Changes will be used by rooms created afterward, running rooms won't be affected
+func (m *RoomTemplateModel) UpdateRoomTemplate(r *protocol.UpdateRoomTemplateReq, tenantId string) (*protocol.RoomTemplateInfo, error) {
+	info, err := m.GetRoomTemplate(r.GetTemplateId(), tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.GetName() != "" {
+		info.Name = r.GetName()
+	}
+	err = applyRoomTemplateValues(info, r.GetRoomFeatures(), r.GetDefaultLockSettings(), r.RoomDuration, r.EmptyTimeout, r.MaxParticipants)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.ds.InsertOrUpdateRoomTemplate(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return prepareRoomTemplateInfo(info), nil
+}
+
+func (m *RoomTemplateModel) DeleteRoomTemplate(r *protocol.DeleteRoomTemplateReq, tenantId string) error {
+	if _, err := m.GetRoomTemplate(r.GetTemplateId(), tenantId); err != nil {
+		return err
+	}
+
+	affected, err := m.ds.DeleteRoomTemplate(r.GetTemplateId())
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("no template found")
+	}
+
+	return nil
+}
+
+func (m *RoomTemplateModel) FetchRoomTemplates(r *protocol.FetchRoomTemplatesReq, tenantId string) (*protocol.FetchRoomTemplatesResult, error) {
+	limit := r.GetLimit()
+	if limit == 0 {
+		limit = 20
+	}
+
+	templates, total, err := m.ds.GetRoomTemplates(tenantId, uint64(r.GetFrom()), uint64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.RoomTemplateInfo
+	for _, t := range templates {
+		list = append(list, prepareRoomTemplateInfo(&t))
+	}
+
+	return &protocol.FetchRoomTemplatesResult{
+		TotalTemplates: total,
+		From:           r.GetFrom(),
+		Limit:          limit,
+		Templates:      list,
+	}, nil
+}
+
+// GetRoomTemplate will return the template if the tenant has access to it.
+// Empty tenantId means the main API key was used, which can access all the templates
+func (m *RoomTemplateModel) GetRoomTemplate(templateId, tenantId string) (*dbmodels.RoomTemplate, error) {
+	info, err := m.ds.GetRoomTemplate(templateId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return nil, errors.New("no template found")
+	}
+
+	return info, nil
+}
+
+// applyRoomTemplateValues will set the values which were sent with the request,
+// features & lock settings will be stored in the same format as protojson
+func applyRoomTemplateValues(info *dbmodels.RoomTemplate, features *plugnmeet.RoomCreateFeatures, lockSettings *plugnmeet.LockSettings, roomDuration *uint64, emptyTimeout, maxParticipants *uint32) error {
+	if features != nil {
+		marshal, err := protojson.Marshal(features)
+		if err != nil {
+			return err
+		}
+		info.RoomFeatures = string(marshal)
+	}
+	if lockSettings != nil {
+		marshal, err := protojson.Marshal(lockSettings)
+		if err != nil {
+			return err
+		}
+		info.DefaultLockSettings = string(marshal)
+	}
+
+	if info.RoomFeatures == "" {
+		info.RoomFeatures = "{}"
+	}
+	if info.DefaultLockSettings == "" {
+		info.DefaultLockSettings = "{}"
+	}
+
+	if roomDuration != nil {
+		info.RoomDuration = *roomDuration
+	}
+	if emptyTimeout != nil {
+		info.EmptyTimeout = *emptyTimeout
+	}
+	if maxParticipants != nil {
+		info.MaxParticipants = *maxParticipants
+	}
+
+	return nil
+}
+
+func prepareRoomTemplateInfo(info *dbmodels.RoomTemplate) *protocol.RoomTemplateInfo {
+	features := new(plugnmeet.RoomCreateFeatures)
+	if err := protojson.Unmarshal([]byte(templateJsonOrEmpty(info.RoomFeatures)), features); err != nil {
+		log.Errorln(fmt.Sprintf("invalid room_features of templateId: %s, error: %s", info.TemplateId, err.Error()))
+	}
+	lockSettings := new(plugnmeet.LockSettings)
+	if err := protojson.Unmarshal([]byte(templateJsonOrEmpty(info.DefaultLockSettings)), lockSettings); err != nil {
+		log.Errorln(fmt.Sprintf("invalid default_lock_settings of templateId: %s, error: %s", info.TemplateId, err.Error()))
+	}
+
+	return &protocol.RoomTemplateInfo{
+		TemplateId:          info.TemplateId,
+		Name:                info.Name,
+		RoomFeatures:        features,
+		DefaultLockSettings: lockSettings,
+		RoomDuration:        info.RoomDuration,
+		EmptyTimeout:        info.EmptyTimeout,
+		MaxParticipants:     info.MaxParticipants,
+		Created:             info.Created.Format(time.RFC3339),
+	}
+}
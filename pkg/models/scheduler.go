@@ -15,8 +15,9 @@ type SchedulerModel struct {
 	natsService *natsservice.NatsService
 	rm          *RoomModel
 
-	rmDuration  *RoomDurationModel
-	closeTicker chan bool
+	rmDuration        *RoomDurationModel
+	roomScheduleModel *RoomScheduleModel
+	closeTicker       chan bool
 }
 
 func NewSchedulerModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs *redisservice.RedisService) *SchedulerModel {
@@ -31,12 +32,13 @@ func NewSchedulerModel(app *config.AppConfig, ds *dbservice.DatabaseService, rs
 	}
 
 	return &SchedulerModel{
-		app:         app,
-		ds:          ds,
-		rs:          rs,
-		rm:          NewRoomModel(app, ds, rs),
-		rmDuration:  NewRoomDurationModel(app, rs),
-		natsService: natsservice.New(app),
+		app:               app,
+		ds:                ds,
+		rs:                rs,
+		rm:                NewRoomModel(app, ds, rs),
+		rmDuration:        NewRoomDurationModel(app, rs),
+		roomScheduleModel: NewRoomScheduleModel(app, ds, rs),
+		natsService:       natsservice.New(app),
 	}
 }
 
@@ -48,6 +50,9 @@ func (m *SchedulerModel) StartScheduler() {
 	fiveMinutesChecker := time.NewTicker(5 * time.Minute)
 	defer fiveMinutesChecker.Stop()
 
+	scheduledRoomsChecker := time.NewTicker(30 * time.Second)
+	defer scheduledRoomsChecker.Stop()
+
 	oneMinuteChecker := time.NewTicker(1 * time.Minute)
 	defer oneMinuteChecker.Stop()
 
@@ -60,12 +65,18 @@ func (m *SchedulerModel) StartScheduler() {
 			return
 		case <-checkRoomDuration.C:
 			m.checkRoomWithDuration()
+			m.checkBreakoutRoomReturns()
+		case <-scheduledRoomsChecker.C:
+			m.checkScheduledRooms()
 		case <-oneMinuteChecker.C:
 			m.checkOnlineUsersStatus()
+			m.checkWaitingRoomQueues()
+			m.checkRoomLobbies()
 		case <-fiveMinutesChecker.C:
 			m.activeRoomChecker()
 		case <-hourlyChecker.C:
 			m.checkDelRecordingBackupPath()
+			m.checkExpiredRecordings()
 		}
 	}
 }
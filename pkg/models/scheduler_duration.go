@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	log "github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -29,3 +30,31 @@ func (m *SchedulerModel) checkRoomWithDuration() {
 		}
 	}
 }
+
+// checkBreakoutRoomReturns will proceed with returning participants from breakout rooms to the main room
+func (m *SchedulerModel) checkBreakoutRoomReturns() {
+	locked := m.rs.IsSchedulerTaskLock("checkBreakoutRoomReturns")
+	if locked {
+		// if lock then we will not perform here
+		return
+	}
+
+	// now set lock
+	_ = m.rs.LockSchedulerTask("checkBreakoutRoomReturns", time.Minute*1)
+	// clean at the end
+	defer m.rs.UnlockSchedulerTask("checkBreakoutRoomReturns")
+
+	roomIds, err := m.rs.BreakoutRoomReturnGetRoomIds()
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	if len(roomIds) == 0 {
+		return
+	}
+
+	bm := NewBreakoutRoomModel(m.app, m.ds, m.rs)
+	for _, roomId := range roomIds {
+		bm.CheckReturnToMainRoom(context.Background(), roomId)
+	}
+}
@@ -1,9 +1,11 @@
 package models
 
 import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
 	log "github.com/sirupsen/logrus"
+	"io/fs"
 	"os"
-	"path"
+	"path/filepath"
 	"time"
 )
 
@@ -25,23 +27,34 @@ func (m *SchedulerModel) checkDelRecordingBackupPath() {
 	defer m.rs.UnlockSchedulerTask("checkDelRecordingBackupPath")
 
 	checkTime := time.Now().Add(-m.app.RecorderInfo.DelRecordingBackupDuration)
-	entries, err := os.ReadDir(m.app.RecorderInfo.DelRecordingBackupPath)
-	if err != nil {
-		log.Errorln(err)
+
+	// first the soft deleted recordings, so that their records will be removed too
+	rm := NewRecordingModel(m.app, m.ds, m.rs)
+	rm.PurgeDeletedRecordings(checkTime)
+
+	// the remaining files in the backup path don't have any record.
+	// For object storage, lifecycle rules of the bucket can be used instead
+	if !storageservice.IsLocal(rm.backupStorage) {
 		return
 	}
-	for _, et := range entries {
+
+	// files are kept using their relative path, so the subdirectories need to be checked too
+	root := m.app.RecorderInfo.DelRecordingBackupPath
+	err := filepath.WalkDir(root, func(fileToDelete string, et fs.DirEntry, err error) error {
+		if err != nil {
+			log.Errorln(err)
+			return nil
+		}
 		if et.IsDir() {
-			continue
+			return nil
 		}
 		info, err := et.Info()
 		if err != nil {
-			continue
+			return nil
 		}
 
 		if info.ModTime().Before(checkTime) {
 			// we can remove this file
-			fileToDelete := path.Join(m.app.RecorderInfo.DelRecordingBackupPath, et.Name())
 			log.Infoln("deleting file:", fileToDelete, "because of created", checkTime, "which is older than", m.app.RecorderInfo.DelRecordingBackupDuration)
 			// video file
 			err = os.Remove(fileToDelete)
@@ -50,9 +63,55 @@ func (m *SchedulerModel) checkDelRecordingBackupPath() {
 			}
 			// info JSON file
 			err = os.Remove(fileToDelete + ".json")
-			if err != nil {
+			if err != nil && !os.IsNotExist(err) {
 				log.Errorln(err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		log.Errorln(err)
+	}
+
+	removeEmptyDirs(root)
+}
+
+// removeEmptyDirs will remove the empty subdirectories of the root, the root will be kept
+func removeEmptyDirs(root string) {
+	var dirs []string
+	_ = filepath.WalkDir(root, func(p string, et fs.DirEntry, err error) error {
+		if err == nil && et.IsDir() && p != root {
+			dirs = append(dirs, p)
+		}
+		return nil
+	})
+	// deepest first, so that the parent will be empty after removing its children
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if entries, err := os.ReadDir(dirs[i]); err == nil && len(entries) == 0 {
+			_ = os.Remove(dirs[i])
+		}
+	}
+}
+
+// checkExpiredRecordings will delete the recordings which are expired by the retention policy
+func (m *SchedulerModel) checkExpiredRecordings() {
+	if m.app.RecorderInfo.Retention == nil || !m.app.RecorderInfo.Retention.Enabled {
+		return
+	}
+
+	locked := m.rs.IsSchedulerTaskLock("checkExpiredRecordings")
+	if locked {
+		// if lock then we will not perform here
+		return
+	}
+
+	// deleting files may take time, so lock a bit longer
+	_ = m.rs.LockSchedulerTask("checkExpiredRecordings", time.Minute*10)
+	// clean at the end
+	defer m.rs.UnlockSchedulerTask("checkExpiredRecordings")
+
+	rm := NewRecordingModel(m.app, m.ds, m.rs)
+	if deleted := rm.DeleteExpiredRecordings(); deleted > 0 {
+		log.Infoln("total expired recordings deleted:", deleted)
 	}
 }
@@ -81,3 +81,27 @@ func (m *SchedulerModel) activeRoomChecker() {
 		}
 	}
 }
+
+// checkRoomLobbies will end rooms where no admin has joined before the deadline
+func (m *SchedulerModel) checkRoomLobbies() {
+	locked := m.rs.IsSchedulerTaskLock("checkRoomLobbies")
+	if locked {
+		// if lock then we will not perform here
+		return
+	}
+	// now set lock
+	_ = m.rs.LockSchedulerTask("checkRoomLobbies", time.Minute*1)
+	// clean at the end
+	defer m.rs.UnlockSchedulerTask("checkRoomLobbies")
+
+	roomIds, err := m.rs.RoomLobbyGetRoomIds()
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	lm := NewRoomLobbyModel(m.app, m.rs)
+	for _, roomId := range roomIds {
+		lm.CheckLobbyDeadline(context.Background(), roomId, m.rm)
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// checkScheduledRooms will create rooms which scheduled time has come
+func (m *SchedulerModel) checkScheduledRooms() {
+	locked := m.rs.IsSchedulerTaskLock("checkScheduledRooms")
+	if locked {
+		// if lock then we will not perform here
+		return
+	}
+
+	// now set lock
+	_ = m.rs.LockSchedulerTask("checkScheduledRooms", time.Minute*5)
+	// clean at the end
+	defer m.rs.UnlockSchedulerTask("checkScheduledRooms")
+
+	rooms, err := m.ds.GetDueScheduledRooms(time.Now().Unix())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	for _, r := range rooms {
+		if err := m.roomScheduleModel.StartScheduledRoom(context.Background(), &r); err != nil {
+			log.Errorln(err)
+		}
+	}
+}
@@ -64,3 +64,30 @@ func (m *SchedulerModel) changeUserStatus(roomId, userId string) {
 		m.natsService.BroadcastUserInfoToRoom(plugnmeet.NatsMsgServerToClientEvents_USER_OFFLINE, roomId, userId, info)
 	}
 }
+
+// checkWaitingRoomQueues will reject users who are waiting too long
+func (m *SchedulerModel) checkWaitingRoomQueues() {
+	if m.app.WaitingRoomSettings.AutoRejectAfter <= 0 {
+		return
+	}
+	locked := m.rs.IsSchedulerTaskLock("checkWaitingRoomQueues")
+	if locked {
+		// if lock then we will not perform here
+		return
+	}
+	// now set lock
+	_ = m.rs.LockSchedulerTask("checkWaitingRoomQueues", time.Minute*1)
+	// clean at the end
+	defer m.rs.UnlockSchedulerTask("checkWaitingRoomQueues")
+
+	roomIds, err := m.rs.WaitingRoomGetQueueRoomIds()
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	wrm := NewWaitingRoomModel(m.app, m.rs)
+	for _, roomId := range roomIds {
+		wrm.RejectTimedOutUsers(roomId)
+	}
+}
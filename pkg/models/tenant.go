@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
+)
+
+type TenantModel struct {
+	app *config.AppConfig
+	ds  *dbservice.DatabaseService
+}
+
+func NewTenantModel(app *config.AppConfig, ds *dbservice.DatabaseService) *TenantModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if ds == nil {
+		ds = dbservice.New(app.DB)
+	}
+
+	return &TenantModel{
+		app: app,
+		ds:  ds,
+	}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+)
+
+// GetActiveTenantByApiKey will return nil if no active tenant was found with the key
+func (m *TenantModel) GetActiveTenantByApiKey(apiKey string) (*dbmodels.Tenant, error) {
+	tenant, err := m.ds.GetTenantByApiKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil || tenant.IsActive != 1 {
+		return nil, nil
+	}
+
+	return tenant, nil
+}
+
+// CheckRoomQuota will return error if the tenant has reached the limit of concurrent rooms
+func (m *TenantModel) CheckRoomQuota(tenantId string) error {
+	tenant, err := m.getActiveTenant(tenantId)
+	if err != nil {
+		return err
+	}
+	if tenant.MaxConcurrentRooms <= 0 {
+		return nil
+	}
+
+	usage, err := m.ds.GetTenantUsage(tenantId)
+	if err != nil {
+		return err
+	}
+	if usage.Rooms >= int64(tenant.MaxConcurrentRooms) {
+		return fmt.Errorf("tenant has reached the limit of %d concurrent rooms", tenant.MaxConcurrentRooms)
+	}
+
+	return nil
+}
+
+// CheckParticipantQuota will return error if the tenant has reached the limit of participants
+// in all the running rooms
+func (m *TenantModel) CheckParticipantQuota(tenantId string) error {
+	tenant, err := m.getActiveTenant(tenantId)
+	if err != nil {
+		return err
+	}
+	if tenant.MaxParticipants <= 0 {
+		return nil
+	}
+
+	usage, err := m.ds.GetTenantUsage(tenantId)
+	if err != nil {
+		return err
+	}
+	if usage.Participants >= int64(tenant.MaxParticipants) {
+		return errors.New("notifications.max-num-participates-exceeded")
+	}
+
+	return nil
+}
+
+// CheckRoomAccess will make sure that the running room belongs to the tenant.
+// Empty tenantId means request was made using the main API key, which has access to everything
+func (m *TenantModel) CheckRoomAccess(tenantId, roomId string) error {
+	if tenantId == "" {
+		return nil
+	}
+
+	info, err := m.ds.GetRoomInfoByRoomId(roomId, 1)
+	if err != nil {
+		return err
+	}
+	if info != nil && info.TenantId != tenantId {
+		return errors.New("room not found")
+	}
+
+	return nil
+}
+
+func (m *TenantModel) CheckRecordingAccess(tenantId, recordId string) error {
+	if tenantId == "" {
+		return nil
+	}
+
+	info, err := m.ds.GetRecording(recordId)
+	if err != nil {
+		return err
+	}
+	if info == nil || info.TenantId != tenantId {
+		return errors.New("no recording found")
+	}
+
+	return nil
+}
+
+func (m *TenantModel) CheckAnalyticsAccess(tenantId, fileId string) error {
+	if tenantId == "" {
+		return nil
+	}
+
+	info, err := m.ds.GetAnalyticByFileId(fileId)
+	if err != nil {
+		return err
+	}
+	if info == nil || info.TenantId != tenantId {
+		return errors.New("no analytics found")
+	}
+
+	return nil
+}
+
+func (m *TenantModel) getActiveTenant(tenantId string) (*dbmodels.Tenant, error) {
+	tenant, err := m.ds.GetTenant(tenantId)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil || tenant.IsActive != 1 {
+		return nil, errors.New("tenant not found or disabled")
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"time"
+)
+
+// CreateTenant will create a new tenant with its own API key & secret
+func (m *TenantModel) CreateTenant(r *protocol.CreateTenantReq) (*protocol.TenantInfo, error) {
+	if r.ApiKey == m.app.Client.ApiKey {
+		return nil, errors.New("api_key is already in use")
+	}
+
+	info := &dbmodels.Tenant{
+		TenantId: uuid.NewString(),
+		Name:     r.Name,
+		ApiKey:   r.ApiKey,
+		Secret:   r.Secret,
+		IsActive: 1,
+	}
+	if info.ApiKey == "" {
+		k, err := generateTenantRandomString(12)
+		if err != nil {
+			return nil, err
+		}
+		info.ApiKey = "pnm_" + k
+	}
+	if info.Secret == "" {
+		s, err := generateTenantRandomString(32)
+		if err != nil {
+			return nil, err
+		}
+		info.Secret = s
+	}
+	m.applyTenantLimits(info, r.MaxConcurrentRooms, r.MaxParticipants, r.IsActive)
+
+	exist, err := m.ds.GetTenantByApiKey(info.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	if exist != nil {
+		return nil, errors.New("api_key is already in use")
+	}
+
+	_, err = m.ds.InsertOrUpdateTenant(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return prepareTenantInfo(info), nil
+}
+
+// UpdateTenant will update the tenant, empty fields will be ignored.
+// API key can't be changed
+func (m *TenantModel) UpdateTenant(r *protocol.UpdateTenantReq) (*protocol.TenantInfo, error) {
+	info, err := m.ds.GetTenant(r.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, errors.New("no tenant found")
+	}
+
+	if r.Name != "" {
+		info.Name = r.Name
+	}
+	if r.Secret != "" {
+		info.Secret = r.Secret
+	}
+	m.applyTenantLimits(info, r.MaxConcurrentRooms, r.MaxParticipants, r.IsActive)
+
+	_, err = m.ds.InsertOrUpdateTenant(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return prepareTenantInfo(info), nil
+}
+
+func (m *TenantModel) DeleteTenant(r *protocol.DeleteTenantReq) error {
+	affected, err := m.ds.DeleteTenant(r.TenantId)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("no tenant found")
+	}
+
+	return nil
+}
+
+func (m *TenantModel) FetchTenants() ([]*protocol.TenantInfo, error) {
+	tenants, err := m.ds.GetTenants()
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.TenantInfo
+	for _, t := range tenants {
+		list = append(list, prepareTenantInfo(&t))
+	}
+
+	return list, nil
+}
+
+// applyTenantLimits will change only the present values
+func (m *TenantModel) applyTenantLimits(info *dbmodels.Tenant, maxConcurrentRooms, maxParticipants *int32, isActive *bool) {
+	if maxConcurrentRooms != nil && *maxConcurrentRooms >= 0 {
+		info.MaxConcurrentRooms = int(*maxConcurrentRooms)
+	}
+	if maxParticipants != nil && *maxParticipants >= 0 {
+		info.MaxParticipants = int(*maxParticipants)
+	}
+	if isActive != nil {
+		info.IsActive = 0
+		if *isActive {
+			info.IsActive = 1
+		}
+	}
+}
+
+func prepareTenantInfo(info *dbmodels.Tenant) *protocol.TenantInfo {
+	return &protocol.TenantInfo{
+		TenantId:           info.TenantId,
+		Name:               info.Name,
+		ApiKey:             info.ApiKey,
+		Secret:             info.Secret,
+		MaxConcurrentRooms: int32(info.MaxConcurrentRooms),
+		MaxParticipants:    int32(info.MaxParticipants),
+		IsActive:           info.IsActive == 1,
+		Created:            info.Created.Format(time.RFC3339),
+	}
+}
+
+func generateTenantRandomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
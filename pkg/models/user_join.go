@@ -17,6 +17,12 @@ import (
 var validUserIDRegex = regexp.MustCompile("^[a-zA-Z0-9-_]+$")
 
 func (m *UserModel) GetPNMJoinToken(ctx context.Context, g *plugnmeet.GenerateTokenReq) (string, error) {
+	return m.getPNMJoinToken(ctx, g, false)
+}
+
+// getPNMJoinToken will generate the token, if skipWaiting is true the user won't be held by
+// the waiting room or the lobby, e.g. returning from breakout room as already admitted before
+func (m *UserModel) getPNMJoinToken(ctx context.Context, g *plugnmeet.GenerateTokenReq, skipWaiting bool) (string, error) {
 	// check first
 	_ = waitUntilRoomCreationCompletes(ctx, m.rs, g.GetRoomId())
 
@@ -90,6 +96,9 @@ func (m *UserModel) GetPNMJoinToken(ctx context.Context, g *plugnmeet.GenerateTo
 		if err := m.CreateNewPresenter(g); err != nil {
 			return "", err
 		}
+	} else if skipWaiting {
+		m.AssignLockSettingsToUser(meta, g)
+		g.UserInfo.UserMetadata.WaitForApproval = false
 	} else {
 		m.AssignLockSettingsToUser(meta, g)
 
@@ -97,6 +106,15 @@ func (m *UserModel) GetPNMJoinToken(ctx context.Context, g *plugnmeet.GenerateTo
 		if meta.RoomFeatures.WaitingRoomFeatures.IsActive {
 			g.UserInfo.UserMetadata.WaitForApproval = true
 		}
+
+		// user will wait in the lobby until the first admin joins
+		lm := NewRoomLobbyModel(m.app, m.rs)
+		if lm.IsLobbyActive(g.RoomId) {
+			if err := lm.HoldUser(g.RoomId, g.UserInfo.UserId); err != nil {
+				return "", err
+			}
+			g.UserInfo.UserMetadata.WaitForApproval = true
+		}
 	}
 
 	if g.UserInfo.UserMetadata.RecordWebcam == nil {
@@ -6,10 +6,16 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/pkg/services/redis"
 )
 
+const (
+	WaitingRoomDecisionApproved = "approved"
+	WaitingRoomDecisionRejected = "rejected"
+)
+
 type WaitingRoomModel struct {
-	app         *config.AppConfig
-	rs          *redisservice.RedisService
-	natsService *natsservice.NatsService
+	app            *config.AppConfig
+	rs             *redisservice.RedisService
+	natsService    *natsservice.NatsService
+	analyticsModel *AnalyticsModel
 }
 
 func NewWaitingRoomModel(app *config.AppConfig, rs *redisservice.RedisService) *WaitingRoomModel {
@@ -21,8 +27,9 @@ func NewWaitingRoomModel(app *config.AppConfig, rs *redisservice.RedisService) *
 	}
 
 	return &WaitingRoomModel{
-		app:         app,
-		rs:          rs,
-		natsService: natsservice.New(app),
+		app:            app,
+		rs:             rs,
+		natsService:    natsservice.New(app),
+		analyticsModel: NewAnalyticsModel(app, nil, rs),
 	}
 }
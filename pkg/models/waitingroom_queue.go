@@ -0,0 +1,129 @@
+package models
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+// AddUserToQueue will add the user to the waiting queue if the user needs approval
+func (m *WaitingRoomModel) AddUserToQueue(roomId, userId, metadata string) {
+	mt, err := m.natsService.UnmarshalUserMetadata(metadata)
+	if err != nil || !mt.WaitForApproval {
+		return
+	}
+
+	if err = m.rs.WaitingRoomAddUser(roomId, userId, time.Now().UnixMilli()); err != nil {
+		log.Errorln(fmt.Sprintf("error adding userId: %s to waiting queue of roomId: %s; msg: %s", userId, roomId, err.Error()))
+		return
+	}
+	m.broadcastQueuePositions(roomId)
+}
+
+// RemoveUserFromQueue will be used when the user has left without any decision
+func (m *WaitingRoomModel) RemoveUserFromQueue(roomId, userId string) {
+	if m.removeFromQueue(roomId, userId, "") {
+		m.broadcastQueuePositions(roomId)
+	}
+}
+
+// GetWaitingQueue will return waiting users in FIFO order
+func (m *WaitingRoomModel) GetWaitingQueue(roomId string) ([]*protocol.WaitingQueueUser, error) {
+	list, err := m.rs.WaitingRoomGetQueue(roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	var users []*protocol.WaitingQueueUser
+	for i, z := range list {
+		userId, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		u := &protocol.WaitingQueueUser{
+			UserId:      userId,
+			Position:    uint32(i + 1),
+			JoinedAt:    int64(z.Score),
+			WaitingTime: (now - int64(z.Score)) / 1000,
+		}
+		if info, err := m.natsService.GetUserInfo(roomId, userId); err == nil && info != nil {
+			u.Name = info.Name
+		}
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+// RejectTimedOutUsers will reject users who are waiting longer than the configured duration
+func (m *WaitingRoomModel) RejectTimedOutUsers(roomId string) {
+	if m.app.WaitingRoomSettings.AutoRejectAfter <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-m.app.WaitingRoomSettings.AutoRejectAfter).UnixMilli()
+	userIds, err := m.rs.WaitingRoomGetUsersAddedBefore(roomId, before)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	if len(userIds) == 0 {
+		return
+	}
+
+	err = m.RejectWaitingUsers(&protocol.RejectWaitingUsersReq{
+		RoomId:  roomId,
+		UserIds: userIds,
+		Reason:  "notifications.waiting-room-request-timeout",
+	})
+	if err != nil {
+		log.Errorln(fmt.Sprintf("error auto rejecting waiting users of roomId: %s; msg: %s", roomId, err.Error()))
+	}
+}
+
+// CleanUpWaitingQueue should be called after the room has ended
+func (m *WaitingRoomModel) CleanUpWaitingQueue(roomId string) error {
+	return m.rs.WaitingRoomDeleteQueue(roomId)
+}
+
+// removeFromQueue will remove the user from the queue & record the waiting time for the decision
+func (m *WaitingRoomModel) removeFromQueue(roomId, userId, decision string) bool {
+	joinedAt, err := m.rs.WaitingRoomRemoveUser(roomId, userId)
+	if err != nil {
+		log.Errorln(err)
+		return false
+	}
+	if joinedAt == 0 {
+		return false
+	}
+
+	if decision != "" {
+		waited := time.Now().UnixMilli() - joinedAt
+		m.analyticsModel.handleServerUserEvent(roomId, userId, analyticsEventUserWaitingRoomDuration, fmt.Sprintf("%s:%d", decision, waited))
+	}
+
+	return true
+}
+
+// broadcastQueuePositions will notify every waiting user about the current position
+func (m *WaitingRoomModel) broadcastQueuePositions(roomId string) {
+	queue, err := m.GetWaitingQueue(roomId)
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	total := strconv.Itoa(len(queue))
+	for _, u := range queue {
+		params := map[string]string{
+			"position": strconv.Itoa(int(u.Position)),
+			"total":    total,
+		}
+		if err := m.natsService.NotifyI18nInfoMsg(roomId, "notifications.waiting-room-queue-position", params, false, &u.UserId); err != nil {
+			log.Errorln(err)
+		}
+	}
+}
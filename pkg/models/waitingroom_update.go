@@ -2,34 +2,127 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	log "github.com/sirupsen/logrus"
 )
 
 func (m *WaitingRoomModel) ApproveWaitingUsers(r *plugnmeet.ApproveWaitingUsersReq) error {
 	if r.UserId == "all" {
-		participants, err := m.natsService.GetOnlineUsersList(r.RoomId)
+		// approve in the same order as they are waiting
+		queue, err := m.GetWaitingQueue(r.RoomId)
 		if err != nil {
 			return err
 		}
+		approved := make(map[string]bool)
+		for _, u := range queue {
+			if err := m.approveWaitingUser(r.RoomId, u.UserId); err != nil {
+				log.Errorln(err)
+			}
+			approved[u.UserId] = true
+		}
 
+		// users who may not be in the queue
+		participants, err := m.natsService.GetOnlineUsersList(r.RoomId)
+		if err != nil {
+			return err
+		}
 		for _, p := range participants {
-			err = m.approveUser(r.RoomId, r.UserId, p.Metadata)
-			log.Errorln(err)
+			if approved[p.UserId] {
+				continue
+			}
+			if err := m.approveUser(r.RoomId, p.UserId, p.Metadata); err != nil {
+				log.Errorln(err)
+			}
 		}
+		m.broadcastQueuePositions(r.RoomId)
 
 		return nil
 	}
 
-	p, err := m.natsService.GetUserInfo(r.RoomId, r.UserId)
+	if err := m.approveWaitingUser(r.RoomId, r.UserId); err != nil {
+		return err
+	}
+	m.broadcastQueuePositions(r.RoomId)
+
+	return nil
+}
+
+// ApproveNextWaitingUsers will approve the first N users of the queue
+func (m *WaitingRoomModel) ApproveNextWaitingUsers(r *protocol.ApproveNextWaitingUsersReq) (uint32, error) {
+	if r.Count < 1 {
+		return 0, errors.New("count should be greater than 0")
+	}
+
+	queue, err := m.GetWaitingQueue(r.RoomId)
+	if err != nil {
+		return 0, err
+	}
+	if len(queue) == 0 {
+		return 0, errors.New("no user is waiting")
+	}
+
+	var approved uint32
+	for _, u := range queue {
+		if approved == r.Count {
+			break
+		}
+		if err := m.approveWaitingUser(r.RoomId, u.UserId); err != nil {
+			log.Errorln(err)
+			continue
+		}
+		approved++
+	}
+	m.broadcastQueuePositions(r.RoomId)
+
+	return approved, nil
+}
+
+// RejectWaitingUsers will disconnect requested users from the waiting room with the reason
+func (m *WaitingRoomModel) RejectWaitingUsers(r *protocol.RejectWaitingUsersReq) error {
+	if len(r.UserIds) == 0 {
+		return errors.New("user_ids is required")
+	}
+	if r.Reason == "" {
+		r.Reason = "notifications.waiting-room-request-rejected"
+	}
+
+	userIds := r.UserIds
+	if len(userIds) == 1 && userIds[0] == "all" {
+		queue, err := m.GetWaitingQueue(r.RoomId)
+		if err != nil {
+			return err
+		}
+		userIds = nil
+		for _, u := range queue {
+			userIds = append(userIds, u.UserId)
+		}
+	}
+
+	var errs []error
+	for _, id := range userIds {
+		if err := m.rejectUser(r.RoomId, id, r.Reason, r.BlockUser); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+		}
+	}
+	m.broadcastQueuePositions(r.RoomId)
+
+	return errors.Join(errs...)
+}
+
+func (m *WaitingRoomModel) approveWaitingUser(roomId, userId string) error {
+	p, err := m.natsService.GetUserInfo(roomId, userId)
 	if err != nil {
 		return err
 	}
 	if p == nil {
+		// not exist anymore
+		m.removeFromQueue(roomId, userId, "")
 		return errors.New("user not found")
 	}
 
-	return m.approveUser(r.RoomId, r.UserId, p.Metadata)
+	return m.approveUser(roomId, userId, p.Metadata)
 }
 
 func (m *WaitingRoomModel) approveUser(roomId, userId, metadata string) error {
@@ -37,16 +130,51 @@ func (m *WaitingRoomModel) approveUser(roomId, userId, metadata string) error {
 	if err != nil {
 		return err
 	}
+	if !mt.WaitForApproval {
+		// already approved
+		m.removeFromQueue(roomId, userId, "")
+		return nil
+	}
 	mt.WaitForApproval = false // this mean doesn't need to wait anymore
 
 	err = m.natsService.UpdateAndBroadcastUserMetadata(roomId, userId, mt, nil)
 	if err != nil {
 		return errors.New("can't approve user. try again")
 	}
+	m.removeFromQueue(roomId, userId, WaitingRoomDecisionApproved)
 
 	return nil
 }
 
+func (m *WaitingRoomModel) rejectUser(roomId, userId, reason string, block bool) error {
+	p, err := m.natsService.GetUserInfo(roomId, userId)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		m.removeFromQueue(roomId, userId, "")
+		return errors.New("user not found")
+	}
+
+	mt, err := m.natsService.UnmarshalUserMetadata(p.Metadata)
+	if err != nil {
+		return err
+	}
+	if !mt.WaitForApproval {
+		return errors.New("user isn't waiting for approval")
+	}
+	m.removeFromQueue(roomId, userId, WaitingRoomDecisionRejected)
+
+	if block {
+		if _, err = m.natsService.AddUserToBlockList(roomId, userId); err != nil {
+			log.Errorln(fmt.Sprintf("error AddUserToBlockList roomId %s; userId: %s; msg: %s", roomId, userId, err))
+		}
+	}
+
+	// client will be disconnected with the reason
+	return m.natsService.BroadcastSystemEventToRoom(plugnmeet.NatsMsgServerToClientEvents_SESSION_ENDED, roomId, reason, &userId)
+}
+
 func (m *WaitingRoomModel) UpdateWaitingRoomMessage(r *plugnmeet.UpdateWaitingRoomMessageReq) error {
 	roomMeta, err := m.natsService.GetRoomMetadataStruct(r.RoomId)
 	if err != nil {
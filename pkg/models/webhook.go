@@ -46,6 +46,11 @@ func NewWebhookModel(ctx context.Context, app *config.AppConfig, ds *dbservice.D
 	}
 }
 
+// StartDeliveryWorker will start sending the queued webhooks from this server
+func (m *WebhookModel) StartDeliveryWorker() {
+	m.webhookNotifier.StartDeliveryWorker()
+}
+
 func (m *WebhookModel) HandleWebhookEvents(e *livekit.WebhookEvent) {
 	switch e.GetEvent() {
 	case "room_started":
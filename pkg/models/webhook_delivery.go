@@ -0,0 +1,143 @@
+package models
+
+import (
+	"errors"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-server/pkg/helpers"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	log "github.com/sirupsen/logrus"
+	"slices"
+	"time"
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 100
+	maxWebhookDeliveriesLimit     = 1000
+)
+
+// webhookDeliveryStatuses empty value means all
+var webhookDeliveryStatuses = []string{
+	"",
+	helpers.WebhookDeliveryStatusPending,
+	helpers.WebhookDeliveryStatusDelivered,
+	helpers.WebhookDeliveryStatusFailed,
+	helpers.WebhookDeliveryStatusDead,
+}
+
+// FetchWebhookDeliveries will return the delivery log of the room session.
+// For status dead, entries will be read from the dead-letter store
+func (m *WebhookModel) FetchWebhookDeliveries(r *protocol.FetchWebhookDeliveriesReq) ([]*helpers.WebhookDelivery, error) {
+	if r.RoomSid == "" {
+		return nil, errors.New("room_sid is required")
+	}
+	if !slices.Contains(webhookDeliveryStatuses, r.Status) {
+		return nil, errors.New("invalid status")
+	}
+	if r.Limit <= 0 {
+		r.Limit = defaultWebhookDeliveriesLimit
+	} else if r.Limit > maxWebhookDeliveriesLimit {
+		r.Limit = maxWebhookDeliveriesLimit
+	}
+
+	from, to := webhookDeliveriesTimeRange(r.From, r.To)
+	var data [][]byte
+	var err error
+	if r.Status == helpers.WebhookDeliveryStatusDead {
+		data, err = m.natsService.GetWebhookDeadLetters(r.RoomSid, from, to, int(r.Limit))
+	} else {
+		data, err = m.natsService.GetWebhookDeliveryLogs(r.RoomSid, r.Status, from, to, int(r.Limit))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*helpers.WebhookDelivery
+	for _, d := range data {
+		info := new(helpers.WebhookDelivery)
+		if err := json.Unmarshal(d, info); err != nil {
+			log.Errorln(err)
+			continue
+		}
+		list = append(list, info)
+	}
+
+	return list, nil
+}
+
+// ReplayWebhookDeliveries will put the matched deliveries in the queue again.
+// Replayed dead letters will be removed from the dead-letter store.
+// It will return the new deliveries
+func (m *WebhookModel) ReplayWebhookDeliveries(r *protocol.ReplayWebhookDeliveriesReq) ([]*helpers.WebhookDelivery, error) {
+	if r.Status == "" {
+		r.Status = helpers.WebhookDeliveryStatusDead
+	}
+
+	list, err := m.FetchWebhookDeliveries(&protocol.FetchWebhookDeliveriesReq{
+		RoomSid: r.RoomSid,
+		Status:  r.Status,
+		From:    r.From,
+		To:      r.To,
+		Limit:   maxWebhookDeliveriesLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var replayed []*helpers.WebhookDelivery
+	// log may contain multiple entries for the same delivery
+	done := make(map[string]bool)
+	for _, d := range list {
+		if done[d.Id] {
+			continue
+		}
+		if len(r.DeliveryIds) > 0 && !slices.Contains(r.DeliveryIds, d.Id) {
+			continue
+		}
+		done[d.Id] = true
+
+		nd, err := m.webhookNotifier.ReplayWebhookDelivery(d)
+		if err != nil {
+			return replayed, err
+		}
+		replayed = append(replayed, nd)
+
+		if r.Status == helpers.WebhookDeliveryStatusDead {
+			// it's in the queue again, so it should not be replayed twice
+			if err := m.natsService.DeleteWebhookDeadLetter(d.RoomSid, d.Id); err != nil {
+				log.Errorln(err)
+			}
+		}
+	}
+
+	return replayed, nil
+}
+
+// ToWebhookDeliveryInfo will convert the delivery for the API response
+func ToWebhookDeliveryInfo(d *helpers.WebhookDelivery) *protocol.WebhookDeliveryInfo {
+	return &protocol.WebhookDeliveryInfo{
+		Id:         d.Id,
+		EventId:    d.EventId,
+		Event:      d.Event,
+		RoomId:     d.RoomId,
+		RoomSid:    d.RoomSid,
+		Url:        d.Url,
+		EndpointId: d.EndpointId,
+		Payload:    d.Payload,
+		Status:     d.Status,
+		Attempts:   int32(d.Attempts),
+		HttpStatus: int32(d.HttpStatus),
+		LastError:  d.LastError,
+		ReplayOf:   d.ReplayOf,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+	}
+}
+
+func webhookDeliveriesTimeRange(from, to int64) (time.Time, time.Time) {
+	start := time.Unix(from, 0)
+	end := time.Now()
+	if to > 0 {
+		end = time.Unix(to, 0)
+	}
+	return start, end
+}
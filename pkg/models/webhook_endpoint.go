@@ -0,0 +1,186 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CreateWebhookEndpoint will register a new endpoint to receive webhook events.
+// Endpoint of a tenant will receive events of the rooms of the same tenant only
+func (m *WebhookModel) CreateWebhookEndpoint(r *protocol.CreateWebhookEndpointReq, tenantId string) (*protocol.WebhookEndpointInfo, error) {
+	if err := validateWebhookEndpointUrl(r.Url); err != nil {
+		return nil, err
+	}
+
+	info := &dbmodels.WebhookEndpoint{
+		EndpointId: uuid.NewString(),
+		Name:       r.Name,
+		Url:        r.Url,
+		Secret:     r.Secret,
+		Events:     formatWebhookEndpointEvents(r.Events),
+		IsActive:   1,
+		TenantId:   tenantId,
+	}
+	if r.IsActive != nil && !r.GetIsActive() {
+		info.IsActive = 0
+	}
+	if info.Secret == "" {
+		secret, err := generateWebhookEndpointSecret()
+		if err != nil {
+			return nil, err
+		}
+		info.Secret = secret
+	}
+
+	_, err := m.ds.InsertOrUpdateWebhookEndpoint(info)
+	if err != nil {
+		return nil, err
+	}
+	m.webhookNotifier.WebhookEndpointsChanged()
+
+	return prepareWebhookEndpointInfo(info), nil
+}
+
+// UpdateWebhookEndpoint will update the endpoint, empty fields will be ignored.
+// To subscribe to all events again send events with *
+func (m *WebhookModel) UpdateWebhookEndpoint(r *protocol.UpdateWebhookEndpointReq, tenantId string) (*protocol.WebhookEndpointInfo, error) {
+	info, err := m.getWebhookEndpoint(r.EndpointId, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Name != "" {
+		info.Name = r.Name
+	}
+	if r.Url != "" {
+		if err := validateWebhookEndpointUrl(r.Url); err != nil {
+			return nil, err
+		}
+		info.Url = r.Url
+	}
+	if len(r.Events) > 0 {
+		info.Events = formatWebhookEndpointEvents(r.Events)
+	}
+	if r.Secret != "" {
+		info.Secret = r.Secret
+	}
+	if r.IsActive != nil {
+		info.IsActive = 0
+		if r.GetIsActive() {
+			info.IsActive = 1
+		}
+	}
+
+	_, err = m.ds.InsertOrUpdateWebhookEndpoint(info)
+	if err != nil {
+		return nil, err
+	}
+	m.webhookNotifier.WebhookEndpointsChanged()
+
+	return prepareWebhookEndpointInfo(info), nil
+}
+
+func (m *WebhookModel) DeleteWebhookEndpoint(r *protocol.DeleteWebhookEndpointReq, tenantId string) error {
+	if _, err := m.getWebhookEndpoint(r.EndpointId, tenantId); err != nil {
+		return err
+	}
+
+	affected, err := m.ds.DeleteWebhookEndpoint(r.EndpointId)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("no endpoint found")
+	}
+	m.webhookNotifier.WebhookEndpointsChanged()
+
+	return nil
+}
+
+// FetchWebhookEndpoints will return endpoints of the tenant,
+// empty tenantId means the main API key was used, which can access all the endpoints
+func (m *WebhookModel) FetchWebhookEndpoints(tenantId string) ([]*protocol.WebhookEndpointInfo, error) {
+	endpoints, err := m.ds.GetWebhookEndpoints(tenantId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.WebhookEndpointInfo
+	for _, ep := range endpoints {
+		list = append(list, prepareWebhookEndpointInfo(&ep))
+	}
+
+	return list, nil
+}
+
+// getWebhookEndpoint will return the endpoint if the tenant has access to it
+func (m *WebhookModel) getWebhookEndpoint(endpointId, tenantId string) (*dbmodels.WebhookEndpoint, error) {
+	info, err := m.ds.GetWebhookEndpoint(endpointId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return nil, errors.New("no endpoint found")
+	}
+
+	return info, nil
+}
+
+func prepareWebhookEndpointInfo(info *dbmodels.WebhookEndpoint) *protocol.WebhookEndpointInfo {
+	events := make([]string, 0)
+	if info.Events != "" {
+		events = strings.Split(info.Events, ",")
+	}
+
+	return &protocol.WebhookEndpointInfo{
+		EndpointId: info.EndpointId,
+		Name:       info.Name,
+		Url:        info.Url,
+		Events:     events,
+		Secret:     info.Secret,
+		IsActive:   info.IsActive == 1,
+		Created:    info.Created.Format(time.RFC3339),
+	}
+}
+
+func validateWebhookEndpointUrl(u string) error {
+	parsed, err := url.ParseRequestURI(u)
+	if err != nil {
+		return errors.New("invalid url")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New("url must use http or https")
+	}
+	return nil
+}
+
+// formatWebhookEndpointEvents will store event names in lowercase,
+// same as the notifier sends them. * means all events, so empty value will be stored
+func formatWebhookEndpointEvents(events []string) string {
+	var list []string
+	for _, e := range events {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e == "*" {
+			return ""
+		}
+		if e != "" {
+			list = append(list, e)
+		}
+	}
+	return strings.Join(list, ",")
+}
+
+func generateWebhookEndpointSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/db"
+	natsservice "github.com/mynaparrot/plugnmeet-server/pkg/services/nats"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+)
+
+// Files of the snapshot are stored as:
+// snapshotId/events.json & snapshotId/files/fileId/page_N.png
+const (
+	whiteboardSnapshotEventsFile = "events.json"
+	whiteboardSnapshotFilesDir   = "files"
+)
+
+type WhiteboardSnapshotModel struct {
+	app         *config.AppConfig
+	ds          *dbservice.DatabaseService
+	natsService *natsservice.NatsService
+	storage     storageservice.Storage
+}
+
+func NewWhiteboardSnapshotModel(app *config.AppConfig, ds *dbservice.DatabaseService) *WhiteboardSnapshotModel {
+	if app == nil {
+		app = config.GetConfig()
+	}
+	if ds == nil {
+		ds = dbservice.New(app.DB)
+	}
+
+	return &WhiteboardSnapshotModel{
+		app:         app,
+		ds:          ds,
+		natsService: natsservice.New(app),
+		storage:     storageservice.New(app, storageservice.AreaWhiteboardSnaps),
+	}
+}
+
+// WhiteboardSnapshotEvents is the content of events.json file of the snapshot
+type WhiteboardSnapshotEvents struct {
+	RoomId      string   `json:"room_id"`
+	RoomSid     string   `json:"room_sid"`
+	FileId      string   `json:"file_id"`
+	FileName    string   `json:"file_name"`
+	TotalPages  uint32   `json:"total_pages"`
+	TotalEvents int      `json:"total_events"`
+	Events      [][]byte `json:"events"`
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	log "github.com/sirupsen/logrus"
+	"path"
+	"strings"
+)
+
+// CreateRoomWhiteboardSnapshot will save whiteboard events & office file pages of the session.
+// It must be called before the room stream & uploaded files have been deleted
+func (m *WhiteboardSnapshotModel) CreateRoomWhiteboardSnapshot(roomId, roomSid, metadata string) error {
+	if m.app.WhiteboardSnapshotSettings == nil || !m.app.WhiteboardSnapshotSettings.Enabled || roomSid == "" || metadata == "" {
+		return nil
+	}
+
+	meta, err := m.natsService.UnmarshalRoomMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	wbf := meta.GetRoomFeatures().GetWhiteboardFeatures()
+	if wbf == nil || !wbf.AllowedWhiteboard {
+		return nil
+	}
+
+	isRunning := 0
+	room, err := m.ds.GetRoomInfoBySid(roomSid, &isRunning)
+	if err != nil {
+		return err
+	}
+	if room == nil {
+		return nil
+	}
+
+	events, err := m.natsService.GetRoomWhiteboardMessages(roomId)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	uploads := storageservice.New(m.app, storageservice.AreaUploads)
+	// all the office files converted during the session
+	pages, totalFiles, err := listWhiteboardPages(ctx, uploads, roomSid)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 && len(pages) == 0 {
+		// nothing to save
+		return nil
+	}
+
+	snapshotId := uuid.NewString()
+	for _, p := range pages {
+		// key format: roomSid/fileId/page_N.png
+		dst := path.Join(snapshotId, whiteboardSnapshotFilesDir, strings.TrimPrefix(p, roomSid+"/"))
+		if err = copyStorageFile(ctx, uploads, p, m.storage, dst); err != nil {
+			_ = m.storage.DeletePrefix(ctx, snapshotId)
+			return err
+		}
+	}
+
+	content := &WhiteboardSnapshotEvents{
+		RoomId:      room.RoomId,
+		RoomSid:     room.Sid,
+		FileId:      wbf.WhiteboardFileId,
+		FileName:    wbf.FileName,
+		TotalPages:  wbf.TotalPages,
+		TotalEvents: len(events),
+		Events:      events,
+	}
+	marshal, err := json.Marshal(content)
+	if err != nil {
+		_ = m.storage.DeletePrefix(ctx, snapshotId)
+		return err
+	}
+	err = m.storage.Put(ctx, path.Join(snapshotId, whiteboardSnapshotEventsFile), bytes.NewReader(marshal), int64(len(marshal)), "application/json")
+	if err != nil {
+		_ = m.storage.DeletePrefix(ctx, snapshotId)
+		return err
+	}
+
+	info := &dbmodels.WhiteboardSnapshot{
+		SnapshotId:       snapshotId,
+		RoomTableID:      room.ID,
+		RoomId:           room.RoomId,
+		RoomSid:          room.Sid,
+		TenantId:         room.TenantId,
+		FileId:           wbf.WhiteboardFileId,
+		FileName:         wbf.FileName,
+		TotalPages:       int(wbf.TotalPages),
+		TotalFiles:       totalFiles,
+		TotalEvents:      len(events),
+		RoomCreationTime: room.CreationTime,
+	}
+	if _, err = m.ds.InsertWhiteboardSnapshot(info); err != nil {
+		_ = m.storage.DeletePrefix(ctx, snapshotId)
+		return err
+	}
+
+	log.Infoln(fmt.Sprintf("whiteboard snapshot: %s created for roomId: %s, roomSid: %s with %d events & %d files", snapshotId, room.RoomId, room.Sid, len(events), totalFiles))
+	return nil
+}
+
+// listWhiteboardPages will return keys of the converted page images under the prefix
+// with format: prefix/fileId/page_N.png & the total number of files
+func listWhiteboardPages(ctx context.Context, s storageservice.Storage, prefix string) ([]string, int, error) {
+	keys, err := s.List(ctx, prefix)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var pages []string
+	files := make(map[string]struct{})
+	for _, k := range keys {
+		parts := strings.Split(strings.TrimPrefix(k, prefix+"/"), "/")
+		if len(parts) != 2 {
+			continue
+		}
+		if ok, _ := path.Match("page_*.png", parts[1]); !ok {
+			continue
+		}
+		pages = append(pages, k)
+		files[parts[0]] = struct{}{}
+	}
+
+	return pages, len(files), nil
+}
+
+// copyStorageFile will copy the file from one storage to another one
+func copyStorageFile(ctx context.Context, src storageservice.Storage, srcKey string, dst storageservice.Storage, dstKey string) error {
+	rd, info, err := src.Get(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	return dst.Put(ctx, dstKey, rd, info.Size, info.ContentType)
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// GetWhiteboardSnapshotDownloadToken will use the same JWT token generator as plugNmeet is using
+func (m *WhiteboardSnapshotModel) GetWhiteboardSnapshotDownloadToken(r *protocol.GetWhiteboardSnapshotDownloadTokenReq, tenantId string) (string, error) {
+	if m.app.WhiteboardSnapshotSettings == nil {
+		return "", errors.New("whiteboard snapshot isn't enabled")
+	}
+
+	snapshot, err := m.fetchWhiteboardSnapshot(r.GetSnapshotId(), tenantId)
+	if err != nil {
+		return "", err
+	}
+
+	return generateFileToken(m.app, whiteboardSnapshotDownloadAudience, snapshot.SnapshotId, time.Now().UTC().Add(*m.app.WhiteboardSnapshotSettings.TokenValidity))
+}
+
+// VerifyWhiteboardSnapshotToken verify token & provide id of the snapshot
+func (m *WhiteboardSnapshotModel) VerifyWhiteboardSnapshotToken(token string) (string, int, error) {
+	if m.app.WhiteboardSnapshotSettings == nil {
+		return "", fiber.StatusNotFound, errors.New("whiteboard snapshot isn't enabled")
+	}
+
+	snapshotId, status, err := verifyFileToken(m.app, token, whiteboardSnapshotDownloadAudience)
+	if err != nil {
+		return "", status, err
+	}
+
+	snapshotId = path.Base(snapshotId)
+	_, err = m.storage.Stat(context.Background(), path.Join(snapshotId, whiteboardSnapshotEventsFile))
+	if err != nil {
+		return "", fiber.StatusNotFound, errors.New("snapshot not found")
+	}
+
+	return snapshotId, fiber.StatusOK, nil
+}
+
+// ExportWhiteboardSnapshot will write all the files of the snapshot as zip
+func (m *WhiteboardSnapshotModel) ExportWhiteboardSnapshot(snapshotId string, w io.Writer) error {
+	ctx := context.Background()
+	keys, err := m.storage.List(ctx, snapshotId)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for _, k := range keys {
+		if err = m.addToZip(ctx, zw, k, strings.TrimPrefix(k, snapshotId+"/")); err != nil {
+			_ = zw.Close()
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func (m *WhiteboardSnapshotModel) addToZip(ctx context.Context, zw *zip.Writer, key, name string) error {
+	rd, _, err := m.storage.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, rd)
+	return err
+}
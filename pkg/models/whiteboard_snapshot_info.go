@@ -0,0 +1,81 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
+)
+
+// FetchWhiteboardSnapshots will return snapshots, empty tenantId will return snapshots of all tenants
+func (m *WhiteboardSnapshotModel) FetchWhiteboardSnapshots(r *protocol.FetchWhiteboardSnapshotsReq, tenantId string) (*protocol.FetchWhiteboardSnapshotsResult, error) {
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+	if r.OrderBy == "" {
+		r.OrderBy = "DESC"
+	}
+
+	snapshots, total, err := m.ds.GetWhiteboardSnapshots(r.RoomIds, tenantId, uint64(r.From), uint64(r.Limit), &r.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*protocol.WhiteboardSnapshotInfo
+	for _, v := range snapshots {
+		list = append(list, prepareWhiteboardSnapshotInfo(&v))
+	}
+
+	return &protocol.FetchWhiteboardSnapshotsResult{
+		TotalSnapshots: total,
+		From:           r.From,
+		Limit:          r.Limit,
+		OrderBy:        r.OrderBy,
+		SnapshotsList:  list,
+	}, nil
+}
+
+// DeleteWhiteboardSnapshot will delete the stored files & record from DB
+func (m *WhiteboardSnapshotModel) DeleteWhiteboardSnapshot(r *protocol.DeleteWhiteboardSnapshotReq, tenantId string) error {
+	snapshot, err := m.fetchWhiteboardSnapshot(r.GetSnapshotId(), tenantId)
+	if err != nil {
+		return err
+	}
+
+	if m.app.WhiteboardSnapshotSettings != nil {
+		err = m.storage.DeletePrefix(context.Background(), snapshot.SnapshotId)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = m.ds.DeleteWhiteboardSnapshot(snapshot.SnapshotId)
+	return err
+}
+
+// fetchWhiteboardSnapshot will return the snapshot if the tenant has access to it
+func (m *WhiteboardSnapshotModel) fetchWhiteboardSnapshot(snapshotId, tenantId string) (*dbmodels.WhiteboardSnapshot, error) {
+	info, err := m.ds.GetWhiteboardSnapshot(snapshotId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || (tenantId != "" && info.TenantId != tenantId) {
+		return nil, errors.New("no snapshot found")
+	}
+
+	return info, nil
+}
+
+func prepareWhiteboardSnapshotInfo(info *dbmodels.WhiteboardSnapshot) *protocol.WhiteboardSnapshotInfo {
+	return &protocol.WhiteboardSnapshotInfo{
+		SnapshotId:       info.SnapshotId,
+		RoomId:           info.RoomId,
+		RoomSid:          info.RoomSid,
+		FileName:         info.FileName,
+		TotalPages:       int32(info.TotalPages),
+		TotalFiles:       int32(info.TotalFiles),
+		TotalEvents:      int32(info.TotalEvents),
+		RoomCreationTime: info.RoomCreationTime,
+		CreationTime:     info.CreationTime,
+	}
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	"path"
+	"strings"
+)
+
+// PreloadWhiteboardSnapshot will restore the whiteboard of the room from the snapshot.
+// Saved events will be published again into the room stream
+// & the office file which was active on the whiteboard will be loaded
+func (m *WhiteboardSnapshotModel) PreloadWhiteboardSnapshot(roomId, roomSid, tenantId, snapshotId string) error {
+	if m.app.WhiteboardSnapshotSettings == nil {
+		return errors.New("whiteboard snapshot isn't enabled")
+	}
+
+	snapshot, err := m.fetchWhiteboardSnapshot(snapshotId, tenantId)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	content, err := m.readWhiteboardSnapshotEvents(ctx, snapshot.SnapshotId)
+	if err != nil {
+		return err
+	}
+
+	// pages will be processed in the local upload path first as same as the converted files.
+	// The same file ids will be used because the restored events are referring to them
+	filesPrefix := path.Join(snapshot.SnapshotId, whiteboardSnapshotFilesDir)
+	pages, _, err := listWhiteboardPages(ctx, m.storage, filesPrefix)
+	if err != nil {
+		return err
+	}
+	local := storageservice.NewLocalStorage(m.app.UploadFileSettings.Path)
+	fileIds := make(map[string]struct{})
+	var totalPages int
+	for _, p := range pages {
+		rel := strings.TrimPrefix(p, filesPrefix+"/")
+		if err = copyStorageFile(ctx, m.storage, p, local, path.Join(roomSid, rel)); err != nil {
+			return err
+		}
+		fileId := path.Dir(rel)
+		fileIds[fileId] = struct{}{}
+		if fileId == snapshot.FileId {
+			totalPages++
+		}
+	}
+
+	fm := NewFileModel(m.app, m.ds, m.natsService)
+	for fileId := range fileIds {
+		fm.copyToStorage(path.Join(roomSid, fileId))
+	}
+
+	if err = m.natsService.PublishWhiteboardSnapshotEvents(roomId, content.Events); err != nil {
+		return err
+	}
+
+	if snapshot.FileId == "" {
+		return nil
+	}
+	if totalPages == 0 {
+		return errors.New("whiteboard file of the snapshot not found")
+	}
+
+	return fm.updateRoomMetadataWithOfficeFile(roomId, &ConvertWhiteboardFileRes{
+		Status:     true,
+		Msg:        "success",
+		FileName:   snapshot.FileName,
+		FileId:     snapshot.FileId,
+		FilePath:   path.Join(roomSid, snapshot.FileId),
+		TotalPages: totalPages,
+	})
+}
+
+func (m *WhiteboardSnapshotModel) readWhiteboardSnapshotEvents(ctx context.Context, snapshotId string) (*WhiteboardSnapshotEvents, error) {
+	rd, _, err := m.storage.Get(ctx, path.Join(snapshotId, whiteboardSnapshotEventsFile))
+	if err != nil {
+		return nil, fmt.Errorf("events of the snapshot not found: %w", err)
+	}
+	defer rd.Close()
+
+	content := new(WhiteboardSnapshotEvents)
+	if err = json.NewDecoder(rd).Decode(content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
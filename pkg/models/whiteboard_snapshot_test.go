@@ -0,0 +1,33 @@
+package models
+
+import (
+	"context"
+	"github.com/mynaparrot/plugnmeet-server/pkg/services/storage"
+	"strings"
+	"testing"
+)
+
+func TestListWhiteboardPages(t *testing.T) {
+	ctx := context.Background()
+	s := storageservice.NewLocalStorage(t.TempDir())
+	for _, k := range []string{
+		"sid/file1/page_1.png",
+		"sid/file1/page_2.png",
+		"sid/file1/file.pdf",
+		"sid/file2/page_1.png",
+		"sid/upload.png",
+		"other/file3/page_1.png",
+	} {
+		if err := s.Put(ctx, k, strings.NewReader("data"), 4, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pages, totalFiles, err := listWhiteboardPages(ctx, s, "sid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(pages, ",") != "sid/file1/page_1.png,sid/file1/page_2.png,sid/file2/page_1.png" || totalFiles != 2 {
+		t.Errorf("unexpected pages: %v with total files: %d", pages, totalFiles)
+	}
+}
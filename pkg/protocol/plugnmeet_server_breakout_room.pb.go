@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_breakout_room.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AutoCreateBreakoutRoomsReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RoomId          string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestedUserId string                 `protobuf:"bytes,2,opt,name=requested_user_id,json=requestedUserId,proto3" json:"requested_user_id,omitempty"`
+	// strategy can be random, count, previous or self_select
+	Strategy string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// required for random & self_select. For previous, it can't be less than the number of previous rooms,
+	// 0 means the same number of rooms
+	NumberOfRooms uint32 `protobuf:"varint,4,opt,name=number_of_rooms,json=numberOfRooms,proto3" json:"number_of_rooms,omitempty"`
+	// required for count. For self_select it limits the room size
+	UsersPerRoom uint32 `protobuf:"varint,5,opt,name=users_per_room,json=usersPerRoom,proto3" json:"users_per_room,omitempty"`
+	// in minutes
+	Duration   uint64  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	WelcomeMsg *string `protobuf:"bytes,7,opt,name=welcome_msg,json=welcomeMsg,proto3,oneof" json:"welcome_msg,omitempty"`
+	// optional, otherwise "Room 1", "Room 2"...
+	Titles        []string `protobuf:"bytes,8,rep,name=titles,proto3" json:"titles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoCreateBreakoutRoomsReq) Reset() {
+	*x = AutoCreateBreakoutRoomsReq{}
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoCreateBreakoutRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoCreateBreakoutRoomsReq) ProtoMessage() {}
+
+func (x *AutoCreateBreakoutRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoCreateBreakoutRoomsReq.ProtoReflect.Descriptor instead.
+func (*AutoCreateBreakoutRoomsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_breakout_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetRequestedUserId() string {
+	if x != nil {
+		return x.RequestedUserId
+	}
+	return ""
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetNumberOfRooms() uint32 {
+	if x != nil {
+		return x.NumberOfRooms
+	}
+	return 0
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetUsersPerRoom() uint32 {
+	if x != nil {
+		return x.UsersPerRoom
+	}
+	return 0
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetWelcomeMsg() string {
+	if x != nil && x.WelcomeMsg != nil {
+		return *x.WelcomeMsg
+	}
+	return ""
+}
+
+func (x *AutoCreateBreakoutRoomsReq) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type SelectBreakoutRoomReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BreakoutRoomId string                 `protobuf:"bytes,3,opt,name=breakout_room_id,json=breakoutRoomId,proto3" json:"breakout_room_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SelectBreakoutRoomReq) Reset() {
+	*x = SelectBreakoutRoomReq{}
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectBreakoutRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectBreakoutRoomReq) ProtoMessage() {}
+
+func (x *SelectBreakoutRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectBreakoutRoomReq.ProtoReflect.Descriptor instead.
+func (*SelectBreakoutRoomReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_breakout_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SelectBreakoutRoomReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SelectBreakoutRoomReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SelectBreakoutRoomReq) GetBreakoutRoomId() string {
+	if x != nil {
+		return x.BreakoutRoomId
+	}
+	return ""
+}
+
+type SelectBreakoutRoomRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectBreakoutRoomRes) Reset() {
+	*x = SelectBreakoutRoomRes{}
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectBreakoutRoomRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectBreakoutRoomRes) ProtoMessage() {}
+
+func (x *SelectBreakoutRoomRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectBreakoutRoomRes.ProtoReflect.Descriptor instead.
+func (*SelectBreakoutRoomRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_breakout_room_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SelectBreakoutRoomRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *SelectBreakoutRoomRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SelectBreakoutRoomRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReturnToMainRoomReq struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// in seconds, config value will be used if not set
+	Countdown *int64 `protobuf:"varint,2,opt,name=countdown,proto3,oneof" json:"countdown,omitempty"`
+	// in seconds, config value will be used if not set
+	GracePeriod   *int64 `protobuf:"varint,3,opt,name=grace_period,json=gracePeriod,proto3,oneof" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnToMainRoomReq) Reset() {
+	*x = ReturnToMainRoomReq{}
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnToMainRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnToMainRoomReq) ProtoMessage() {}
+
+func (x *ReturnToMainRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnToMainRoomReq.ProtoReflect.Descriptor instead.
+func (*ReturnToMainRoomReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_breakout_room_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReturnToMainRoomReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReturnToMainRoomReq) GetCountdown() int64 {
+	if x != nil && x.Countdown != nil {
+		return *x.Countdown
+	}
+	return 0
+}
+
+func (x *ReturnToMainRoomReq) GetGracePeriod() int64 {
+	if x != nil && x.GracePeriod != nil {
+		return *x.GracePeriod
+	}
+	return 0
+}
+
+// ReturnToMainRoomMsg will be sent with RETURN_TO_MAIN_ROOM event,
+// client should use the token to join the main room
+type ReturnToMainRoomMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MainRoomId    string                 `protobuf:"bytes,1,opt,name=main_room_id,json=mainRoomId,proto3" json:"main_room_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnToMainRoomMsg) Reset() {
+	*x = ReturnToMainRoomMsg{}
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnToMainRoomMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnToMainRoomMsg) ProtoMessage() {}
+
+func (x *ReturnToMainRoomMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_breakout_room_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnToMainRoomMsg.ProtoReflect.Descriptor instead.
+func (*ReturnToMainRoomMsg) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_breakout_room_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReturnToMainRoomMsg) GetMainRoomId() string {
+	if x != nil {
+		return x.MainRoomId
+	}
+	return ""
+}
+
+func (x *ReturnToMainRoomMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+var File_plugnmeet_server_breakout_room_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_breakout_room_proto_rawDesc = "" +
+	"\n" +
+	"$plugnmeet_server_breakout_room.proto\x12\x10plugnmeet_server\"\xb5\x02\n" +
+	"\x1aAutoCreateBreakoutRoomsReq\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12*\n" +
+	"\x11requested_user_id\x18\x02 \x01(\tR\x0frequestedUserId\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x12&\n" +
+	"\x0fnumber_of_rooms\x18\x04 \x01(\rR\rnumberOfRooms\x12$\n" +
+	"\x0eusers_per_room\x18\x05 \x01(\rR\fusersPerRoom\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x04R\bduration\x12$\n" +
+	"\vwelcome_msg\x18\a \x01(\tH\x00R\n" +
+	"welcomeMsg\x88\x01\x01\x12\x16\n" +
+	"\x06titles\x18\b \x03(\tR\x06titlesB\x0e\n" +
+	"\f_welcome_msg\"s\n" +
+	"\x15SelectBreakoutRoomReq\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12(\n" +
+	"\x10breakout_room_id\x18\x03 \x01(\tR\x0ebreakoutRoomId\"W\n" +
+	"\x15SelectBreakoutRoomRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\x98\x01\n" +
+	"\x13ReturnToMainRoomReq\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12!\n" +
+	"\tcountdown\x18\x02 \x01(\x03H\x00R\tcountdown\x88\x01\x01\x12&\n" +
+	"\fgrace_period\x18\x03 \x01(\x03H\x01R\vgracePeriod\x88\x01\x01B\f\n" +
+	"\n" +
+	"_countdownB\x0f\n" +
+	"\r_grace_period\"M\n" +
+	"\x13ReturnToMainRoomMsg\x12 \n" +
+	"\fmain_room_id\x18\x01 \x01(\tR\n" +
+	"mainRoomId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05tokenB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_breakout_room_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_breakout_room_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_breakout_room_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_breakout_room_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_breakout_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_breakout_room_proto_rawDesc), len(file_plugnmeet_server_breakout_room_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_breakout_room_proto_rawDescData
+}
+
+var file_plugnmeet_server_breakout_room_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_plugnmeet_server_breakout_room_proto_goTypes = []any{
+	(*AutoCreateBreakoutRoomsReq)(nil), // 0: plugnmeet_server.AutoCreateBreakoutRoomsReq
+	(*SelectBreakoutRoomReq)(nil),      // 1: plugnmeet_server.SelectBreakoutRoomReq
+	(*SelectBreakoutRoomRes)(nil),      // 2: plugnmeet_server.SelectBreakoutRoomRes
+	(*ReturnToMainRoomReq)(nil),        // 3: plugnmeet_server.ReturnToMainRoomReq
+	(*ReturnToMainRoomMsg)(nil),        // 4: plugnmeet_server.ReturnToMainRoomMsg
+}
+var file_plugnmeet_server_breakout_room_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_breakout_room_proto_init() }
+func file_plugnmeet_server_breakout_room_proto_init() {
+	if File_plugnmeet_server_breakout_room_proto != nil {
+		return
+	}
+	file_plugnmeet_server_breakout_room_proto_msgTypes[0].OneofWrappers = []any{}
+	file_plugnmeet_server_breakout_room_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_breakout_room_proto_rawDesc), len(file_plugnmeet_server_breakout_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_breakout_room_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_breakout_room_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_breakout_room_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_breakout_room_proto = out.File
+	file_plugnmeet_server_breakout_room_proto_goTypes = nil
+	file_plugnmeet_server_breakout_room_proto_depIdxs = nil
+}
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_chat_archive.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FetchChatArchivesReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	From    uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit   uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// default DESC
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchChatArchivesReq) Reset() {
+	*x = FetchChatArchivesReq{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchChatArchivesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchChatArchivesReq) ProtoMessage() {}
+
+func (x *FetchChatArchivesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchChatArchivesReq.ProtoReflect.Descriptor instead.
+func (*FetchChatArchivesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchChatArchivesReq) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *FetchChatArchivesReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchChatArchivesReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchChatArchivesReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ChatArchiveInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArchiveId     string                 `protobuf:"bytes,1,opt,name=archive_id,json=archiveId,proto3" json:"archive_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomSid       string                 `protobuf:"bytes,3,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	TotalMessages int64                  `protobuf:"varint,4,opt,name=total_messages,json=totalMessages,proto3" json:"total_messages,omitempty"`
+	// in KB
+	FileSize         float64 `protobuf:"fixed64,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	RoomCreationTime int64   `protobuf:"varint,6,opt,name=room_creation_time,json=roomCreationTime,proto3" json:"room_creation_time,omitempty"`
+	CreationTime     int64   `protobuf:"varint,7,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChatArchiveInfo) Reset() {
+	*x = ChatArchiveInfo{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatArchiveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatArchiveInfo) ProtoMessage() {}
+
+func (x *ChatArchiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatArchiveInfo.ProtoReflect.Descriptor instead.
+func (*ChatArchiveInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChatArchiveInfo) GetArchiveId() string {
+	if x != nil {
+		return x.ArchiveId
+	}
+	return ""
+}
+
+func (x *ChatArchiveInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ChatArchiveInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *ChatArchiveInfo) GetTotalMessages() int64 {
+	if x != nil {
+		return x.TotalMessages
+	}
+	return 0
+}
+
+func (x *ChatArchiveInfo) GetFileSize() float64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ChatArchiveInfo) GetRoomCreationTime() int64 {
+	if x != nil {
+		return x.RoomCreationTime
+	}
+	return 0
+}
+
+func (x *ChatArchiveInfo) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+type FetchChatArchivesResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalArchives int64                  `protobuf:"varint,1,opt,name=total_archives,json=totalArchives,proto3" json:"total_archives,omitempty"`
+	From          uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	ArchivesList  []*ChatArchiveInfo     `protobuf:"bytes,5,rep,name=archives_list,json=archivesList,proto3" json:"archives_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchChatArchivesResult) Reset() {
+	*x = FetchChatArchivesResult{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchChatArchivesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchChatArchivesResult) ProtoMessage() {}
+
+func (x *FetchChatArchivesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchChatArchivesResult.ProtoReflect.Descriptor instead.
+func (*FetchChatArchivesResult) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FetchChatArchivesResult) GetTotalArchives() int64 {
+	if x != nil {
+		return x.TotalArchives
+	}
+	return 0
+}
+
+func (x *FetchChatArchivesResult) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchChatArchivesResult) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchChatArchivesResult) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *FetchChatArchivesResult) GetArchivesList() []*ChatArchiveInfo {
+	if x != nil {
+		return x.ArchivesList
+	}
+	return nil
+}
+
+type FetchChatArchivesRes struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        bool                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *FetchChatArchivesResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchChatArchivesRes) Reset() {
+	*x = FetchChatArchivesRes{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchChatArchivesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchChatArchivesRes) ProtoMessage() {}
+
+func (x *FetchChatArchivesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchChatArchivesRes.ProtoReflect.Descriptor instead.
+func (*FetchChatArchivesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FetchChatArchivesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchChatArchivesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchChatArchivesRes) GetResult() *FetchChatArchivesResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetChatArchiveDownloadTokenReq struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArchiveId string                 `protobuf:"bytes,1,opt,name=archive_id,json=archiveId,proto3" json:"archive_id,omitempty"`
+	// default json
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatArchiveDownloadTokenReq) Reset() {
+	*x = GetChatArchiveDownloadTokenReq{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatArchiveDownloadTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatArchiveDownloadTokenReq) ProtoMessage() {}
+
+func (x *GetChatArchiveDownloadTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatArchiveDownloadTokenReq.ProtoReflect.Descriptor instead.
+func (*GetChatArchiveDownloadTokenReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetChatArchiveDownloadTokenReq) GetArchiveId() string {
+	if x != nil {
+		return x.ArchiveId
+	}
+	return ""
+}
+
+func (x *GetChatArchiveDownloadTokenReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetChatArchiveDownloadTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token         *string                `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatArchiveDownloadTokenRes) Reset() {
+	*x = GetChatArchiveDownloadTokenRes{}
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatArchiveDownloadTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatArchiveDownloadTokenRes) ProtoMessage() {}
+
+func (x *GetChatArchiveDownloadTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_chat_archive_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatArchiveDownloadTokenRes.ProtoReflect.Descriptor instead.
+func (*GetChatArchiveDownloadTokenRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_chat_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetChatArchiveDownloadTokenRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetChatArchiveDownloadTokenRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetChatArchiveDownloadTokenRes) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+var File_plugnmeet_server_chat_archive_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_chat_archive_proto_rawDesc = "" +
+	"\n" +
+	"#plugnmeet_server_chat_archive.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\"\x8a\x01\n" +
+	"\x14FetchChatArchivesReq\x12\x19\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12-\n" +
+	"\border_by\x18\x04 \x01(\tB\x12\xbaH\x0fr\rR\x00R\x03ASCR\x04DESCR\aorderBy\"\xfb\x01\n" +
+	"\x0fChatArchiveInfo\x12\x1d\n" +
+	"\n" +
+	"archive_id\x18\x01 \x01(\tR\tarchiveId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x19\n" +
+	"\broom_sid\x18\x03 \x01(\tR\aroomSid\x12%\n" +
+	"\x0etotal_messages\x18\x04 \x01(\x03R\rtotalMessages\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x01R\bfileSize\x12,\n" +
+	"\x12room_creation_time\x18\x06 \x01(\x03R\x10roomCreationTime\x12#\n" +
+	"\rcreation_time\x18\a \x01(\x03R\fcreationTime\"\xcd\x01\n" +
+	"\x17FetchChatArchivesResult\x12%\n" +
+	"\x0etotal_archives\x18\x01 \x01(\x03R\rtotalArchives\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12F\n" +
+	"\rarchives_list\x18\x05 \x03(\v2!.plugnmeet_server.ChatArchiveInfoR\farchivesList\"\x83\x01\n" +
+	"\x14FetchChatArchivesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12A\n" +
+	"\x06result\x18\x03 \x01(\v2).plugnmeet_server.FetchChatArchivesResultR\x06result\"s\n" +
+	"\x1eGetChatArchiveDownloadTokenReq\x12%\n" +
+	"\n" +
+	"archive_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tarchiveId\x12*\n" +
+	"\x06format\x18\x02 \x01(\tB\x12\xbaH\x0fr\rR\x00R\x04jsonR\x03txtR\x06format\"o\n" +
+	"\x1eGetChatArchiveDownloadTokenRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tH\x00R\x05token\x88\x01\x01B\b\n" +
+	"\x06_tokenB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_chat_archive_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_chat_archive_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_chat_archive_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_chat_archive_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_chat_archive_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_chat_archive_proto_rawDesc), len(file_plugnmeet_server_chat_archive_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_chat_archive_proto_rawDescData
+}
+
+var file_plugnmeet_server_chat_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_plugnmeet_server_chat_archive_proto_goTypes = []any{
+	(*FetchChatArchivesReq)(nil),           // 0: plugnmeet_server.FetchChatArchivesReq
+	(*ChatArchiveInfo)(nil),                // 1: plugnmeet_server.ChatArchiveInfo
+	(*FetchChatArchivesResult)(nil),        // 2: plugnmeet_server.FetchChatArchivesResult
+	(*FetchChatArchivesRes)(nil),           // 3: plugnmeet_server.FetchChatArchivesRes
+	(*GetChatArchiveDownloadTokenReq)(nil), // 4: plugnmeet_server.GetChatArchiveDownloadTokenReq
+	(*GetChatArchiveDownloadTokenRes)(nil), // 5: plugnmeet_server.GetChatArchiveDownloadTokenRes
+}
+var file_plugnmeet_server_chat_archive_proto_depIdxs = []int32{
+	1, // 0: plugnmeet_server.FetchChatArchivesResult.archives_list:type_name -> plugnmeet_server.ChatArchiveInfo
+	2, // 1: plugnmeet_server.FetchChatArchivesRes.result:type_name -> plugnmeet_server.FetchChatArchivesResult
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_chat_archive_proto_init() }
+func file_plugnmeet_server_chat_archive_proto_init() {
+	if File_plugnmeet_server_chat_archive_proto != nil {
+		return
+	}
+	file_plugnmeet_server_chat_archive_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_chat_archive_proto_rawDesc), len(file_plugnmeet_server_chat_archive_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_chat_archive_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_chat_archive_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_chat_archive_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_chat_archive_proto = out.File
+	file_plugnmeet_server_chat_archive_proto_goTypes = nil
+	file_plugnmeet_server_chat_archive_proto_depIdxs = nil
+}
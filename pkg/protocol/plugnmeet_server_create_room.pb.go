@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_create_room.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	plugnmeet "github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateRoomReq has the same fields as plugnmeet.CreateRoomReq
+// with the options which are only supported by this server,
+// so that the request can be parsed once
+type CreateRoomReq struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	RoomId          string                  `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	EmptyTimeout    *uint32                 `protobuf:"varint,2,opt,name=empty_timeout,json=emptyTimeout,proto3,oneof" json:"empty_timeout,omitempty"`
+	MaxParticipants *uint32                 `protobuf:"varint,3,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	Metadata        *plugnmeet.RoomMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// values of the template will be used for the fields which aren't present
+	TemplateId        string `protobuf:"bytes,101,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	EnableChatArchive bool   `protobuf:"varint,102,opt,name=enable_chat_archive,json=enableChatArchive,proto3" json:"enable_chat_archive,omitempty"`
+	// hold non-admin users in the lobby until the first admin joins
+	WaitForModerator bool `protobuf:"varint,103,opt,name=wait_for_moderator,json=waitForModerator,proto3" json:"wait_for_moderator,omitempty"`
+	// in seconds, the room will be ended if no admin joins within it
+	ModeratorWaitTimeout uint64 `protobuf:"varint,104,opt,name=moderator_wait_timeout,json=moderatorWaitTimeout,proto3" json:"moderator_wait_timeout,omitempty"`
+	// 0 means the default will be used, negative value to keep forever
+	RecordingRetentionDays int32 `protobuf:"varint,105,opt,name=recording_retention_days,json=recordingRetentionDays,proto3" json:"recording_retention_days,omitempty"`
+	// restore the whiteboard of the room from the saved snapshot
+	WhiteboardSnapshotId string `protobuf:"bytes,106,opt,name=whiteboard_snapshot_id,json=whiteboardSnapshotId,proto3" json:"whiteboard_snapshot_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateRoomReq) Reset() {
+	*x = CreateRoomReq{}
+	mi := &file_plugnmeet_server_create_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomReq) ProtoMessage() {}
+
+func (x *CreateRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_create_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomReq.ProtoReflect.Descriptor instead.
+func (*CreateRoomReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_create_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRoomReq) GetEmptyTimeout() uint32 {
+	if x != nil && x.EmptyTimeout != nil {
+		return *x.EmptyTimeout
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetMaxParticipants() uint32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetMetadata() *plugnmeet.RoomMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateRoomReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateRoomReq) GetEnableChatArchive() bool {
+	if x != nil {
+		return x.EnableChatArchive
+	}
+	return false
+}
+
+func (x *CreateRoomReq) GetWaitForModerator() bool {
+	if x != nil {
+		return x.WaitForModerator
+	}
+	return false
+}
+
+func (x *CreateRoomReq) GetModeratorWaitTimeout() uint64 {
+	if x != nil {
+		return x.ModeratorWaitTimeout
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetRecordingRetentionDays() int32 {
+	if x != nil {
+		return x.RecordingRetentionDays
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetWhiteboardSnapshotId() string {
+	if x != nil {
+		return x.WhiteboardSnapshotId
+	}
+	return ""
+}
+
+var File_plugnmeet_server_create_room_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_create_room_proto_rawDesc = "" +
+	"\n" +
+	"\"plugnmeet_server_create_room.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\x1a\x1bplugnmeet_create_room.proto\"\xa2\x05\n" +
+	"\rCreateRoomReq\x12\x9b\x01\n" +
+	"\aroom_id\x18\x01 \x01(\tB\x81\x01\xbaH~\xba\x01{\n" +
+	"\x0eroom_id_format\x12Groom_id should only contain ASCII letters (a-z A-Z), digits (0-9) or -_\x1a this.matches('^[a-zA-Z0-9-_]+$')R\x06roomId\x121\n" +
+	"\rempty_timeout\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00H\x00R\femptyTimeout\x88\x01\x01\x127\n" +
+	"\x10max_participants\x18\x03 \x01(\rB\a\xbaH\x04*\x02 \x00H\x01R\x0fmaxParticipants\x88\x01\x01\x12;\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.plugnmeet.RoomMetadataB\x06\xbaH\x03\xc8\x01\x01R\bmetadata\x12\x1f\n" +
+	"\vtemplate_id\x18e \x01(\tR\n" +
+	"templateId\x12.\n" +
+	"\x13enable_chat_archive\x18f \x01(\bR\x11enableChatArchive\x12,\n" +
+	"\x12wait_for_moderator\x18g \x01(\bR\x10waitForModerator\x124\n" +
+	"\x16moderator_wait_timeout\x18h \x01(\x04R\x14moderatorWaitTimeout\x128\n" +
+	"\x18recording_retention_days\x18i \x01(\x05R\x16recordingRetentionDays\x124\n" +
+	"\x16whiteboard_snapshot_id\x18j \x01(\tR\x14whiteboardSnapshotIdB\x10\n" +
+	"\x0e_empty_timeoutB\x13\n" +
+	"\x11_max_participantsB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_create_room_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_create_room_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_create_room_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_create_room_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_create_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_create_room_proto_rawDesc), len(file_plugnmeet_server_create_room_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_create_room_proto_rawDescData
+}
+
+var file_plugnmeet_server_create_room_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_plugnmeet_server_create_room_proto_goTypes = []any{
+	(*CreateRoomReq)(nil),          // 0: plugnmeet_server.CreateRoomReq
+	(*plugnmeet.RoomMetadata)(nil), // 1: plugnmeet.RoomMetadata
+}
+var file_plugnmeet_server_create_room_proto_depIdxs = []int32{
+	1, // 0: plugnmeet_server.CreateRoomReq.metadata:type_name -> plugnmeet.RoomMetadata
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_create_room_proto_init() }
+func file_plugnmeet_server_create_room_proto_init() {
+	if File_plugnmeet_server_create_room_proto != nil {
+		return
+	}
+	file_plugnmeet_server_create_room_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_create_room_proto_rawDesc), len(file_plugnmeet_server_create_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_create_room_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_create_room_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_create_room_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_create_room_proto = out.File
+	file_plugnmeet_server_create_room_proto_goTypes = nil
+	file_plugnmeet_server_create_room_proto_depIdxs = nil
+}
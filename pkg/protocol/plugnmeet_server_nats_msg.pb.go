@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_nats_msg.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NatsMsgServerToClientExtraEvents will be used as the event of plugnmeet.NatsMsgServerToClient
+// for the events which aren't part of the protocol yet, values start from 100 to avoid conflicts
+type NatsMsgServerToClientExtraEvents int32
+
+const (
+	NatsMsgServerToClientExtraEvents_NATS_MSG_SERVER_TO_CLIENT_EXTRA_EVENTS_UNSPECIFIED NatsMsgServerToClientExtraEvents = 0
+	NatsMsgServerToClientExtraEvents_RETURN_TO_MAIN_ROOM                                NatsMsgServerToClientExtraEvents = 100
+)
+
+// Enum value maps for NatsMsgServerToClientExtraEvents.
+var (
+	NatsMsgServerToClientExtraEvents_name = map[int32]string{
+		0:   "NATS_MSG_SERVER_TO_CLIENT_EXTRA_EVENTS_UNSPECIFIED",
+		100: "RETURN_TO_MAIN_ROOM",
+	}
+	NatsMsgServerToClientExtraEvents_value = map[string]int32{
+		"NATS_MSG_SERVER_TO_CLIENT_EXTRA_EVENTS_UNSPECIFIED": 0,
+		"RETURN_TO_MAIN_ROOM":                                100,
+	}
+)
+
+func (x NatsMsgServerToClientExtraEvents) Enum() *NatsMsgServerToClientExtraEvents {
+	p := new(NatsMsgServerToClientExtraEvents)
+	*p = x
+	return p
+}
+
+func (x NatsMsgServerToClientExtraEvents) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NatsMsgServerToClientExtraEvents) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugnmeet_server_nats_msg_proto_enumTypes[0].Descriptor()
+}
+
+func (NatsMsgServerToClientExtraEvents) Type() protoreflect.EnumType {
+	return &file_plugnmeet_server_nats_msg_proto_enumTypes[0]
+}
+
+func (x NatsMsgServerToClientExtraEvents) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NatsMsgServerToClientExtraEvents.Descriptor instead.
+func (NatsMsgServerToClientExtraEvents) EnumDescriptor() ([]byte, []int) {
+	return file_plugnmeet_server_nats_msg_proto_rawDescGZIP(), []int{0}
+}
+
+var File_plugnmeet_server_nats_msg_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_nats_msg_proto_rawDesc = "" +
+	"\n" +
+	"\x1fplugnmeet_server_nats_msg.proto\x12\x10plugnmeet_server*s\n" +
+	" NatsMsgServerToClientExtraEvents\x126\n" +
+	"2NATS_MSG_SERVER_TO_CLIENT_EXTRA_EVENTS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13RETURN_TO_MAIN_ROOM\x10dB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_nats_msg_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_nats_msg_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_nats_msg_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_nats_msg_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_nats_msg_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_nats_msg_proto_rawDesc), len(file_plugnmeet_server_nats_msg_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_nats_msg_proto_rawDescData
+}
+
+var file_plugnmeet_server_nats_msg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_plugnmeet_server_nats_msg_proto_goTypes = []any{
+	(NatsMsgServerToClientExtraEvents)(0), // 0: plugnmeet_server.NatsMsgServerToClientExtraEvents
+}
+var file_plugnmeet_server_nats_msg_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_nats_msg_proto_init() }
+func file_plugnmeet_server_nats_msg_proto_init() {
+	if File_plugnmeet_server_nats_msg_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_nats_msg_proto_rawDesc), len(file_plugnmeet_server_nats_msg_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_nats_msg_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_nats_msg_proto_depIdxs,
+		EnumInfos:         file_plugnmeet_server_nats_msg_proto_enumTypes,
+	}.Build()
+	File_plugnmeet_server_nats_msg_proto = out.File
+	file_plugnmeet_server_nats_msg_proto_goTypes = nil
+	file_plugnmeet_server_nats_msg_proto_depIdxs = nil
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_notification.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// I18nNotificationMsg will be sent as the msg of a system notification
+// so that the client can translate the key using the params
+type I18NNotificationMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *I18NNotificationMsg) Reset() {
+	*x = I18NNotificationMsg{}
+	mi := &file_plugnmeet_server_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *I18NNotificationMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I18NNotificationMsg) ProtoMessage() {}
+
+func (x *I18NNotificationMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I18NNotificationMsg.ProtoReflect.Descriptor instead.
+func (*I18NNotificationMsg) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *I18NNotificationMsg) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *I18NNotificationMsg) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+var File_plugnmeet_server_notification_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_notification_proto_rawDesc = "" +
+	"\n" +
+	"#plugnmeet_server_notification.proto\x12\x10plugnmeet_server\"\xad\x01\n" +
+	"\x13I18nNotificationMsg\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12I\n" +
+	"\x06params\x18\x02 \x03(\v21.plugnmeet_server.I18nNotificationMsg.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_notification_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_notification_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_notification_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_notification_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_notification_proto_rawDesc), len(file_plugnmeet_server_notification_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_notification_proto_rawDescData
+}
+
+var file_plugnmeet_server_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_plugnmeet_server_notification_proto_goTypes = []any{
+	(*I18NNotificationMsg)(nil), // 0: plugnmeet_server.I18nNotificationMsg
+	nil,                         // 1: plugnmeet_server.I18nNotificationMsg.ParamsEntry
+}
+var file_plugnmeet_server_notification_proto_depIdxs = []int32{
+	1, // 0: plugnmeet_server.I18nNotificationMsg.params:type_name -> plugnmeet_server.I18nNotificationMsg.ParamsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_notification_proto_init() }
+func file_plugnmeet_server_notification_proto_init() {
+	if File_plugnmeet_server_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_notification_proto_rawDesc), len(file_plugnmeet_server_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_notification_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_notification_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_notification_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_notification_proto = out.File
+	file_plugnmeet_server_notification_proto_goTypes = nil
+	file_plugnmeet_server_notification_proto_depIdxs = nil
+}
@@ -0,0 +1,1267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_recording.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FetchRecordingsReq has the same fields as plugnmeet.FetchRecordingsReq
+// with the filters which are only supported by this server
+type FetchRecordingsReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	From    uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit   uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// by default, only published recordings will be returned
+	IncludeUnpublished bool `protobuf:"varint,101,opt,name=include_unpublished,json=includeUnpublished,proto3" json:"include_unpublished,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FetchRecordingsReq) Reset() {
+	*x = FetchRecordingsReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRecordingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRecordingsReq) ProtoMessage() {}
+
+func (x *FetchRecordingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRecordingsReq.ProtoReflect.Descriptor instead.
+func (*FetchRecordingsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchRecordingsReq) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *FetchRecordingsReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchRecordingsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchRecordingsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *FetchRecordingsReq) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.IncludeUnpublished
+	}
+	return false
+}
+
+type PublishRecordingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Publish       bool                   `protobuf:"varint,2,opt,name=publish,proto3" json:"publish,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishRecordingReq) Reset() {
+	*x = PublishRecordingReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishRecordingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishRecordingReq) ProtoMessage() {}
+
+func (x *PublishRecordingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishRecordingReq.ProtoReflect.Descriptor instead.
+func (*PublishRecordingReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishRecordingReq) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *PublishRecordingReq) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type UpdateRecordingReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RecordId    string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// will be merged with the existing values, empty value will remove the key
+	Meta          map[string]string `protobuf:"bytes,4,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecordingReq) Reset() {
+	*x = UpdateRecordingReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecordingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecordingReq) ProtoMessage() {}
+
+func (x *UpdateRecordingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecordingReq.ProtoReflect.Descriptor instead.
+func (*UpdateRecordingReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateRecordingReq) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *UpdateRecordingReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRecordingReq) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRecordingReq) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// RecordingProperties holds the information which isn't part of plugnmeet.RecordingInfo
+type RecordingProperties struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Published     bool                   `protobuf:"varint,2,opt,name=published,proto3" json:"published,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Meta          map[string]string      `protobuf:"bytes,5,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordingProperties) Reset() {
+	*x = RecordingProperties{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingProperties) ProtoMessage() {}
+
+func (x *RecordingProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingProperties.ProtoReflect.Descriptor instead.
+func (*RecordingProperties) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecordingProperties) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *RecordingProperties) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+func (x *RecordingProperties) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecordingProperties) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecordingProperties) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type RecordingPropertiesRes struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Status              bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                 string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	RecordingProperties *RecordingProperties   `protobuf:"bytes,3,opt,name=recording_properties,json=recordingProperties,proto3" json:"recording_properties,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RecordingPropertiesRes) Reset() {
+	*x = RecordingPropertiesRes{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingPropertiesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingPropertiesRes) ProtoMessage() {}
+
+func (x *RecordingPropertiesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingPropertiesRes.ProtoReflect.Descriptor instead.
+func (*RecordingPropertiesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordingPropertiesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *RecordingPropertiesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RecordingPropertiesRes) GetRecordingProperties() *RecordingProperties {
+	if x != nil {
+		return x.RecordingProperties
+	}
+	return nil
+}
+
+type RecordingRetentionReportReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// will include the recordings which will be expired within these days
+	WithinDays    int32  `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+	From          uint32 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordingRetentionReportReq) Reset() {
+	*x = RecordingRetentionReportReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingRetentionReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingRetentionReportReq) ProtoMessage() {}
+
+func (x *RecordingRetentionReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingRetentionReportReq.ProtoReflect.Descriptor instead.
+func (*RecordingRetentionReportReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordingRetentionReportReq) GetWithinDays() int32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReportReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReportReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RecordingRetentionInfo struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RecordId     string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	RoomId       string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomSid      string                 `protobuf:"bytes,3,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	FilePath     string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileSize     float64                `protobuf:"fixed64,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	CreationTime int64                  `protobuf:"varint,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// 0 means the recording will be kept forever
+	ExpireAt      int64 `protobuf:"varint,7,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordingRetentionInfo) Reset() {
+	*x = RecordingRetentionInfo{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingRetentionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingRetentionInfo) ProtoMessage() {}
+
+func (x *RecordingRetentionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingRetentionInfo.ProtoReflect.Descriptor instead.
+func (*RecordingRetentionInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecordingRetentionInfo) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *RecordingRetentionInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RecordingRetentionInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *RecordingRetentionInfo) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *RecordingRetentionInfo) GetFileSize() float64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *RecordingRetentionInfo) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *RecordingRetentionInfo) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+type RecordingRetentionReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// indicates if expired recordings will be deleted by the scheduler
+	Enabled         bool                      `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DefaultDays     int32                     `protobuf:"varint,2,opt,name=default_days,json=defaultDays,proto3" json:"default_days,omitempty"`
+	TotalRecordings int64                     `protobuf:"varint,3,opt,name=total_recordings,json=totalRecordings,proto3" json:"total_recordings,omitempty"`
+	From            uint32                    `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	Limit           uint32                    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	RecordingsList  []*RecordingRetentionInfo `protobuf:"bytes,6,rep,name=recordings_list,json=recordingsList,proto3" json:"recordings_list,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordingRetentionReport) Reset() {
+	*x = RecordingRetentionReport{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingRetentionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingRetentionReport) ProtoMessage() {}
+
+func (x *RecordingRetentionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingRetentionReport.ProtoReflect.Descriptor instead.
+func (*RecordingRetentionReport) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RecordingRetentionReport) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RecordingRetentionReport) GetDefaultDays() int32 {
+	if x != nil {
+		return x.DefaultDays
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReport) GetTotalRecordings() int64 {
+	if x != nil {
+		return x.TotalRecordings
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReport) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReport) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RecordingRetentionReport) GetRecordingsList() []*RecordingRetentionInfo {
+	if x != nil {
+		return x.RecordingsList
+	}
+	return nil
+}
+
+type RecordingRetentionReportRes struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Status        bool                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *RecordingRetentionReport `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordingRetentionReportRes) Reset() {
+	*x = RecordingRetentionReportRes{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingRetentionReportRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingRetentionReportRes) ProtoMessage() {}
+
+func (x *RecordingRetentionReportRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingRetentionReportRes.ProtoReflect.Descriptor instead.
+func (*RecordingRetentionReportRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordingRetentionReportRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *RecordingRetentionReportRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RecordingRetentionReportRes) GetResult() *RecordingRetentionReport {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FetchDeletedRecordingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint32                 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchDeletedRecordingsReq) Reset() {
+	*x = FetchDeletedRecordingsReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchDeletedRecordingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchDeletedRecordingsReq) ProtoMessage() {}
+
+func (x *FetchDeletedRecordingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchDeletedRecordingsReq.ProtoReflect.Descriptor instead.
+func (*FetchDeletedRecordingsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FetchDeletedRecordingsReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchDeletedRecordingsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DeletedRecordingInfo struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RecordId     string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	RoomId       string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomSid      string                 `protobuf:"bytes,3,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	FilePath     string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileSize     float64                `protobuf:"fixed64,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	CreationTime int64                  `protobuf:"varint,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	DeletedAt    int64                  `protobuf:"varint,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// the time when the backup will be removed permanently
+	PurgeAt       int64 `protobuf:"varint,8,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedRecordingInfo) Reset() {
+	*x = DeletedRecordingInfo{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedRecordingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedRecordingInfo) ProtoMessage() {}
+
+func (x *DeletedRecordingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedRecordingInfo.ProtoReflect.Descriptor instead.
+func (*DeletedRecordingInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletedRecordingInfo) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *DeletedRecordingInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *DeletedRecordingInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *DeletedRecordingInfo) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *DeletedRecordingInfo) GetFileSize() float64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *DeletedRecordingInfo) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *DeletedRecordingInfo) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *DeletedRecordingInfo) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+type FetchDeletedRecordingsResult struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	TotalRecordings int64                   `protobuf:"varint,1,opt,name=total_recordings,json=totalRecordings,proto3" json:"total_recordings,omitempty"`
+	From            uint32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit           uint32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	RecordingsList  []*DeletedRecordingInfo `protobuf:"bytes,4,rep,name=recordings_list,json=recordingsList,proto3" json:"recordings_list,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FetchDeletedRecordingsResult) Reset() {
+	*x = FetchDeletedRecordingsResult{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchDeletedRecordingsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchDeletedRecordingsResult) ProtoMessage() {}
+
+func (x *FetchDeletedRecordingsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchDeletedRecordingsResult.ProtoReflect.Descriptor instead.
+func (*FetchDeletedRecordingsResult) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FetchDeletedRecordingsResult) GetTotalRecordings() int64 {
+	if x != nil {
+		return x.TotalRecordings
+	}
+	return 0
+}
+
+func (x *FetchDeletedRecordingsResult) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchDeletedRecordingsResult) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchDeletedRecordingsResult) GetRecordingsList() []*DeletedRecordingInfo {
+	if x != nil {
+		return x.RecordingsList
+	}
+	return nil
+}
+
+type FetchDeletedRecordingsRes struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Status        bool                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *FetchDeletedRecordingsResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchDeletedRecordingsRes) Reset() {
+	*x = FetchDeletedRecordingsRes{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchDeletedRecordingsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchDeletedRecordingsRes) ProtoMessage() {}
+
+func (x *FetchDeletedRecordingsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchDeletedRecordingsRes.ProtoReflect.Descriptor instead.
+func (*FetchDeletedRecordingsRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FetchDeletedRecordingsRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchDeletedRecordingsRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchDeletedRecordingsRes) GetResult() *FetchDeletedRecordingsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RestoreRecordingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRecordingReq) Reset() {
+	*x = RestoreRecordingReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRecordingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRecordingReq) ProtoMessage() {}
+
+func (x *RestoreRecordingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRecordingReq.ProtoReflect.Descriptor instead.
+func (*RestoreRecordingReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreRecordingReq) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+type GetPlaybackTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaybackTokenReq) Reset() {
+	*x = GetPlaybackTokenReq{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaybackTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaybackTokenReq) ProtoMessage() {}
+
+func (x *GetPlaybackTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaybackTokenReq.ProtoReflect.Descriptor instead.
+func (*GetPlaybackTokenReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPlaybackTokenReq) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+type GetPlaybackTokenRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg    string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token  *string                `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	// unix timestamp, the player will need a new token after it
+	ExpireAt      *int64 `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3,oneof" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaybackTokenRes) Reset() {
+	*x = GetPlaybackTokenRes{}
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaybackTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaybackTokenRes) ProtoMessage() {}
+
+func (x *GetPlaybackTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_recording_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaybackTokenRes.ProtoReflect.Descriptor instead.
+func (*GetPlaybackTokenRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_recording_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPlaybackTokenRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetPlaybackTokenRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetPlaybackTokenRes) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *GetPlaybackTokenRes) GetExpireAt() int64 {
+	if x != nil && x.ExpireAt != nil {
+		return *x.ExpireAt
+	}
+	return 0
+}
+
+var File_plugnmeet_server_recording_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_recording_proto_rawDesc = "" +
+	"\n" +
+	" plugnmeet_server_recording.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\"\xa5\x01\n" +
+	"\x12FetchRecordingsReq\x12\x19\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12/\n" +
+	"\x13include_unpublished\x18e \x01(\bR\x12includeUnpublished\"T\n" +
+	"\x13PublishRecordingReq\x12#\n" +
+	"\trecord_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\brecordId\x12\x18\n" +
+	"\apublish\x18\x02 \x01(\bR\apublish\"\x92\x02\n" +
+	"\x12UpdateRecordingReq\x12#\n" +
+	"\trecord_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\brecordId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\x04meta\x18\x04 \x03(\v2..plugnmeet_server.UpdateRecordingReq.MetaEntryR\x04meta\x1a7\n" +
+	"\tMetaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_description\"\x86\x02\n" +
+	"\x13RecordingProperties\x12\x1b\n" +
+	"\trecord_id\x18\x01 \x01(\tR\brecordId\x12\x1c\n" +
+	"\tpublished\x18\x02 \x01(\bR\tpublished\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12C\n" +
+	"\x04meta\x18\x05 \x03(\v2/.plugnmeet_server.RecordingProperties.MetaEntryR\x04meta\x1a7\n" +
+	"\tMetaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x01\n" +
+	"\x16RecordingPropertiesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12X\n" +
+	"\x14recording_properties\x18\x03 \x01(\v2%.plugnmeet_server.RecordingPropertiesR\x13recordingProperties\"q\n" +
+	"\x1bRecordingRetentionReportReq\x12(\n" +
+	"\vwithin_days\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\n" +
+	"withinDays\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"\xe5\x01\n" +
+	"\x16RecordingRetentionInfo\x12\x1b\n" +
+	"\trecord_id\x18\x01 \x01(\tR\brecordId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x19\n" +
+	"\broom_sid\x18\x03 \x01(\tR\aroomSid\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x01R\bfileSize\x12#\n" +
+	"\rcreation_time\x18\x06 \x01(\x03R\fcreationTime\x12\x1b\n" +
+	"\texpire_at\x18\a \x01(\x03R\bexpireAt\"\xff\x01\n" +
+	"\x18RecordingRetentionReport\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\fdefault_days\x18\x02 \x01(\x05R\vdefaultDays\x12)\n" +
+	"\x10total_recordings\x18\x03 \x01(\x03R\x0ftotalRecordings\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\x12Q\n" +
+	"\x0frecordings_list\x18\x06 \x03(\v2(.plugnmeet_server.RecordingRetentionInfoR\x0erecordingsList\"\x8b\x01\n" +
+	"\x1bRecordingRetentionReportRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12B\n" +
+	"\x06result\x18\x03 \x01(\v2*.plugnmeet_server.RecordingRetentionReportR\x06result\"E\n" +
+	"\x19FetchDeletedRecordingsReq\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\x80\x02\n" +
+	"\x14DeletedRecordingInfo\x12\x1b\n" +
+	"\trecord_id\x18\x01 \x01(\tR\brecordId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x19\n" +
+	"\broom_sid\x18\x03 \x01(\tR\aroomSid\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x01R\bfileSize\x12#\n" +
+	"\rcreation_time\x18\x06 \x01(\x03R\fcreationTime\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\x03R\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\b \x01(\x03R\apurgeAt\"\xc4\x01\n" +
+	"\x1cFetchDeletedRecordingsResult\x12)\n" +
+	"\x10total_recordings\x18\x01 \x01(\x03R\x0ftotalRecordings\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12O\n" +
+	"\x0frecordings_list\x18\x04 \x03(\v2&.plugnmeet_server.DeletedRecordingInfoR\x0erecordingsList\"\x8d\x01\n" +
+	"\x19FetchDeletedRecordingsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12F\n" +
+	"\x06result\x18\x03 \x01(\v2..plugnmeet_server.FetchDeletedRecordingsResultR\x06result\":\n" +
+	"\x13RestoreRecordingReq\x12#\n" +
+	"\trecord_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\brecordId\":\n" +
+	"\x13GetPlaybackTokenReq\x12#\n" +
+	"\trecord_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\brecordId\"\x94\x01\n" +
+	"\x13GetPlaybackTokenRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tH\x00R\x05token\x88\x01\x01\x12 \n" +
+	"\texpire_at\x18\x04 \x01(\x03H\x01R\bexpireAt\x88\x01\x01B\b\n" +
+	"\x06_tokenB\f\n" +
+	"\n" +
+	"_expire_atB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_recording_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_recording_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_recording_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_recording_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_recording_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_recording_proto_rawDesc), len(file_plugnmeet_server_recording_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_recording_proto_rawDescData
+}
+
+var file_plugnmeet_server_recording_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_plugnmeet_server_recording_proto_goTypes = []any{
+	(*FetchRecordingsReq)(nil),           // 0: plugnmeet_server.FetchRecordingsReq
+	(*PublishRecordingReq)(nil),          // 1: plugnmeet_server.PublishRecordingReq
+	(*UpdateRecordingReq)(nil),           // 2: plugnmeet_server.UpdateRecordingReq
+	(*RecordingProperties)(nil),          // 3: plugnmeet_server.RecordingProperties
+	(*RecordingPropertiesRes)(nil),       // 4: plugnmeet_server.RecordingPropertiesRes
+	(*RecordingRetentionReportReq)(nil),  // 5: plugnmeet_server.RecordingRetentionReportReq
+	(*RecordingRetentionInfo)(nil),       // 6: plugnmeet_server.RecordingRetentionInfo
+	(*RecordingRetentionReport)(nil),     // 7: plugnmeet_server.RecordingRetentionReport
+	(*RecordingRetentionReportRes)(nil),  // 8: plugnmeet_server.RecordingRetentionReportRes
+	(*FetchDeletedRecordingsReq)(nil),    // 9: plugnmeet_server.FetchDeletedRecordingsReq
+	(*DeletedRecordingInfo)(nil),         // 10: plugnmeet_server.DeletedRecordingInfo
+	(*FetchDeletedRecordingsResult)(nil), // 11: plugnmeet_server.FetchDeletedRecordingsResult
+	(*FetchDeletedRecordingsRes)(nil),    // 12: plugnmeet_server.FetchDeletedRecordingsRes
+	(*RestoreRecordingReq)(nil),          // 13: plugnmeet_server.RestoreRecordingReq
+	(*GetPlaybackTokenReq)(nil),          // 14: plugnmeet_server.GetPlaybackTokenReq
+	(*GetPlaybackTokenRes)(nil),          // 15: plugnmeet_server.GetPlaybackTokenRes
+	nil,                                  // 16: plugnmeet_server.UpdateRecordingReq.MetaEntry
+	nil,                                  // 17: plugnmeet_server.RecordingProperties.MetaEntry
+}
+var file_plugnmeet_server_recording_proto_depIdxs = []int32{
+	16, // 0: plugnmeet_server.UpdateRecordingReq.meta:type_name -> plugnmeet_server.UpdateRecordingReq.MetaEntry
+	17, // 1: plugnmeet_server.RecordingProperties.meta:type_name -> plugnmeet_server.RecordingProperties.MetaEntry
+	3,  // 2: plugnmeet_server.RecordingPropertiesRes.recording_properties:type_name -> plugnmeet_server.RecordingProperties
+	6,  // 3: plugnmeet_server.RecordingRetentionReport.recordings_list:type_name -> plugnmeet_server.RecordingRetentionInfo
+	7,  // 4: plugnmeet_server.RecordingRetentionReportRes.result:type_name -> plugnmeet_server.RecordingRetentionReport
+	10, // 5: plugnmeet_server.FetchDeletedRecordingsResult.recordings_list:type_name -> plugnmeet_server.DeletedRecordingInfo
+	11, // 6: plugnmeet_server.FetchDeletedRecordingsRes.result:type_name -> plugnmeet_server.FetchDeletedRecordingsResult
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_recording_proto_init() }
+func file_plugnmeet_server_recording_proto_init() {
+	if File_plugnmeet_server_recording_proto != nil {
+		return
+	}
+	file_plugnmeet_server_recording_proto_msgTypes[2].OneofWrappers = []any{}
+	file_plugnmeet_server_recording_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_recording_proto_rawDesc), len(file_plugnmeet_server_recording_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_recording_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_recording_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_recording_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_recording_proto = out.File
+	file_plugnmeet_server_recording_proto_goTypes = nil
+	file_plugnmeet_server_recording_proto_depIdxs = nil
+}
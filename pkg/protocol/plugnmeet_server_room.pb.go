@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_room.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FetchPastRoomsReq has the same fields as plugnmeet.FetchPastRoomsReq
+// with the filters which are only supported by this server
+type FetchPastRoomsReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	From    uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit   uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// rooms which were started by the scheduled series
+	SeriesId      string `protobuf:"bytes,101,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchPastRoomsReq) Reset() {
+	*x = FetchPastRoomsReq{}
+	mi := &file_plugnmeet_server_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchPastRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPastRoomsReq) ProtoMessage() {}
+
+func (x *FetchPastRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPastRoomsReq.ProtoReflect.Descriptor instead.
+func (*FetchPastRoomsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchPastRoomsReq) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *FetchPastRoomsReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchPastRoomsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchPastRoomsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *FetchPastRoomsReq) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+var File_plugnmeet_server_room_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_room_proto_rawDesc = "" +
+	"\n" +
+	"\x1bplugnmeet_server_room.proto\x12\x10plugnmeet_server\"\x90\x01\n" +
+	"\x11FetchPastRoomsReq\x12\x19\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12\x1b\n" +
+	"\tseries_id\x18e \x01(\tR\bseriesIdB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_room_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_room_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_room_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_room_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_proto_rawDesc), len(file_plugnmeet_server_room_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_room_proto_rawDescData
+}
+
+var file_plugnmeet_server_room_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_plugnmeet_server_room_proto_goTypes = []any{
+	(*FetchPastRoomsReq)(nil), // 0: plugnmeet_server.FetchPastRoomsReq
+}
+var file_plugnmeet_server_room_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_room_proto_init() }
+func file_plugnmeet_server_room_proto_init() {
+	if File_plugnmeet_server_room_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_proto_rawDesc), len(file_plugnmeet_server_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_room_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_room_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_room_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_room_proto = out.File
+	file_plugnmeet_server_room_proto_goTypes = nil
+	file_plugnmeet_server_room_proto_depIdxs = nil
+}
@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_room_schedule.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	plugnmeet "github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduleRoomReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// required to update
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// unix timestamp in seconds
+	StartAt int64 `protobuf:"varint,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// same format as /auth/room/create
+	CreateRoomReq *CreateRoomReq `protobuf:"bytes,3,opt,name=create_room_req,json=createRoomReq,proto3" json:"create_room_req,omitempty"`
+	// overrides, useful to change a single occurrence of a series
+	RoomTitle    *string `protobuf:"bytes,4,opt,name=room_title,json=roomTitle,proto3,oneof" json:"room_title,omitempty"`
+	RoomDuration *uint64 `protobuf:"varint,5,opt,name=room_duration,json=roomDuration,proto3,oneof" json:"room_duration,omitempty"`
+	// same format as metadata.room_features
+	RoomFeatures  *plugnmeet.RoomCreateFeatures `protobuf:"bytes,6,opt,name=room_features,json=roomFeatures,proto3" json:"room_features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRoomReq) Reset() {
+	*x = ScheduleRoomReq{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRoomReq) ProtoMessage() {}
+
+func (x *ScheduleRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRoomReq.ProtoReflect.Descriptor instead.
+func (*ScheduleRoomReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleRoomReq) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleRoomReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *ScheduleRoomReq) GetCreateRoomReq() *CreateRoomReq {
+	if x != nil {
+		return x.CreateRoomReq
+	}
+	return nil
+}
+
+func (x *ScheduleRoomReq) GetRoomTitle() string {
+	if x != nil && x.RoomTitle != nil {
+		return *x.RoomTitle
+	}
+	return ""
+}
+
+func (x *ScheduleRoomReq) GetRoomDuration() uint64 {
+	if x != nil && x.RoomDuration != nil {
+		return *x.RoomDuration
+	}
+	return 0
+}
+
+func (x *ScheduleRoomReq) GetRoomFeatures() *plugnmeet.RoomCreateFeatures {
+	if x != nil {
+		return x.RoomFeatures
+	}
+	return nil
+}
+
+type ScheduledRoomInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SeriesId   string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	RoomId     string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomTitle  string                 `protobuf:"bytes,4,opt,name=room_title,json=roomTitle,proto3" json:"room_title,omitempty"`
+	StartAt    int64                  `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// pending, started, cancelled or failed
+	Status        string                   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	RoomSid       string                   `protobuf:"bytes,7,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	ErrorMsg      string                   `protobuf:"bytes,8,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	CreateRoomReq *plugnmeet.CreateRoomReq `protobuf:"bytes,9,opt,name=create_room_req,json=createRoomReq,proto3" json:"create_room_req,omitempty"`
+	// RFC3339 format
+	Created       string `protobuf:"bytes,10,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledRoomInfo) Reset() {
+	*x = ScheduledRoomInfo{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledRoomInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledRoomInfo) ProtoMessage() {}
+
+func (x *ScheduledRoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledRoomInfo.ProtoReflect.Descriptor instead.
+func (*ScheduledRoomInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduledRoomInfo) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetRoomTitle() string {
+	if x != nil {
+		return x.RoomTitle
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *ScheduledRoomInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *ScheduledRoomInfo) GetCreateRoomReq() *plugnmeet.CreateRoomReq {
+	if x != nil {
+		return x.CreateRoomReq
+	}
+	return nil
+}
+
+func (x *ScheduledRoomInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type ScheduleRoomRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	ScheduleInfo  *ScheduledRoomInfo     `protobuf:"bytes,3,opt,name=schedule_info,json=scheduleInfo,proto3" json:"schedule_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRoomRes) Reset() {
+	*x = ScheduleRoomRes{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRoomRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRoomRes) ProtoMessage() {}
+
+func (x *ScheduleRoomRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRoomRes.ProtoReflect.Descriptor instead.
+func (*ScheduleRoomRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleRoomRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ScheduleRoomRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ScheduleRoomRes) GetScheduleInfo() *ScheduledRoomInfo {
+	if x != nil {
+		return x.ScheduleInfo
+	}
+	return nil
+}
+
+type CancelScheduledRoomReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledRoomReq) Reset() {
+	*x = CancelScheduledRoomReq{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledRoomReq) ProtoMessage() {}
+
+func (x *CancelScheduledRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledRoomReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledRoomReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelScheduledRoomReq) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type FetchScheduledRoomsReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds  []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	SeriesId string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// pending, started, cancelled or failed
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	From          uint32 `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchScheduledRoomsReq) Reset() {
+	*x = FetchScheduledRoomsReq{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchScheduledRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchScheduledRoomsReq) ProtoMessage() {}
+
+func (x *FetchScheduledRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchScheduledRoomsReq.ProtoReflect.Descriptor instead.
+func (*FetchScheduledRoomsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FetchScheduledRoomsReq) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *FetchScheduledRoomsReq) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *FetchScheduledRoomsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FetchScheduledRoomsReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchScheduledRoomsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchScheduledRoomsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type FetchScheduledRoomsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRooms    int64                  `protobuf:"varint,1,opt,name=total_rooms,json=totalRooms,proto3" json:"total_rooms,omitempty"`
+	From          uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	RoomsList     []*ScheduledRoomInfo   `protobuf:"bytes,5,rep,name=rooms_list,json=roomsList,proto3" json:"rooms_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchScheduledRoomsResult) Reset() {
+	*x = FetchScheduledRoomsResult{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchScheduledRoomsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchScheduledRoomsResult) ProtoMessage() {}
+
+func (x *FetchScheduledRoomsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchScheduledRoomsResult.ProtoReflect.Descriptor instead.
+func (*FetchScheduledRoomsResult) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchScheduledRoomsResult) GetTotalRooms() int64 {
+	if x != nil {
+		return x.TotalRooms
+	}
+	return 0
+}
+
+func (x *FetchScheduledRoomsResult) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchScheduledRoomsResult) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchScheduledRoomsResult) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *FetchScheduledRoomsResult) GetRoomsList() []*ScheduledRoomInfo {
+	if x != nil {
+		return x.RoomsList
+	}
+	return nil
+}
+
+type FetchScheduledRoomsRes struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Status        bool                       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *FetchScheduledRoomsResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchScheduledRoomsRes) Reset() {
+	*x = FetchScheduledRoomsRes{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchScheduledRoomsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchScheduledRoomsRes) ProtoMessage() {}
+
+func (x *FetchScheduledRoomsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchScheduledRoomsRes.ProtoReflect.Descriptor instead.
+func (*FetchScheduledRoomsRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FetchScheduledRoomsRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchScheduledRoomsRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchScheduledRoomsRes) GetResult() *FetchScheduledRoomsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ScheduleRoomSeriesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix timestamp in seconds of the first occurrence
+	StartAt int64 `protobuf:"varint,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// RFC 5545 RRULE, e.g. FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10
+	RecurrenceRule string `protobuf:"bytes,2,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	// IANA timezone, default UTC
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// same format as /auth/room/create
+	CreateRoomReq *CreateRoomReq `protobuf:"bytes,4,opt,name=create_room_req,json=createRoomReq,proto3" json:"create_room_req,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRoomSeriesReq) Reset() {
+	*x = ScheduleRoomSeriesReq{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRoomSeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRoomSeriesReq) ProtoMessage() {}
+
+func (x *ScheduleRoomSeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRoomSeriesReq.ProtoReflect.Descriptor instead.
+func (*ScheduleRoomSeriesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduleRoomSeriesReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *ScheduleRoomSeriesReq) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *ScheduleRoomSeriesReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ScheduleRoomSeriesReq) GetCreateRoomReq() *CreateRoomReq {
+	if x != nil {
+		return x.CreateRoomReq
+	}
+	return nil
+}
+
+type ScheduledRoomSeriesInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId       string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	RoomId         string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomTitle      string                 `protobuf:"bytes,3,opt,name=room_title,json=roomTitle,proto3" json:"room_title,omitempty"`
+	RecurrenceRule string                 `protobuf:"bytes,4,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	Timezone       string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	StartAt        int64                  `protobuf:"varint,6,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// number of occurrences created or cancelled
+	TotalOccurrences int32                `protobuf:"varint,7,opt,name=total_occurrences,json=totalOccurrences,proto3" json:"total_occurrences,omitempty"`
+	Occurrences      []*ScheduledRoomInfo `protobuf:"bytes,8,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduledRoomSeriesInfo) Reset() {
+	*x = ScheduledRoomSeriesInfo{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledRoomSeriesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledRoomSeriesInfo) ProtoMessage() {}
+
+func (x *ScheduledRoomSeriesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledRoomSeriesInfo.ProtoReflect.Descriptor instead.
+func (*ScheduledRoomSeriesInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScheduledRoomSeriesInfo) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *ScheduledRoomSeriesInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ScheduledRoomSeriesInfo) GetRoomTitle() string {
+	if x != nil {
+		return x.RoomTitle
+	}
+	return ""
+}
+
+func (x *ScheduledRoomSeriesInfo) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *ScheduledRoomSeriesInfo) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ScheduledRoomSeriesInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *ScheduledRoomSeriesInfo) GetTotalOccurrences() int32 {
+	if x != nil {
+		return x.TotalOccurrences
+	}
+	return 0
+}
+
+func (x *ScheduledRoomSeriesInfo) GetOccurrences() []*ScheduledRoomInfo {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type ScheduleRoomSeriesRes struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        bool                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SeriesInfo    *ScheduledRoomSeriesInfo `protobuf:"bytes,3,opt,name=series_info,json=seriesInfo,proto3" json:"series_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRoomSeriesRes) Reset() {
+	*x = ScheduleRoomSeriesRes{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRoomSeriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRoomSeriesRes) ProtoMessage() {}
+
+func (x *ScheduleRoomSeriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRoomSeriesRes.ProtoReflect.Descriptor instead.
+func (*ScheduleRoomSeriesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScheduleRoomSeriesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ScheduleRoomSeriesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ScheduleRoomSeriesRes) GetSeriesInfo() *ScheduledRoomSeriesInfo {
+	if x != nil {
+		return x.SeriesInfo
+	}
+	return nil
+}
+
+type CancelScheduledRoomSeriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledRoomSeriesReq) Reset() {
+	*x = CancelScheduledRoomSeriesReq{}
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledRoomSeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledRoomSeriesReq) ProtoMessage() {}
+
+func (x *CancelScheduledRoomSeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledRoomSeriesReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledRoomSeriesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelScheduledRoomSeriesReq) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+var File_plugnmeet_server_room_schedule_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_room_schedule_proto_rawDesc = "" +
+	"\n" +
+	"$plugnmeet_server_room_schedule.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\x1a\x1bplugnmeet_create_room.proto\x1a\"plugnmeet_server_create_room.proto\"\xc9\x02\n" +
+	"\x0fScheduleRoomReq\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bstart_at\x18\x02 \x01(\x03R\astartAt\x12G\n" +
+	"\x0fcreate_room_req\x18\x03 \x01(\v2\x1f.plugnmeet_server.CreateRoomReqR\rcreateRoomReq\x12\"\n" +
+	"\n" +
+	"room_title\x18\x04 \x01(\tH\x00R\troomTitle\x88\x01\x01\x12(\n" +
+	"\rroom_duration\x18\x05 \x01(\x04H\x01R\froomDuration\x88\x01\x01\x12B\n" +
+	"\rroom_features\x18\x06 \x01(\v2\x1d.plugnmeet.RoomCreateFeaturesR\froomFeaturesB\r\n" +
+	"\v_room_titleB\x10\n" +
+	"\x0e_room_duration\"\xd0\x02\n" +
+	"\x11ScheduledRoomInfo\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"room_title\x18\x04 \x01(\tR\troomTitle\x12\x19\n" +
+	"\bstart_at\x18\x05 \x01(\x03R\astartAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x19\n" +
+	"\broom_sid\x18\a \x01(\tR\aroomSid\x12\x1b\n" +
+	"\terror_msg\x18\b \x01(\tR\berrorMsg\x12@\n" +
+	"\x0fcreate_room_req\x18\t \x01(\v2\x18.plugnmeet.CreateRoomReqR\rcreateRoomReq\x12\x18\n" +
+	"\acreated\x18\n" +
+	" \x01(\tR\acreated\"\x85\x01\n" +
+	"\x0fScheduleRoomRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12H\n" +
+	"\rschedule_info\x18\x03 \x01(\v2#.plugnmeet_server.ScheduledRoomInfoR\fscheduleInfo\"A\n" +
+	"\x16CancelScheduledRoomReq\x12'\n" +
+	"\vschedule_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"scheduleId\"\xad\x01\n" +
+	"\x16FetchScheduledRoomsReq\x12\x19\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xc5\x01\n" +
+	"\x19FetchScheduledRoomsResult\x12\x1f\n" +
+	"\vtotal_rooms\x18\x01 \x01(\x03R\n" +
+	"totalRooms\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12B\n" +
+	"\n" +
+	"rooms_list\x18\x05 \x03(\v2#.plugnmeet_server.ScheduledRoomInfoR\troomsList\"\x87\x01\n" +
+	"\x16FetchScheduledRoomsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12C\n" +
+	"\x06result\x18\x03 \x01(\v2+.plugnmeet_server.FetchScheduledRoomsResultR\x06result\"\xd0\x01\n" +
+	"\x15ScheduleRoomSeriesReq\x12\x19\n" +
+	"\bstart_at\x18\x01 \x01(\x03R\astartAt\x12/\n" +
+	"\x0frecurrence_rule\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x0erecurrenceRule\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12O\n" +
+	"\x0fcreate_room_req\x18\x04 \x01(\v2\x1f.plugnmeet_server.CreateRoomReqB\x06\xbaH\x03\xc8\x01\x01R\rcreateRoomReq\"\xc2\x02\n" +
+	"\x17ScheduledRoomSeriesInfo\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x1d\n" +
+	"\n" +
+	"room_title\x18\x03 \x01(\tR\troomTitle\x12'\n" +
+	"\x0frecurrence_rule\x18\x04 \x01(\tR\x0erecurrenceRule\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x19\n" +
+	"\bstart_at\x18\x06 \x01(\x03R\astartAt\x12+\n" +
+	"\x11total_occurrences\x18\a \x01(\x05R\x10totalOccurrences\x12E\n" +
+	"\voccurrences\x18\b \x03(\v2#.plugnmeet_server.ScheduledRoomInfoR\voccurrences\"\x8d\x01\n" +
+	"\x15ScheduleRoomSeriesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12J\n" +
+	"\vseries_info\x18\x03 \x01(\v2).plugnmeet_server.ScheduledRoomSeriesInfoR\n" +
+	"seriesInfo\"C\n" +
+	"\x1cCancelScheduledRoomSeriesReq\x12#\n" +
+	"\tseries_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bseriesIdB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_room_schedule_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_room_schedule_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_room_schedule_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_room_schedule_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_room_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_schedule_proto_rawDesc), len(file_plugnmeet_server_room_schedule_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_room_schedule_proto_rawDescData
+}
+
+var file_plugnmeet_server_room_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_plugnmeet_server_room_schedule_proto_goTypes = []any{
+	(*ScheduleRoomReq)(nil),              // 0: plugnmeet_server.ScheduleRoomReq
+	(*ScheduledRoomInfo)(nil),            // 1: plugnmeet_server.ScheduledRoomInfo
+	(*ScheduleRoomRes)(nil),              // 2: plugnmeet_server.ScheduleRoomRes
+	(*CancelScheduledRoomReq)(nil),       // 3: plugnmeet_server.CancelScheduledRoomReq
+	(*FetchScheduledRoomsReq)(nil),       // 4: plugnmeet_server.FetchScheduledRoomsReq
+	(*FetchScheduledRoomsResult)(nil),    // 5: plugnmeet_server.FetchScheduledRoomsResult
+	(*FetchScheduledRoomsRes)(nil),       // 6: plugnmeet_server.FetchScheduledRoomsRes
+	(*ScheduleRoomSeriesReq)(nil),        // 7: plugnmeet_server.ScheduleRoomSeriesReq
+	(*ScheduledRoomSeriesInfo)(nil),      // 8: plugnmeet_server.ScheduledRoomSeriesInfo
+	(*ScheduleRoomSeriesRes)(nil),        // 9: plugnmeet_server.ScheduleRoomSeriesRes
+	(*CancelScheduledRoomSeriesReq)(nil), // 10: plugnmeet_server.CancelScheduledRoomSeriesReq
+	(*CreateRoomReq)(nil),                // 11: plugnmeet_server.CreateRoomReq
+	(*plugnmeet.RoomCreateFeatures)(nil), // 12: plugnmeet.RoomCreateFeatures
+	(*plugnmeet.CreateRoomReq)(nil),      // 13: plugnmeet.CreateRoomReq
+}
+var file_plugnmeet_server_room_schedule_proto_depIdxs = []int32{
+	11, // 0: plugnmeet_server.ScheduleRoomReq.create_room_req:type_name -> plugnmeet_server.CreateRoomReq
+	12, // 1: plugnmeet_server.ScheduleRoomReq.room_features:type_name -> plugnmeet.RoomCreateFeatures
+	13, // 2: plugnmeet_server.ScheduledRoomInfo.create_room_req:type_name -> plugnmeet.CreateRoomReq
+	1,  // 3: plugnmeet_server.ScheduleRoomRes.schedule_info:type_name -> plugnmeet_server.ScheduledRoomInfo
+	1,  // 4: plugnmeet_server.FetchScheduledRoomsResult.rooms_list:type_name -> plugnmeet_server.ScheduledRoomInfo
+	5,  // 5: plugnmeet_server.FetchScheduledRoomsRes.result:type_name -> plugnmeet_server.FetchScheduledRoomsResult
+	11, // 6: plugnmeet_server.ScheduleRoomSeriesReq.create_room_req:type_name -> plugnmeet_server.CreateRoomReq
+	1,  // 7: plugnmeet_server.ScheduledRoomSeriesInfo.occurrences:type_name -> plugnmeet_server.ScheduledRoomInfo
+	8,  // 8: plugnmeet_server.ScheduleRoomSeriesRes.series_info:type_name -> plugnmeet_server.ScheduledRoomSeriesInfo
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_room_schedule_proto_init() }
+func file_plugnmeet_server_room_schedule_proto_init() {
+	if File_plugnmeet_server_room_schedule_proto != nil {
+		return
+	}
+	file_plugnmeet_server_create_room_proto_init()
+	file_plugnmeet_server_room_schedule_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_schedule_proto_rawDesc), len(file_plugnmeet_server_room_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_room_schedule_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_room_schedule_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_room_schedule_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_room_schedule_proto = out.File
+	file_plugnmeet_server_room_schedule_proto_goTypes = nil
+	file_plugnmeet_server_room_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_room_template.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	plugnmeet "github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRoomTemplateReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// same format as the metadata of CreateRoomReq
+	RoomFeatures        *plugnmeet.RoomCreateFeatures `protobuf:"bytes,2,opt,name=room_features,json=roomFeatures,proto3" json:"room_features,omitempty"`
+	DefaultLockSettings *plugnmeet.LockSettings       `protobuf:"bytes,3,opt,name=default_lock_settings,json=defaultLockSettings,proto3" json:"default_lock_settings,omitempty"`
+	RoomDuration        *uint64                       `protobuf:"varint,4,opt,name=room_duration,json=roomDuration,proto3,oneof" json:"room_duration,omitempty"`
+	EmptyTimeout        *uint32                       `protobuf:"varint,5,opt,name=empty_timeout,json=emptyTimeout,proto3,oneof" json:"empty_timeout,omitempty"`
+	MaxParticipants     *uint32                       `protobuf:"varint,6,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateRoomTemplateReq) Reset() {
+	*x = CreateRoomTemplateReq{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomTemplateReq) ProtoMessage() {}
+
+func (x *CreateRoomTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomTemplateReq.ProtoReflect.Descriptor instead.
+func (*CreateRoomTemplateReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomTemplateReq) GetRoomFeatures() *plugnmeet.RoomCreateFeatures {
+	if x != nil {
+		return x.RoomFeatures
+	}
+	return nil
+}
+
+func (x *CreateRoomTemplateReq) GetDefaultLockSettings() *plugnmeet.LockSettings {
+	if x != nil {
+		return x.DefaultLockSettings
+	}
+	return nil
+}
+
+func (x *CreateRoomTemplateReq) GetRoomDuration() uint64 {
+	if x != nil && x.RoomDuration != nil {
+		return *x.RoomDuration
+	}
+	return 0
+}
+
+func (x *CreateRoomTemplateReq) GetEmptyTimeout() uint32 {
+	if x != nil && x.EmptyTimeout != nil {
+		return *x.EmptyTimeout
+	}
+	return 0
+}
+
+func (x *CreateRoomTemplateReq) GetMaxParticipants() uint32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+// UpdateRoomTemplateReq empty fields will be ignored
+type UpdateRoomTemplateReq struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	TemplateId          string                        `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name                string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RoomFeatures        *plugnmeet.RoomCreateFeatures `protobuf:"bytes,3,opt,name=room_features,json=roomFeatures,proto3" json:"room_features,omitempty"`
+	DefaultLockSettings *plugnmeet.LockSettings       `protobuf:"bytes,4,opt,name=default_lock_settings,json=defaultLockSettings,proto3" json:"default_lock_settings,omitempty"`
+	RoomDuration        *uint64                       `protobuf:"varint,5,opt,name=room_duration,json=roomDuration,proto3,oneof" json:"room_duration,omitempty"`
+	EmptyTimeout        *uint32                       `protobuf:"varint,6,opt,name=empty_timeout,json=emptyTimeout,proto3,oneof" json:"empty_timeout,omitempty"`
+	MaxParticipants     *uint32                       `protobuf:"varint,7,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateRoomTemplateReq) Reset() {
+	*x = UpdateRoomTemplateReq{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomTemplateReq) ProtoMessage() {}
+
+func (x *UpdateRoomTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomTemplateReq.ProtoReflect.Descriptor instead.
+func (*UpdateRoomTemplateReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRoomTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateRoomTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomTemplateReq) GetRoomFeatures() *plugnmeet.RoomCreateFeatures {
+	if x != nil {
+		return x.RoomFeatures
+	}
+	return nil
+}
+
+func (x *UpdateRoomTemplateReq) GetDefaultLockSettings() *plugnmeet.LockSettings {
+	if x != nil {
+		return x.DefaultLockSettings
+	}
+	return nil
+}
+
+func (x *UpdateRoomTemplateReq) GetRoomDuration() uint64 {
+	if x != nil && x.RoomDuration != nil {
+		return *x.RoomDuration
+	}
+	return 0
+}
+
+func (x *UpdateRoomTemplateReq) GetEmptyTimeout() uint32 {
+	if x != nil && x.EmptyTimeout != nil {
+		return *x.EmptyTimeout
+	}
+	return 0
+}
+
+func (x *UpdateRoomTemplateReq) GetMaxParticipants() uint32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+type DeleteRoomTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomTemplateReq) Reset() {
+	*x = DeleteRoomTemplateReq{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomTemplateReq) ProtoMessage() {}
+
+func (x *DeleteRoomTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomTemplateReq.ProtoReflect.Descriptor instead.
+func (*DeleteRoomTemplateReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteRoomTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type FetchRoomTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint32                 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchRoomTemplatesReq) Reset() {
+	*x = FetchRoomTemplatesReq{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRoomTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRoomTemplatesReq) ProtoMessage() {}
+
+func (x *FetchRoomTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRoomTemplatesReq.ProtoReflect.Descriptor instead.
+func (*FetchRoomTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FetchRoomTemplatesReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchRoomTemplatesReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RoomTemplateInfo struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	TemplateId          string                        `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name                string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RoomFeatures        *plugnmeet.RoomCreateFeatures `protobuf:"bytes,3,opt,name=room_features,json=roomFeatures,proto3" json:"room_features,omitempty"`
+	DefaultLockSettings *plugnmeet.LockSettings       `protobuf:"bytes,4,opt,name=default_lock_settings,json=defaultLockSettings,proto3" json:"default_lock_settings,omitempty"`
+	RoomDuration        uint64                        `protobuf:"varint,5,opt,name=room_duration,json=roomDuration,proto3" json:"room_duration,omitempty"`
+	EmptyTimeout        uint32                        `protobuf:"varint,6,opt,name=empty_timeout,json=emptyTimeout,proto3" json:"empty_timeout,omitempty"`
+	MaxParticipants     uint32                        `protobuf:"varint,7,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// RFC3339 format
+	Created       string `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomTemplateInfo) Reset() {
+	*x = RoomTemplateInfo{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomTemplateInfo) ProtoMessage() {}
+
+func (x *RoomTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomTemplateInfo.ProtoReflect.Descriptor instead.
+func (*RoomTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoomTemplateInfo) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RoomTemplateInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomTemplateInfo) GetRoomFeatures() *plugnmeet.RoomCreateFeatures {
+	if x != nil {
+		return x.RoomFeatures
+	}
+	return nil
+}
+
+func (x *RoomTemplateInfo) GetDefaultLockSettings() *plugnmeet.LockSettings {
+	if x != nil {
+		return x.DefaultLockSettings
+	}
+	return nil
+}
+
+func (x *RoomTemplateInfo) GetRoomDuration() uint64 {
+	if x != nil {
+		return x.RoomDuration
+	}
+	return 0
+}
+
+func (x *RoomTemplateInfo) GetEmptyTimeout() uint32 {
+	if x != nil {
+		return x.EmptyTimeout
+	}
+	return 0
+}
+
+func (x *RoomTemplateInfo) GetMaxParticipants() uint32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *RoomTemplateInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type RoomTemplateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TemplateInfo  *RoomTemplateInfo      `protobuf:"bytes,3,opt,name=template_info,json=templateInfo,proto3" json:"template_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomTemplateRes) Reset() {
+	*x = RoomTemplateRes{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomTemplateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomTemplateRes) ProtoMessage() {}
+
+func (x *RoomTemplateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomTemplateRes.ProtoReflect.Descriptor instead.
+func (*RoomTemplateRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoomTemplateRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *RoomTemplateRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RoomTemplateRes) GetTemplateInfo() *RoomTemplateInfo {
+	if x != nil {
+		return x.TemplateInfo
+	}
+	return nil
+}
+
+type FetchRoomTemplatesResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalTemplates int64                  `protobuf:"varint,1,opt,name=total_templates,json=totalTemplates,proto3" json:"total_templates,omitempty"`
+	From           uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit          uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Templates      []*RoomTemplateInfo    `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FetchRoomTemplatesResult) Reset() {
+	*x = FetchRoomTemplatesResult{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRoomTemplatesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRoomTemplatesResult) ProtoMessage() {}
+
+func (x *FetchRoomTemplatesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRoomTemplatesResult.ProtoReflect.Descriptor instead.
+func (*FetchRoomTemplatesResult) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FetchRoomTemplatesResult) GetTotalTemplates() int64 {
+	if x != nil {
+		return x.TotalTemplates
+	}
+	return 0
+}
+
+func (x *FetchRoomTemplatesResult) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchRoomTemplatesResult) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchRoomTemplatesResult) GetTemplates() []*RoomTemplateInfo {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type FetchRoomTemplatesRes struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Status        bool                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *FetchRoomTemplatesResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchRoomTemplatesRes) Reset() {
+	*x = FetchRoomTemplatesRes{}
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRoomTemplatesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRoomTemplatesRes) ProtoMessage() {}
+
+func (x *FetchRoomTemplatesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_room_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRoomTemplatesRes.ProtoReflect.Descriptor instead.
+func (*FetchRoomTemplatesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_room_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FetchRoomTemplatesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchRoomTemplatesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchRoomTemplatesRes) GetResult() *FetchRoomTemplatesResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_plugnmeet_server_room_template_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_room_template_proto_rawDesc = "" +
+	"\n" +
+	"$plugnmeet_server_room_template.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\x1a\x1bplugnmeet_create_room.proto\x1a\x19plugnmeet_gen_token.proto\"\x81\x03\n" +
+	"\x15CreateRoomTemplateReq\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12B\n" +
+	"\rroom_features\x18\x02 \x01(\v2\x1d.plugnmeet.RoomCreateFeaturesR\froomFeatures\x12K\n" +
+	"\x15default_lock_settings\x18\x03 \x01(\v2\x17.plugnmeet.LockSettingsR\x13defaultLockSettings\x12(\n" +
+	"\rroom_duration\x18\x04 \x01(\x04H\x00R\froomDuration\x88\x01\x01\x12(\n" +
+	"\rempty_timeout\x18\x05 \x01(\rH\x01R\femptyTimeout\x88\x01\x01\x12.\n" +
+	"\x10max_participants\x18\x06 \x01(\rH\x02R\x0fmaxParticipants\x88\x01\x01B\x10\n" +
+	"\x0e_room_durationB\x10\n" +
+	"\x0e_empty_timeoutB\x13\n" +
+	"\x11_max_participants\"\xa2\x03\n" +
+	"\x15UpdateRoomTemplateReq\x12'\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\rroom_features\x18\x03 \x01(\v2\x1d.plugnmeet.RoomCreateFeaturesR\froomFeatures\x12K\n" +
+	"\x15default_lock_settings\x18\x04 \x01(\v2\x17.plugnmeet.LockSettingsR\x13defaultLockSettings\x12(\n" +
+	"\rroom_duration\x18\x05 \x01(\x04H\x00R\froomDuration\x88\x01\x01\x12(\n" +
+	"\rempty_timeout\x18\x06 \x01(\rH\x01R\femptyTimeout\x88\x01\x01\x12.\n" +
+	"\x10max_participants\x18\a \x01(\rH\x02R\x0fmaxParticipants\x88\x01\x01B\x10\n" +
+	"\x0e_room_durationB\x10\n" +
+	"\x0e_empty_timeoutB\x13\n" +
+	"\x11_max_participants\"@\n" +
+	"\x15DeleteRoomTemplateReq\x12'\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"templateId\"A\n" +
+	"\x15FetchRoomTemplatesReq\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xe7\x02\n" +
+	"\x10RoomTemplateInfo\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\rroom_features\x18\x03 \x01(\v2\x1d.plugnmeet.RoomCreateFeaturesR\froomFeatures\x12K\n" +
+	"\x15default_lock_settings\x18\x04 \x01(\v2\x17.plugnmeet.LockSettingsR\x13defaultLockSettings\x12#\n" +
+	"\rroom_duration\x18\x05 \x01(\x04R\froomDuration\x12#\n" +
+	"\rempty_timeout\x18\x06 \x01(\rR\femptyTimeout\x12)\n" +
+	"\x10max_participants\x18\a \x01(\rR\x0fmaxParticipants\x12\x18\n" +
+	"\acreated\x18\b \x01(\tR\acreated\"\x84\x01\n" +
+	"\x0fRoomTemplateRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12G\n" +
+	"\rtemplate_info\x18\x03 \x01(\v2\".plugnmeet_server.RoomTemplateInfoR\ftemplateInfo\"\xaf\x01\n" +
+	"\x18FetchRoomTemplatesResult\x12'\n" +
+	"\x0ftotal_templates\x18\x01 \x01(\x03R\x0etotalTemplates\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12@\n" +
+	"\ttemplates\x18\x04 \x03(\v2\".plugnmeet_server.RoomTemplateInfoR\ttemplates\"\x85\x01\n" +
+	"\x15FetchRoomTemplatesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12B\n" +
+	"\x06result\x18\x03 \x01(\v2*.plugnmeet_server.FetchRoomTemplatesResultR\x06resultB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_room_template_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_room_template_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_room_template_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_room_template_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_room_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_template_proto_rawDesc), len(file_plugnmeet_server_room_template_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_room_template_proto_rawDescData
+}
+
+var file_plugnmeet_server_room_template_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_plugnmeet_server_room_template_proto_goTypes = []any{
+	(*CreateRoomTemplateReq)(nil),        // 0: plugnmeet_server.CreateRoomTemplateReq
+	(*UpdateRoomTemplateReq)(nil),        // 1: plugnmeet_server.UpdateRoomTemplateReq
+	(*DeleteRoomTemplateReq)(nil),        // 2: plugnmeet_server.DeleteRoomTemplateReq
+	(*FetchRoomTemplatesReq)(nil),        // 3: plugnmeet_server.FetchRoomTemplatesReq
+	(*RoomTemplateInfo)(nil),             // 4: plugnmeet_server.RoomTemplateInfo
+	(*RoomTemplateRes)(nil),              // 5: plugnmeet_server.RoomTemplateRes
+	(*FetchRoomTemplatesResult)(nil),     // 6: plugnmeet_server.FetchRoomTemplatesResult
+	(*FetchRoomTemplatesRes)(nil),        // 7: plugnmeet_server.FetchRoomTemplatesRes
+	(*plugnmeet.RoomCreateFeatures)(nil), // 8: plugnmeet.RoomCreateFeatures
+	(*plugnmeet.LockSettings)(nil),       // 9: plugnmeet.LockSettings
+}
+var file_plugnmeet_server_room_template_proto_depIdxs = []int32{
+	8, // 0: plugnmeet_server.CreateRoomTemplateReq.room_features:type_name -> plugnmeet.RoomCreateFeatures
+	9, // 1: plugnmeet_server.CreateRoomTemplateReq.default_lock_settings:type_name -> plugnmeet.LockSettings
+	8, // 2: plugnmeet_server.UpdateRoomTemplateReq.room_features:type_name -> plugnmeet.RoomCreateFeatures
+	9, // 3: plugnmeet_server.UpdateRoomTemplateReq.default_lock_settings:type_name -> plugnmeet.LockSettings
+	8, // 4: plugnmeet_server.RoomTemplateInfo.room_features:type_name -> plugnmeet.RoomCreateFeatures
+	9, // 5: plugnmeet_server.RoomTemplateInfo.default_lock_settings:type_name -> plugnmeet.LockSettings
+	4, // 6: plugnmeet_server.RoomTemplateRes.template_info:type_name -> plugnmeet_server.RoomTemplateInfo
+	4, // 7: plugnmeet_server.FetchRoomTemplatesResult.templates:type_name -> plugnmeet_server.RoomTemplateInfo
+	6, // 8: plugnmeet_server.FetchRoomTemplatesRes.result:type_name -> plugnmeet_server.FetchRoomTemplatesResult
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_room_template_proto_init() }
+func file_plugnmeet_server_room_template_proto_init() {
+	if File_plugnmeet_server_room_template_proto != nil {
+		return
+	}
+	file_plugnmeet_server_room_template_proto_msgTypes[0].OneofWrappers = []any{}
+	file_plugnmeet_server_room_template_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_room_template_proto_rawDesc), len(file_plugnmeet_server_room_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_room_template_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_room_template_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_room_template_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_room_template_proto = out.File
+	file_plugnmeet_server_room_template_proto_goTypes = nil
+	file_plugnmeet_server_room_template_proto_depIdxs = nil
+}
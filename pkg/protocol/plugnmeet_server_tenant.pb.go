@@ -0,0 +1,565 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_tenant.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateTenantReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// api_key & secret will be generated if empty
+	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// 0 means unlimited
+	MaxConcurrentRooms *int32 `protobuf:"varint,4,opt,name=max_concurrent_rooms,json=maxConcurrentRooms,proto3,oneof" json:"max_concurrent_rooms,omitempty"`
+	MaxParticipants    *int32 `protobuf:"varint,5,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	IsActive           *bool  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateTenantReq) Reset() {
+	*x = CreateTenantReq{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantReq) ProtoMessage() {}
+
+func (x *CreateTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantReq.ProtoReflect.Descriptor instead.
+func (*CreateTenantReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTenantReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateTenantReq) GetMaxConcurrentRooms() int32 {
+	if x != nil && x.MaxConcurrentRooms != nil {
+		return *x.MaxConcurrentRooms
+	}
+	return 0
+}
+
+func (x *CreateTenantReq) GetMaxParticipants() int32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateTenantReq) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+// UpdateTenantReq empty fields will be ignored, api_key can't be changed
+type UpdateTenantReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TenantId           string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Secret             string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	MaxConcurrentRooms *int32                 `protobuf:"varint,4,opt,name=max_concurrent_rooms,json=maxConcurrentRooms,proto3,oneof" json:"max_concurrent_rooms,omitempty"`
+	MaxParticipants    *int32                 `protobuf:"varint,5,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	IsActive           *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateTenantReq) Reset() {
+	*x = UpdateTenantReq{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantReq) ProtoMessage() {}
+
+func (x *UpdateTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantReq.ProtoReflect.Descriptor instead.
+func (*UpdateTenantReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateTenantReq) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTenantReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateTenantReq) GetMaxConcurrentRooms() int32 {
+	if x != nil && x.MaxConcurrentRooms != nil {
+		return *x.MaxConcurrentRooms
+	}
+	return 0
+}
+
+func (x *UpdateTenantReq) GetMaxParticipants() int32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *UpdateTenantReq) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type DeleteTenantReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantReq) Reset() {
+	*x = DeleteTenantReq{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantReq) ProtoMessage() {}
+
+func (x *DeleteTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantReq.ProtoReflect.Descriptor instead.
+func (*DeleteTenantReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteTenantReq) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type TenantInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TenantId           string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ApiKey             string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret             string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	MaxConcurrentRooms int32                  `protobuf:"varint,5,opt,name=max_concurrent_rooms,json=maxConcurrentRooms,proto3" json:"max_concurrent_rooms,omitempty"`
+	MaxParticipants    int32                  `protobuf:"varint,6,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// RFC3339 format
+	Created       string `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantInfo) Reset() {
+	*x = TenantInfo{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInfo) ProtoMessage() {}
+
+func (x *TenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
+func (*TenantInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TenantInfo) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TenantInfo) GetMaxConcurrentRooms() int32 {
+	if x != nil {
+		return x.MaxConcurrentRooms
+	}
+	return 0
+}
+
+func (x *TenantInfo) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *TenantInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TenantInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type TenantRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TenantInfo    *TenantInfo            `protobuf:"bytes,3,opt,name=tenant_info,json=tenantInfo,proto3" json:"tenant_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantRes) Reset() {
+	*x = TenantRes{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantRes) ProtoMessage() {}
+
+func (x *TenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantRes.ProtoReflect.Descriptor instead.
+func (*TenantRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *TenantRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *TenantRes) GetTenantInfo() *TenantInfo {
+	if x != nil {
+		return x.TenantInfo
+	}
+	return nil
+}
+
+type FetchTenantsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenants       []*TenantInfo          `protobuf:"bytes,3,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchTenantsRes) Reset() {
+	*x = FetchTenantsRes{}
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchTenantsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchTenantsRes) ProtoMessage() {}
+
+func (x *FetchTenantsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchTenantsRes.ProtoReflect.Descriptor instead.
+func (*FetchTenantsRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchTenantsRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchTenantsRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchTenantsRes) GetTenants() []*TenantInfo {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+var File_plugnmeet_server_tenant_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_tenant_proto_rawDesc = "" +
+	"\n" +
+	"\x1dplugnmeet_server_tenant.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\"\xb5\x02\n" +
+	"\x0fCreateTenantReq\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12>\n" +
+	"\x14max_concurrent_rooms\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x00R\x12maxConcurrentRooms\x88\x01\x01\x127\n" +
+	"\x10max_participants\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x0fmaxParticipants\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x02R\bisActive\x88\x01\x01B\x17\n" +
+	"\x15_max_concurrent_roomsB\x13\n" +
+	"\x11_max_participantsB\f\n" +
+	"\n" +
+	"_is_active\"\xb9\x02\n" +
+	"\x0fUpdateTenantReq\x12#\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12>\n" +
+	"\x14max_concurrent_rooms\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x00R\x12maxConcurrentRooms\x88\x01\x01\x127\n" +
+	"\x10max_participants\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x0fmaxParticipants\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x02R\bisActive\x88\x01\x01B\x17\n" +
+	"\x15_max_concurrent_roomsB\x13\n" +
+	"\x11_max_participantsB\f\n" +
+	"\n" +
+	"_is_active\"6\n" +
+	"\x0fDeleteTenantReq\x12#\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\btenantId\"\x82\x02\n" +
+	"\n" +
+	"TenantInfo\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x120\n" +
+	"\x14max_concurrent_rooms\x18\x05 \x01(\x05R\x12maxConcurrentRooms\x12)\n" +
+	"\x10max_participants\x18\x06 \x01(\x05R\x0fmaxParticipants\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x18\n" +
+	"\acreated\x18\b \x01(\tR\acreated\"t\n" +
+	"\tTenantRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12=\n" +
+	"\vtenant_info\x18\x03 \x01(\v2\x1c.plugnmeet_server.TenantInfoR\n" +
+	"tenantInfo\"s\n" +
+	"\x0fFetchTenantsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x126\n" +
+	"\atenants\x18\x03 \x03(\v2\x1c.plugnmeet_server.TenantInfoR\atenantsB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_tenant_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_tenant_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_tenant_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_tenant_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_tenant_proto_rawDesc), len(file_plugnmeet_server_tenant_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_tenant_proto_rawDescData
+}
+
+var file_plugnmeet_server_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_plugnmeet_server_tenant_proto_goTypes = []any{
+	(*CreateTenantReq)(nil), // 0: plugnmeet_server.CreateTenantReq
+	(*UpdateTenantReq)(nil), // 1: plugnmeet_server.UpdateTenantReq
+	(*DeleteTenantReq)(nil), // 2: plugnmeet_server.DeleteTenantReq
+	(*TenantInfo)(nil),      // 3: plugnmeet_server.TenantInfo
+	(*TenantRes)(nil),       // 4: plugnmeet_server.TenantRes
+	(*FetchTenantsRes)(nil), // 5: plugnmeet_server.FetchTenantsRes
+}
+var file_plugnmeet_server_tenant_proto_depIdxs = []int32{
+	3, // 0: plugnmeet_server.TenantRes.tenant_info:type_name -> plugnmeet_server.TenantInfo
+	3, // 1: plugnmeet_server.FetchTenantsRes.tenants:type_name -> plugnmeet_server.TenantInfo
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_tenant_proto_init() }
+func file_plugnmeet_server_tenant_proto_init() {
+	if File_plugnmeet_server_tenant_proto != nil {
+		return
+	}
+	file_plugnmeet_server_tenant_proto_msgTypes[0].OneofWrappers = []any{}
+	file_plugnmeet_server_tenant_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_tenant_proto_rawDesc), len(file_plugnmeet_server_tenant_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_tenant_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_tenant_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_tenant_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_tenant_proto = out.File
+	file_plugnmeet_server_tenant_proto_goTypes = nil
+	file_plugnmeet_server_tenant_proto_depIdxs = nil
+}
@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_waiting_room.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WaitingQueueUser struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position uint32                 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// unix timestamp in milliseconds
+	JoinedAt int64 `protobuf:"varint,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// in seconds
+	WaitingTime   int64 `protobuf:"varint,5,opt,name=waiting_time,json=waitingTime,proto3" json:"waiting_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitingQueueUser) Reset() {
+	*x = WaitingQueueUser{}
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingQueueUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingQueueUser) ProtoMessage() {}
+
+func (x *WaitingQueueUser) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingQueueUser.ProtoReflect.Descriptor instead.
+func (*WaitingQueueUser) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_waiting_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WaitingQueueUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitingQueueUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WaitingQueueUser) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitingQueueUser) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *WaitingQueueUser) GetWaitingTime() int64 {
+	if x != nil {
+		return x.WaitingTime
+	}
+	return 0
+}
+
+type GetWaitingQueueRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Total         uint32                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Queue         []*WaitingQueueUser    `protobuf:"bytes,4,rep,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitingQueueRes) Reset() {
+	*x = GetWaitingQueueRes{}
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitingQueueRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingQueueRes) ProtoMessage() {}
+
+func (x *GetWaitingQueueRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingQueueRes.ProtoReflect.Descriptor instead.
+func (*GetWaitingQueueRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_waiting_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWaitingQueueRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetWaitingQueueRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetWaitingQueueRes) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetWaitingQueueRes) GetQueue() []*WaitingQueueUser {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type ApproveNextWaitingUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveNextWaitingUsersReq) Reset() {
+	*x = ApproveNextWaitingUsersReq{}
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveNextWaitingUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveNextWaitingUsersReq) ProtoMessage() {}
+
+func (x *ApproveNextWaitingUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveNextWaitingUsersReq.ProtoReflect.Descriptor instead.
+func (*ApproveNextWaitingUsersReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_waiting_room_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApproveNextWaitingUsersReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ApproveNextWaitingUsersReq) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ApproveNextWaitingUsersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Approved      uint32                 `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveNextWaitingUsersRes) Reset() {
+	*x = ApproveNextWaitingUsersRes{}
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveNextWaitingUsersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveNextWaitingUsersRes) ProtoMessage() {}
+
+func (x *ApproveNextWaitingUsersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveNextWaitingUsersRes.ProtoReflect.Descriptor instead.
+func (*ApproveNextWaitingUsersRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_waiting_room_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApproveNextWaitingUsersRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ApproveNextWaitingUsersRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ApproveNextWaitingUsersRes) GetApproved() uint32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+type RejectWaitingUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockUser     bool                   `protobuf:"varint,4,opt,name=block_user,json=blockUser,proto3" json:"block_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectWaitingUsersReq) Reset() {
+	*x = RejectWaitingUsersReq{}
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectWaitingUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWaitingUsersReq) ProtoMessage() {}
+
+func (x *RejectWaitingUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_waiting_room_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWaitingUsersReq.ProtoReflect.Descriptor instead.
+func (*RejectWaitingUsersReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_waiting_room_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RejectWaitingUsersReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RejectWaitingUsersReq) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *RejectWaitingUsersReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectWaitingUsersReq) GetBlockUser() bool {
+	if x != nil {
+		return x.BlockUser
+	}
+	return false
+}
+
+var File_plugnmeet_server_waiting_room_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_waiting_room_proto_rawDesc = "" +
+	"\n" +
+	"#plugnmeet_server_waiting_room.proto\x12\x10plugnmeet_server\"\x9b\x01\n" +
+	"\x10WaitingQueueUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\x03R\bjoinedAt\x12!\n" +
+	"\fwaiting_time\x18\x05 \x01(\x03R\vwaitingTime\"\x8e\x01\n" +
+	"\x12GetWaitingQueueRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\x128\n" +
+	"\x05queue\x18\x04 \x03(\v2\".plugnmeet_server.WaitingQueueUserR\x05queue\"K\n" +
+	"\x1aApproveNextWaitingUsersReq\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"b\n" +
+	"\x1aApproveNextWaitingUsersRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\rR\bapproved\"\x82\x01\n" +
+	"\x15RejectWaitingUsersReq\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"block_user\x18\x04 \x01(\bR\tblockUserB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_waiting_room_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_waiting_room_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_waiting_room_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_waiting_room_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_waiting_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_waiting_room_proto_rawDesc), len(file_plugnmeet_server_waiting_room_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_waiting_room_proto_rawDescData
+}
+
+var file_plugnmeet_server_waiting_room_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_plugnmeet_server_waiting_room_proto_goTypes = []any{
+	(*WaitingQueueUser)(nil),           // 0: plugnmeet_server.WaitingQueueUser
+	(*GetWaitingQueueRes)(nil),         // 1: plugnmeet_server.GetWaitingQueueRes
+	(*ApproveNextWaitingUsersReq)(nil), // 2: plugnmeet_server.ApproveNextWaitingUsersReq
+	(*ApproveNextWaitingUsersRes)(nil), // 3: plugnmeet_server.ApproveNextWaitingUsersRes
+	(*RejectWaitingUsersReq)(nil),      // 4: plugnmeet_server.RejectWaitingUsersReq
+}
+var file_plugnmeet_server_waiting_room_proto_depIdxs = []int32{
+	0, // 0: plugnmeet_server.GetWaitingQueueRes.queue:type_name -> plugnmeet_server.WaitingQueueUser
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_waiting_room_proto_init() }
+func file_plugnmeet_server_waiting_room_proto_init() {
+	if File_plugnmeet_server_waiting_room_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_waiting_room_proto_rawDesc), len(file_plugnmeet_server_waiting_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_waiting_room_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_waiting_room_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_waiting_room_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_waiting_room_proto = out.File
+	file_plugnmeet_server_waiting_room_proto_goTypes = nil
+	file_plugnmeet_server_waiting_room_proto_depIdxs = nil
+}
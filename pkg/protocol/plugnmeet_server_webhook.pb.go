@@ -0,0 +1,1036 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_webhook.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WebhookDeliveryInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId    string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event      string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	RoomId     string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomSid    string                 `protobuf:"bytes,5,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	Url        string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	EndpointId string                 `protobuf:"bytes,7,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// the event in the same format as it was sent
+	Payload string `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	// pending, delivered, failed or dead
+	Status     string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts   int32  `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	HttpStatus int32  `protobuf:"varint,11,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	LastError  string `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// id of the delivery which was replayed
+	ReplayOf      string `protobuf:"bytes,13,opt,name=replay_of,json=replayOf,proto3" json:"replay_of,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64  `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryInfo) Reset() {
+	*x = WebhookDeliveryInfo{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryInfo) ProtoMessage() {}
+
+func (x *WebhookDeliveryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryInfo.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookDeliveryInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetReplayOf() string {
+	if x != nil {
+		return x.ReplayOf
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type FetchWebhookDeliveriesReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomSid string                 `protobuf:"bytes,1,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	// optional, for dead the entries will be read from the dead-letter store
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// unix timestamp in seconds
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// default 100, max 1000
+	Limit         uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWebhookDeliveriesReq) Reset() {
+	*x = FetchWebhookDeliveriesReq{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWebhookDeliveriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWebhookDeliveriesReq) ProtoMessage() {}
+
+func (x *FetchWebhookDeliveriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWebhookDeliveriesReq.ProtoReflect.Descriptor instead.
+func (*FetchWebhookDeliveriesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FetchWebhookDeliveriesReq) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *FetchWebhookDeliveriesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FetchWebhookDeliveriesReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchWebhookDeliveriesReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *FetchWebhookDeliveriesReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FetchWebhookDeliveriesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Deliveries    []*WebhookDeliveryInfo `protobuf:"bytes,3,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWebhookDeliveriesRes) Reset() {
+	*x = FetchWebhookDeliveriesRes{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWebhookDeliveriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWebhookDeliveriesRes) ProtoMessage() {}
+
+func (x *FetchWebhookDeliveriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWebhookDeliveriesRes.ProtoReflect.Descriptor instead.
+func (*FetchWebhookDeliveriesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FetchWebhookDeliveriesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchWebhookDeliveriesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchWebhookDeliveriesRes) GetDeliveries() []*WebhookDeliveryInfo {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type ReplayWebhookDeliveriesReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomSid string                 `protobuf:"bytes,1,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	// status of the deliveries to replay, default: dead
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	From   int64  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To     int64  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// optional, to replay only these deliveries
+	DeliveryIds   []string `protobuf:"bytes,5,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveriesReq) Reset() {
+	*x = ReplayWebhookDeliveriesReq{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveriesReq) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveriesReq.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveriesReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplayWebhookDeliveriesReq) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveriesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveriesReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ReplayWebhookDeliveriesReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ReplayWebhookDeliveriesReq) GetDeliveryIds() []string {
+	if x != nil {
+		return x.DeliveryIds
+	}
+	return nil
+}
+
+type ReplayWebhookDeliveriesRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg    string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// the new deliveries
+	Deliveries    []*WebhookDeliveryInfo `protobuf:"bytes,3,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveriesRes) Reset() {
+	*x = ReplayWebhookDeliveriesRes{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveriesRes) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveriesRes.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveriesRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayWebhookDeliveriesRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ReplayWebhookDeliveriesRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveriesRes) GetDeliveries() []*WebhookDeliveryInfo {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type CreateWebhookEndpointReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// must use http or https
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// events to subscribe, empty list or * means all events
+	Events []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// will be used to sign the requests, if empty then random secret will be generated
+	Secret        string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	IsActive      *bool  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookEndpointReq) Reset() {
+	*x = CreateWebhookEndpointReq{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookEndpointReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointReq) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointReq.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateWebhookEndpointReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointReq) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookEndpointReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointReq) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+// UpdateWebhookEndpointReq empty fields will be ignored
+type UpdateWebhookEndpointReq struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url        string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// to subscribe to all events again send ["*"]
+	Events        []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Secret        string   `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	IsActive      *bool    `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookEndpointReq) Reset() {
+	*x = UpdateWebhookEndpointReq{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookEndpointReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookEndpointReq) ProtoMessage() {}
+
+func (x *UpdateWebhookEndpointReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookEndpointReq.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookEndpointReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateWebhookEndpointReq) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointReq) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateWebhookEndpointReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointReq) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type DeleteWebhookEndpointReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId    string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookEndpointReq) Reset() {
+	*x = DeleteWebhookEndpointReq{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookEndpointReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookEndpointReq) ProtoMessage() {}
+
+func (x *DeleteWebhookEndpointReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookEndpointReq.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteWebhookEndpointReq) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+type WebhookEndpointInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url        string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Events     []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Secret     string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	IsActive   bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// RFC3339 format
+	Created       string `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpointInfo) Reset() {
+	*x = WebhookEndpointInfo{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpointInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointInfo) ProtoMessage() {}
+
+func (x *WebhookEndpointInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointInfo.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WebhookEndpointInfo) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookEndpointInfo) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *WebhookEndpointInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type WebhookEndpointRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	EndpointInfo  *WebhookEndpointInfo   `protobuf:"bytes,3,opt,name=endpoint_info,json=endpointInfo,proto3" json:"endpoint_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpointRes) Reset() {
+	*x = WebhookEndpointRes{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpointRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointRes) ProtoMessage() {}
+
+func (x *WebhookEndpointRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointRes.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WebhookEndpointRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *WebhookEndpointRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *WebhookEndpointRes) GetEndpointInfo() *WebhookEndpointInfo {
+	if x != nil {
+		return x.EndpointInfo
+	}
+	return nil
+}
+
+type FetchWebhookEndpointsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Endpoints     []*WebhookEndpointInfo `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWebhookEndpointsRes) Reset() {
+	*x = FetchWebhookEndpointsRes{}
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWebhookEndpointsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWebhookEndpointsRes) ProtoMessage() {}
+
+func (x *FetchWebhookEndpointsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWebhookEndpointsRes.ProtoReflect.Descriptor instead.
+func (*FetchWebhookEndpointsRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FetchWebhookEndpointsRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchWebhookEndpointsRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchWebhookEndpointsRes) GetEndpoints() []*WebhookEndpointInfo {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+var File_plugnmeet_server_webhook_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x1eplugnmeet_server_webhook.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\"\xa6\x03\n" +
+	"\x13WebhookDeliveryInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12\x19\n" +
+	"\broom_sid\x18\x05 \x01(\tR\aroomSid\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1f\n" +
+	"\vendpoint_id\x18\a \x01(\tR\n" +
+	"endpointId\x12\x18\n" +
+	"\apayload\x18\b \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\n" +
+	" \x01(\x05R\battempts\x12\x1f\n" +
+	"\vhttp_status\x18\v \x01(\x05R\n" +
+	"httpStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tR\tlastError\x12\x1b\n" +
+	"\treplay_of\x18\r \x01(\tR\breplayOf\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\x03R\tupdatedAt\"\xbb\x01\n" +
+	"\x19FetchWebhookDeliveriesReq\x12!\n" +
+	"\broom_sid\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aroomSid\x12A\n" +
+	"\x06status\x18\x02 \x01(\tB)\xbaH&r$R\x00R\apendingR\tdeliveredR\x06failedR\x04deadR\x06status\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\"\x8c\x01\n" +
+	"\x19FetchWebhookDeliveriesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12E\n" +
+	"\n" +
+	"deliveries\x18\x03 \x03(\v2%.plugnmeet_server.WebhookDeliveryInfoR\n" +
+	"deliveries\"\xc9\x01\n" +
+	"\x1aReplayWebhookDeliveriesReq\x12!\n" +
+	"\broom_sid\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aroomSid\x12A\n" +
+	"\x06status\x18\x02 \x01(\tB)\xbaH&r$R\x00R\apendingR\tdeliveredR\x06failedR\x04deadR\x06status\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\x12!\n" +
+	"\fdelivery_ids\x18\x05 \x03(\tR\vdeliveryIds\"\x8d\x01\n" +
+	"\x1aReplayWebhookDeliveriesRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12E\n" +
+	"\n" +
+	"deliveries\x18\x03 \x03(\v2%.plugnmeet_server.WebhookDeliveryInfoR\n" +
+	"deliveries\"\xa8\x01\n" +
+	"\x18CreateWebhookEndpointReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\x03url\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"\xc9\x01\n" +
+	"\x18UpdateWebhookEndpointReq\x12'\n" +
+	"\vendpoint_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"endpointId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"C\n" +
+	"\x18DeleteWebhookEndpointReq\x12'\n" +
+	"\vendpoint_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"endpointId\"\xc3\x01\n" +
+	"\x13WebhookEndpointInfo\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x18\n" +
+	"\acreated\x18\a \x01(\tR\acreated\"\x8a\x01\n" +
+	"\x12WebhookEndpointRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12J\n" +
+	"\rendpoint_info\x18\x03 \x01(\v2%.plugnmeet_server.WebhookEndpointInfoR\fendpointInfo\"\x89\x01\n" +
+	"\x18FetchWebhookEndpointsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12C\n" +
+	"\tendpoints\x18\x03 \x03(\v2%.plugnmeet_server.WebhookEndpointInfoR\tendpointsB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_webhook_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_webhook_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_webhook_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_webhook_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_webhook_proto_rawDesc), len(file_plugnmeet_server_webhook_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_webhook_proto_rawDescData
+}
+
+var file_plugnmeet_server_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_plugnmeet_server_webhook_proto_goTypes = []any{
+	(*WebhookDeliveryInfo)(nil),        // 0: plugnmeet_server.WebhookDeliveryInfo
+	(*FetchWebhookDeliveriesReq)(nil),  // 1: plugnmeet_server.FetchWebhookDeliveriesReq
+	(*FetchWebhookDeliveriesRes)(nil),  // 2: plugnmeet_server.FetchWebhookDeliveriesRes
+	(*ReplayWebhookDeliveriesReq)(nil), // 3: plugnmeet_server.ReplayWebhookDeliveriesReq
+	(*ReplayWebhookDeliveriesRes)(nil), // 4: plugnmeet_server.ReplayWebhookDeliveriesRes
+	(*CreateWebhookEndpointReq)(nil),   // 5: plugnmeet_server.CreateWebhookEndpointReq
+	(*UpdateWebhookEndpointReq)(nil),   // 6: plugnmeet_server.UpdateWebhookEndpointReq
+	(*DeleteWebhookEndpointReq)(nil),   // 7: plugnmeet_server.DeleteWebhookEndpointReq
+	(*WebhookEndpointInfo)(nil),        // 8: plugnmeet_server.WebhookEndpointInfo
+	(*WebhookEndpointRes)(nil),         // 9: plugnmeet_server.WebhookEndpointRes
+	(*FetchWebhookEndpointsRes)(nil),   // 10: plugnmeet_server.FetchWebhookEndpointsRes
+}
+var file_plugnmeet_server_webhook_proto_depIdxs = []int32{
+	0, // 0: plugnmeet_server.FetchWebhookDeliveriesRes.deliveries:type_name -> plugnmeet_server.WebhookDeliveryInfo
+	0, // 1: plugnmeet_server.ReplayWebhookDeliveriesRes.deliveries:type_name -> plugnmeet_server.WebhookDeliveryInfo
+	8, // 2: plugnmeet_server.WebhookEndpointRes.endpoint_info:type_name -> plugnmeet_server.WebhookEndpointInfo
+	8, // 3: plugnmeet_server.FetchWebhookEndpointsRes.endpoints:type_name -> plugnmeet_server.WebhookEndpointInfo
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_webhook_proto_init() }
+func file_plugnmeet_server_webhook_proto_init() {
+	if File_plugnmeet_server_webhook_proto != nil {
+		return
+	}
+	file_plugnmeet_server_webhook_proto_msgTypes[5].OneofWrappers = []any{}
+	file_plugnmeet_server_webhook_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_webhook_proto_rawDesc), len(file_plugnmeet_server_webhook_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_webhook_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_webhook_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_webhook_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_webhook_proto = out.File
+	file_plugnmeet_server_webhook_proto_goTypes = nil
+	file_plugnmeet_server_webhook_proto_depIdxs = nil
+}
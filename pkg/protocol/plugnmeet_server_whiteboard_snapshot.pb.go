@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: plugnmeet_server_whiteboard_snapshot.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FetchWhiteboardSnapshotsReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	From    uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit   uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// default DESC
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWhiteboardSnapshotsReq) Reset() {
+	*x = FetchWhiteboardSnapshotsReq{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWhiteboardSnapshotsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWhiteboardSnapshotsReq) ProtoMessage() {}
+
+func (x *FetchWhiteboardSnapshotsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWhiteboardSnapshotsReq.ProtoReflect.Descriptor instead.
+func (*FetchWhiteboardSnapshotsReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FetchWhiteboardSnapshotsReq) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *FetchWhiteboardSnapshotsReq) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchWhiteboardSnapshotsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchWhiteboardSnapshotsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type WhiteboardSnapshotInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId       string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	RoomId           string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomSid          string                 `protobuf:"bytes,3,opt,name=room_sid,json=roomSid,proto3" json:"room_sid,omitempty"`
+	FileName         string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	TotalPages       int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalFiles       int32                  `protobuf:"varint,6,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	TotalEvents      int32                  `protobuf:"varint,7,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	RoomCreationTime int64                  `protobuf:"varint,8,opt,name=room_creation_time,json=roomCreationTime,proto3" json:"room_creation_time,omitempty"`
+	CreationTime     int64                  `protobuf:"varint,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WhiteboardSnapshotInfo) Reset() {
+	*x = WhiteboardSnapshotInfo{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhiteboardSnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhiteboardSnapshotInfo) ProtoMessage() {}
+
+func (x *WhiteboardSnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhiteboardSnapshotInfo.ProtoReflect.Descriptor instead.
+func (*WhiteboardSnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WhiteboardSnapshotInfo) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *WhiteboardSnapshotInfo) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *WhiteboardSnapshotInfo) GetRoomSid() string {
+	if x != nil {
+		return x.RoomSid
+	}
+	return ""
+}
+
+func (x *WhiteboardSnapshotInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *WhiteboardSnapshotInfo) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *WhiteboardSnapshotInfo) GetTotalFiles() int32 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *WhiteboardSnapshotInfo) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *WhiteboardSnapshotInfo) GetRoomCreationTime() int64 {
+	if x != nil {
+		return x.RoomCreationTime
+	}
+	return 0
+}
+
+func (x *WhiteboardSnapshotInfo) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+type FetchWhiteboardSnapshotsResult struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	TotalSnapshots int64                     `protobuf:"varint,1,opt,name=total_snapshots,json=totalSnapshots,proto3" json:"total_snapshots,omitempty"`
+	From           uint32                    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Limit          uint32                    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy        string                    `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	SnapshotsList  []*WhiteboardSnapshotInfo `protobuf:"bytes,5,rep,name=snapshots_list,json=snapshotsList,proto3" json:"snapshots_list,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FetchWhiteboardSnapshotsResult) Reset() {
+	*x = FetchWhiteboardSnapshotsResult{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWhiteboardSnapshotsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWhiteboardSnapshotsResult) ProtoMessage() {}
+
+func (x *FetchWhiteboardSnapshotsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWhiteboardSnapshotsResult.ProtoReflect.Descriptor instead.
+func (*FetchWhiteboardSnapshotsResult) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FetchWhiteboardSnapshotsResult) GetTotalSnapshots() int64 {
+	if x != nil {
+		return x.TotalSnapshots
+	}
+	return 0
+}
+
+func (x *FetchWhiteboardSnapshotsResult) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FetchWhiteboardSnapshotsResult) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FetchWhiteboardSnapshotsResult) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *FetchWhiteboardSnapshotsResult) GetSnapshotsList() []*WhiteboardSnapshotInfo {
+	if x != nil {
+		return x.SnapshotsList
+	}
+	return nil
+}
+
+type FetchWhiteboardSnapshotsRes struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Status        bool                            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        *FetchWhiteboardSnapshotsResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWhiteboardSnapshotsRes) Reset() {
+	*x = FetchWhiteboardSnapshotsRes{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWhiteboardSnapshotsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWhiteboardSnapshotsRes) ProtoMessage() {}
+
+func (x *FetchWhiteboardSnapshotsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWhiteboardSnapshotsRes.ProtoReflect.Descriptor instead.
+func (*FetchWhiteboardSnapshotsRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FetchWhiteboardSnapshotsRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *FetchWhiteboardSnapshotsRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *FetchWhiteboardSnapshotsRes) GetResult() *FetchWhiteboardSnapshotsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteWhiteboardSnapshotReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWhiteboardSnapshotReq) Reset() {
+	*x = DeleteWhiteboardSnapshotReq{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWhiteboardSnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWhiteboardSnapshotReq) ProtoMessage() {}
+
+func (x *DeleteWhiteboardSnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWhiteboardSnapshotReq.ProtoReflect.Descriptor instead.
+func (*DeleteWhiteboardSnapshotReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteWhiteboardSnapshotReq) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type GetWhiteboardSnapshotDownloadTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenReq) Reset() {
+	*x = GetWhiteboardSnapshotDownloadTokenReq{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWhiteboardSnapshotDownloadTokenReq) ProtoMessage() {}
+
+func (x *GetWhiteboardSnapshotDownloadTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWhiteboardSnapshotDownloadTokenReq.ProtoReflect.Descriptor instead.
+func (*GetWhiteboardSnapshotDownloadTokenReq) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenReq) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type GetWhiteboardSnapshotDownloadTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token         *string                `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) Reset() {
+	*x = GetWhiteboardSnapshotDownloadTokenRes{}
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWhiteboardSnapshotDownloadTokenRes) ProtoMessage() {}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWhiteboardSnapshotDownloadTokenRes.ProtoReflect.Descriptor instead.
+func (*GetWhiteboardSnapshotDownloadTokenRes) Descriptor() ([]byte, []int) {
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetWhiteboardSnapshotDownloadTokenRes) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+var File_plugnmeet_server_whiteboard_snapshot_proto protoreflect.FileDescriptor
+
+const file_plugnmeet_server_whiteboard_snapshot_proto_rawDesc = "" +
+	"\n" +
+	"*plugnmeet_server_whiteboard_snapshot.proto\x12\x10plugnmeet_server\x1a\x1bbuf/validate/validate.proto\"\x91\x01\n" +
+	"\x1bFetchWhiteboardSnapshotsReq\x12\x19\n" +
+	"\broom_ids\x18\x01 \x03(\tR\aroomIds\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12-\n" +
+	"\border_by\x18\x04 \x01(\tB\x12\xbaH\x0fr\rR\x00R\x03ASCR\x04DESCR\aorderBy\"\xc2\x02\n" +
+	"\x16WhiteboardSnapshotInfo\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
+	"snapshotId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x19\n" +
+	"\broom_sid\x18\x03 \x01(\tR\aroomSid\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12\x1f\n" +
+	"\vtotal_files\x18\x06 \x01(\x05R\n" +
+	"totalFiles\x12!\n" +
+	"\ftotal_events\x18\a \x01(\x05R\vtotalEvents\x12,\n" +
+	"\x12room_creation_time\x18\b \x01(\x03R\x10roomCreationTime\x12#\n" +
+	"\rcreation_time\x18\t \x01(\x03R\fcreationTime\"\xdf\x01\n" +
+	"\x1eFetchWhiteboardSnapshotsResult\x12'\n" +
+	"\x0ftotal_snapshots\x18\x01 \x01(\x03R\x0etotalSnapshots\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12O\n" +
+	"\x0esnapshots_list\x18\x05 \x03(\v2(.plugnmeet_server.WhiteboardSnapshotInfoR\rsnapshotsList\"\x91\x01\n" +
+	"\x1bFetchWhiteboardSnapshotsRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12H\n" +
+	"\x06result\x18\x03 \x01(\v20.plugnmeet_server.FetchWhiteboardSnapshotsResultR\x06result\"F\n" +
+	"\x1bDeleteWhiteboardSnapshotReq\x12'\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"snapshotId\"P\n" +
+	"%GetWhiteboardSnapshotDownloadTokenReq\x12'\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"snapshotId\"v\n" +
+	"%GetWhiteboardSnapshotDownloadTokenRes\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tH\x00R\x05token\x88\x01\x01B\b\n" +
+	"\x06_tokenB5Z3github.com/mynaparrot/plugnmeet-server/pkg/protocolb\x06proto3"
+
+var (
+	file_plugnmeet_server_whiteboard_snapshot_proto_rawDescOnce sync.Once
+	file_plugnmeet_server_whiteboard_snapshot_proto_rawDescData []byte
+)
+
+func file_plugnmeet_server_whiteboard_snapshot_proto_rawDescGZIP() []byte {
+	file_plugnmeet_server_whiteboard_snapshot_proto_rawDescOnce.Do(func() {
+		file_plugnmeet_server_whiteboard_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugnmeet_server_whiteboard_snapshot_proto_rawDesc), len(file_plugnmeet_server_whiteboard_snapshot_proto_rawDesc)))
+	})
+	return file_plugnmeet_server_whiteboard_snapshot_proto_rawDescData
+}
+
+var file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_plugnmeet_server_whiteboard_snapshot_proto_goTypes = []any{
+	(*FetchWhiteboardSnapshotsReq)(nil),           // 0: plugnmeet_server.FetchWhiteboardSnapshotsReq
+	(*WhiteboardSnapshotInfo)(nil),                // 1: plugnmeet_server.WhiteboardSnapshotInfo
+	(*FetchWhiteboardSnapshotsResult)(nil),        // 2: plugnmeet_server.FetchWhiteboardSnapshotsResult
+	(*FetchWhiteboardSnapshotsRes)(nil),           // 3: plugnmeet_server.FetchWhiteboardSnapshotsRes
+	(*DeleteWhiteboardSnapshotReq)(nil),           // 4: plugnmeet_server.DeleteWhiteboardSnapshotReq
+	(*GetWhiteboardSnapshotDownloadTokenReq)(nil), // 5: plugnmeet_server.GetWhiteboardSnapshotDownloadTokenReq
+	(*GetWhiteboardSnapshotDownloadTokenRes)(nil), // 6: plugnmeet_server.GetWhiteboardSnapshotDownloadTokenRes
+}
+var file_plugnmeet_server_whiteboard_snapshot_proto_depIdxs = []int32{
+	1, // 0: plugnmeet_server.FetchWhiteboardSnapshotsResult.snapshots_list:type_name -> plugnmeet_server.WhiteboardSnapshotInfo
+	2, // 1: plugnmeet_server.FetchWhiteboardSnapshotsRes.result:type_name -> plugnmeet_server.FetchWhiteboardSnapshotsResult
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_plugnmeet_server_whiteboard_snapshot_proto_init() }
+func file_plugnmeet_server_whiteboard_snapshot_proto_init() {
+	if File_plugnmeet_server_whiteboard_snapshot_proto != nil {
+		return
+	}
+	file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugnmeet_server_whiteboard_snapshot_proto_rawDesc), len(file_plugnmeet_server_whiteboard_snapshot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugnmeet_server_whiteboard_snapshot_proto_goTypes,
+		DependencyIndexes: file_plugnmeet_server_whiteboard_snapshot_proto_depIdxs,
+		MessageInfos:      file_plugnmeet_server_whiteboard_snapshot_proto_msgTypes,
+	}.Build()
+	File_plugnmeet_server_whiteboard_snapshot_proto = out.File
+	file_plugnmeet_server_whiteboard_snapshot_proto_goTypes = nil
+	file_plugnmeet_server_whiteboard_snapshot_proto_depIdxs = nil
+}
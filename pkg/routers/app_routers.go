@@ -61,7 +61,10 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	app.Post("/webhook", ctrl.WebhookController.HandleWebhook)
 	app.Get("/download/uploadedFile/:sid/*", ctrl.FileController.HandleDownloadUploadedFile)
 	app.Get("/download/recording/:token", ctrl.RecordingController.HandleDownloadRecording)
+	app.Get("/playback/recording/:token", ctrl.RecordingController.HandlePlaybackRecording)
 	app.Get("/download/analytics/:token", ctrl.AnalyticsController.HandleDownloadAnalytics)
+	app.Get("/download/chat/:token", ctrl.ChatArchiveController.HandleDownloadChatArchive)
+	app.Get("/download/whiteboard/:token", ctrl.WhiteboardSnapshotController.HandleDownloadWhiteboardSnapshot)
 	app.Get("/healthCheck", controllers.HandleHealthCheck)
 
 	// lti group
@@ -89,12 +92,50 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	room.Post("/endRoom", ctrl.RoomController.HandleEndRoom)
 	room.Post("/fetchPastRooms", ctrl.RoomController.HandleFetchPastRooms)
 
+	// for scheduled rooms
+	schedule := auth.Group("/schedule")
+	schedule.Post("/create", ctrl.RoomScheduleController.HandleCreateScheduledRoom)
+	schedule.Post("/update", ctrl.RoomScheduleController.HandleUpdateScheduledRoom)
+	schedule.Post("/cancel", ctrl.RoomScheduleController.HandleCancelScheduledRoom)
+	schedule.Post("/list", ctrl.RoomScheduleController.HandleFetchScheduledRooms)
+	schedule.Post("/createSeries", ctrl.RoomScheduleController.HandleCreateScheduledRoomSeries)
+	schedule.Post("/cancelSeries", ctrl.RoomScheduleController.HandleCancelScheduledRoomSeries)
+
+	// for room templates
+	roomTemplate := auth.Group("/roomTemplate")
+	roomTemplate.Post("/create", ctrl.RoomTemplateController.HandleCreateRoomTemplate)
+	roomTemplate.Post("/update", ctrl.RoomTemplateController.HandleUpdateRoomTemplate)
+	roomTemplate.Post("/delete", ctrl.RoomTemplateController.HandleDeleteRoomTemplate)
+	roomTemplate.Post("/list", ctrl.RoomTemplateController.HandleFetchRoomTemplates)
+
+	// webhook delivery log & registered endpoints
+	webhook := auth.Group("/webhook", ctrl.AuthController.HandleAuthRequireMainApiKey)
+	webhook.Post("/deliveries", ctrl.WebhookController.HandleFetchWebhookDeliveries)
+	webhook.Post("/replay", ctrl.WebhookController.HandleReplayWebhookDeliveries)
+	webhook.Post("/endpoint/create", ctrl.WebhookController.HandleCreateWebhookEndpoint)
+	webhook.Post("/endpoint/update", ctrl.WebhookController.HandleUpdateWebhookEndpoint)
+	webhook.Post("/endpoint/delete", ctrl.WebhookController.HandleDeleteWebhookEndpoint)
+	webhook.Post("/endpoint/list", ctrl.WebhookController.HandleFetchWebhookEndpoints)
+
+	// tenants can be managed only using the main API key
+	tenant := auth.Group("/tenant", ctrl.AuthController.HandleAuthRequireMainApiKey)
+	tenant.Post("/create", ctrl.TenantController.HandleCreateTenant)
+	tenant.Post("/update", ctrl.TenantController.HandleUpdateTenant)
+	tenant.Post("/delete", ctrl.TenantController.HandleDeleteTenant)
+	tenant.Post("/list", ctrl.TenantController.HandleFetchTenants)
+
 	// for recording
 	recording := auth.Group("/recording")
 	recording.Post("/fetch", ctrl.RecordingController.HandleFetchRecordings)
 	recording.Post("/recordingInfo", ctrl.RecordingController.HandleRecordingInfo)
 	recording.Post("/delete", ctrl.RecordingController.HandleDeleteRecording)
 	recording.Post("/getDownloadToken", ctrl.RecordingController.HandleGetDownloadToken)
+	recording.Post("/getPlaybackToken", ctrl.RecordingController.HandleGetPlaybackToken)
+	recording.Post("/publish", ctrl.RecordingController.HandlePublishRecording)
+	recording.Post("/update", ctrl.RecordingController.HandleUpdateRecording)
+	recording.Post("/retentionReport", ctrl.RecordingController.HandleRecordingRetentionReport)
+	recording.Post("/listDeleted", ctrl.RecordingController.HandleFetchDeletedRecordings)
+	recording.Post("/restore", ctrl.RecordingController.HandleRestoreRecording)
 
 	// for analytics
 	analytics := auth.Group("/analytics")
@@ -102,8 +143,19 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	analytics.Post("/delete", ctrl.AnalyticsController.HandleDeleteAnalytics)
 	analytics.Post("/getDownloadToken", ctrl.AnalyticsController.HandleGetAnalyticsDownloadToken)
 
+	// for archived chat
+	chat := auth.Group("/chat")
+	chat.Post("/fetch", ctrl.ChatArchiveController.HandleFetchChatArchives)
+	chat.Post("/getDownloadToken", ctrl.ChatArchiveController.HandleGetChatArchiveDownloadToken)
+
+	// for whiteboard snapshots
+	whiteboard := auth.Group("/whiteboard")
+	whiteboard.Post("/fetchSnapshots", ctrl.WhiteboardSnapshotController.HandleFetchWhiteboardSnapshots)
+	whiteboard.Post("/deleteSnapshot", ctrl.WhiteboardSnapshotController.HandleDeleteWhiteboardSnapshot)
+	whiteboard.Post("/getSnapshotDownloadToken", ctrl.WhiteboardSnapshotController.HandleGetWhiteboardSnapshotDownloadToken)
+
 	// to handle different events from recorder
-	recorder := auth.Group("/recorder")
+	recorder := auth.Group("/recorder", ctrl.AuthController.HandleAuthRequireMainApiKey)
 	recorder.Post("/notify", ctrl.RecorderController.HandleRecorderEvents)
 
 	// for convert BBB request to PlugNmeet
@@ -116,7 +168,6 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	bbb.All("/end", ctrl.BBBController.HandleBBBEndMeetings)
 	bbb.All("/getRecordings", ctrl.BBBController.HandleBBBGetRecordings)
 	bbb.All("/deleteRecordings", ctrl.BBBController.HandleBBBDeleteRecordings)
-	// TO-DO: in the future
 	bbb.All("/updateRecordings", ctrl.BBBController.HandleBBBUpdateRecordings)
 	bbb.All("/publishRecordings", ctrl.BBBController.HandleBBBPublishRecordings)
 
@@ -147,6 +198,9 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	waitingRoom := api.Group("/waitingRoom")
 	waitingRoom.Post("/approveUsers", ctrl.WaitingRoomController.HandleApproveUsers)
 	waitingRoom.Post("/updateMsg", ctrl.WaitingRoomController.HandleUpdateWaitingRoomMessage)
+	waitingRoom.Post("/queue", ctrl.WaitingRoomController.HandleGetWaitingQueue)
+	waitingRoom.Post("/approveNext", ctrl.WaitingRoomController.HandleApproveNextUsers)
+	waitingRoom.Post("/rejectUsers", ctrl.WaitingRoomController.HandleRejectUsers)
 
 	// polls group
 	polls := api.Group("/polls")
@@ -164,6 +218,8 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	// breakout room group
 	breakoutRoom := api.Group("/breakoutRoom")
 	breakoutRoom.Post("/create", ctrl.BreakoutRoomController.HandleCreateBreakoutRooms)
+	breakoutRoom.Post("/autoCreate", ctrl.BreakoutRoomController.HandleAutoCreateBreakoutRooms)
+	breakoutRoom.Post("/selectRoom", ctrl.BreakoutRoomController.HandleSelectBreakoutRoom)
 	breakoutRoom.Post("/join", ctrl.BreakoutRoomController.HandleJoinBreakoutRoom)
 	breakoutRoom.Get("/listRooms", ctrl.BreakoutRoomController.HandleGetBreakoutRooms)
 	breakoutRoom.Get("/myRooms", ctrl.BreakoutRoomController.HandleGetMyBreakoutRooms)
@@ -171,6 +227,7 @@ func New(appConfig *config.AppConfig, ctrl *factory.ApplicationControllers) *fib
 	breakoutRoom.Post("/sendMsg", ctrl.BreakoutRoomController.HandleSendBreakoutRoomMsg)
 	breakoutRoom.Post("/endRoom", ctrl.BreakoutRoomController.HandleEndBreakoutRoom)
 	breakoutRoom.Post("/endAllRooms", ctrl.BreakoutRoomController.HandleEndBreakoutRooms)
+	breakoutRoom.Post("/returnToMainRoom", ctrl.BreakoutRoomController.HandleReturnToMainRoom)
 
 	// Ingress
 	ingress := api.Group("/ingress")
@@ -0,0 +1,58 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// GetChatArchives will return archives, empty tenantId will return archives of all tenants
+func (s *DatabaseService) GetChatArchives(roomIds []string, tenantId string, offset, limit uint64, direction *string) ([]dbmodels.ChatArchive, int64, error) {
+	var archives []dbmodels.ChatArchive
+	var total int64
+
+	d := s.db.Model(&dbmodels.ChatArchive{})
+	if len(roomIds) > 0 {
+		d.Where("room_id IN ?", roomIds)
+	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+
+	if err := d.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	orderBy := "DESC"
+	if direction != nil && *direction == "ASC" {
+		orderBy = "ASC"
+	}
+
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("id " + orderBy).Find(&archives)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, 0, result.Error
+	}
+
+	return archives, total, nil
+}
+
+func (s *DatabaseService) GetChatArchive(archiveId string) (*dbmodels.ChatArchive, error) {
+	info := new(dbmodels.ChatArchive)
+	cond := &dbmodels.ChatArchive{
+		ArchiveId: archiveId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
@@ -0,0 +1,14 @@
+package dbservice
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+)
+
+func (s *DatabaseService) InsertChatArchive(info *dbmodels.ChatArchive) (int64, error) {
+	result := s.db.Create(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
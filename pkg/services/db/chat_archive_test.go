@@ -0,0 +1,58 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var chatArchiveId = fmt.Sprintf("chat-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertChatArchive(t *testing.T) {
+	info := &dbmodels.ChatArchive{
+		ArchiveId:        chatArchiveId,
+		RoomTableID:      roomTableId,
+		RoomId:           roomId,
+		RoomSid:          sid,
+		FileName:         chatArchiveId,
+		FileSize:         1.5,
+		TotalMessages:    10,
+		RoomCreationTime: roomCreationTime,
+	}
+
+	_, err := s.InsertChatArchive(info)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseService_GetChatArchives(t *testing.T) {
+	archives, total, err := s.GetChatArchives([]string{roomId}, "", 0, 5, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v with total: %d", archives, total)
+}
+
+func TestDatabaseService_GetChatArchive(t *testing.T) {
+	info, err := s.GetChatArchive(chatArchiveId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetChatArchive(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil archive but got something else")
+	}
+}
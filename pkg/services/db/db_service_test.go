@@ -5,8 +5,10 @@ import (
 	"github.com/mynaparrot/plugnmeet-server/helpers"
 	"github.com/mynaparrot/plugnmeet-server/pkg/config"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -30,6 +32,7 @@ func init() {
 	}
 
 	appCnf.RootWorkingDir = root
+	setTestDatabaseDriver(&appCnf.DatabaseInfo)
 	// set this config for global usage
 	config.New(appCnf)
 
@@ -41,6 +44,32 @@ func init() {
 	s = New(config.GetConfig().DB)
 }
 
+// setTestDatabaseDriver will allow running the same tests for every supported driver.
+// e.g. TEST_DB_DRIVER=postgres TEST_DB_PORT=5432 go test ./pkg/services/db/...
+func setTestDatabaseDriver(info *config.DatabaseInfo) {
+	driver := os.Getenv("TEST_DB_DRIVER")
+	if driver == "" {
+		return
+	}
+	info.DriverName = driver
+
+	if v := os.Getenv("TEST_DB_HOST"); v != "" {
+		info.Host = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("TEST_DB_PORT")); err == nil {
+		info.Port = int32(v)
+	}
+	if v := os.Getenv("TEST_DB_USERNAME"); v != "" {
+		info.Username = v
+	}
+	if v := os.Getenv("TEST_DB_PASSWORD"); v != "" {
+		info.Password = v
+	}
+	if v := os.Getenv("TEST_DB_NAME"); v != "" {
+		info.DBName = v
+	}
+}
+
 func TestDatabaseService_InsertOrUpdateRoomInfo(t *testing.T) {
 	info := &dbmodels.RoomInfo{
 		RoomId:       roomId,
@@ -4,15 +4,25 @@ import (
 	"errors"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
 	"gorm.io/gorm"
+	"time"
 )
 
-func (s *DatabaseService) GetRecordings(roomIds []string, offset, limit uint64, direction *string) ([]dbmodels.Recording, int64, error) {
+// GetRecordings will return recordings, empty tenantId will return recordings of all tenants.
+// nil published will return both published & unpublished recordings
+func (s *DatabaseService) GetRecordings(roomIds []string, tenantId string, published *bool, offset, limit uint64, direction *string) ([]dbmodels.Recording, int64, error) {
 	var recordings []dbmodels.Recording
 	var total int64
 
 	d := s.db.Model(&dbmodels.Recording{})
 	if len(roomIds) > 0 {
-		d.Where("room_id IN ?", roomIds)
+		// recordings of the breakout rooms will be listed under the parent room
+		d.Where(s.db.Where("room_id IN ?", roomIds).Or("parent_room_id IN ?", roomIds))
+	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+	if published != nil {
+		d.Where("published = ?", publishedValue(*published))
 	}
 
 	if err := d.Count(&total).Error; err != nil {
@@ -53,7 +63,9 @@ func (s *DatabaseService) GetRecording(recordId string) (*dbmodels.Recording, er
 	return info, nil
 }
 
-func (s *DatabaseService) GetRecordingsForBBB(recordIds, meetingIds []string, offset, limit uint64) ([]dbmodels.Recording, int64, error) {
+// GetRecordingsForBBB will return recordings by recordIds or meetingIds.
+// nil published will return both published & unpublished recordings
+func (s *DatabaseService) GetRecordingsForBBB(recordIds, meetingIds []string, published *bool, offset, limit uint64) ([]dbmodels.Recording, int64, error) {
 	var recordings []dbmodels.Recording
 	var total int64
 
@@ -64,6 +76,9 @@ func (s *DatabaseService) GetRecordingsForBBB(recordIds, meetingIds []string, of
 	} else if len(meetingIds) > 0 {
 		d.Where("room_id IN ?", meetingIds)
 	}
+	if published != nil {
+		d.Where("published = ?", publishedValue(*published))
+	}
 
 	if err := d.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -76,3 +91,114 @@ func (s *DatabaseService) GetRecordingsForBBB(recordIds, meetingIds []string, of
 
 	return recordings, total, nil
 }
+
+func publishedValue(published bool) int64 {
+	if published {
+		return 1
+	}
+	return 0
+}
+
+// GetExpiredRecordings will return recordings which are expired at expireAt (unix timestamp).
+// Recordings with own retention_days will use that value, otherwise defaultDays will be used.
+// Negative retention_days means the recording will be kept forever.
+// Empty tenantId will return recordings of all tenants
+func (s *DatabaseService) GetExpiredRecordings(tenantId string, defaultDays int, expireAt int64, offset, limit uint64) ([]dbmodels.Recording, int64, error) {
+	var recordings []dbmodels.Recording
+	var total int64
+
+	d := s.db.Model(&dbmodels.Recording{}).Where(s.expiredRecordingsCond(defaultDays, expireAt))
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+
+	if err := d.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("creation_time ASC").Find(&recordings)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, 0, result.Error
+	}
+
+	return recordings, total, nil
+}
+
+// GetExpiredRecordingsAfterId works like GetExpiredRecordings but uses id as the cursor,
+// so recordings which couldn't be deleted won't be fetched again in the same run
+func (s *DatabaseService) GetExpiredRecordingsAfterId(defaultDays int, expireAt int64, afterId, limit uint64) ([]dbmodels.Recording, error) {
+	var recordings []dbmodels.Recording
+
+	result := s.db.Model(&dbmodels.Recording{}).Where(s.expiredRecordingsCond(defaultDays, expireAt)).Where("id > ?", afterId).Limit(int(limit)).Order("id ASC").Find(&recordings)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, result.Error
+	}
+
+	return recordings, nil
+}
+
+func (s *DatabaseService) expiredRecordingsCond(defaultDays int, expireAt int64) *gorm.DB {
+	cond := s.db.Where("retention_days > 0 AND creation_time + retention_days * 86400 <= ?", expireAt)
+	if defaultDays > 0 {
+		cond = cond.Or("retention_days = 0 AND creation_time <= ?", expireAt-int64(defaultDays)*86400)
+	}
+	return cond
+}
+
+// GetDeletedRecordings will return soft deleted recordings, empty tenantId will return recordings of all tenants
+func (s *DatabaseService) GetDeletedRecordings(tenantId string, offset, limit uint64) ([]dbmodels.Recording, int64, error) {
+	var recordings []dbmodels.Recording
+	var total int64
+
+	d := s.db.Unscoped().Model(&dbmodels.Recording{}).Where("deleted_at IS NOT NULL")
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+
+	if err := d.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("deleted_at DESC").Find(&recordings)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, 0, result.Error
+	}
+
+	return recordings, total, nil
+}
+
+// GetDeletedRecording will return nil if the recording wasn't soft deleted
+func (s *DatabaseService) GetDeletedRecording(recordId string) (*dbmodels.Recording, error) {
+	info := new(dbmodels.Recording)
+
+	result := s.db.Unscoped().Where("record_id = ? AND deleted_at IS NOT NULL", recordId).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+// GetRecordingsDeletedBefore will return soft deleted recordings which were deleted before the time,
+// ordered by id so that the next page can be fetched using the last id
+func (s *DatabaseService) GetRecordingsDeletedBefore(before time.Time, afterId uint64, limit int) ([]dbmodels.Recording, error) {
+	var recordings []dbmodels.Recording
+
+	result := s.db.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Where("id > ?", afterId).Limit(limit).Order("id ASC").Find(&recordings)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, result.Error
+	}
+
+	return recordings, nil
+}
@@ -1,6 +1,7 @@
 package dbservice
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
 	"gorm.io/gorm"
@@ -15,12 +16,13 @@ func (s *DatabaseService) InsertRecordingData(info *dbmodels.Recording) (int64,
 	return result.RowsAffected, nil
 }
 
+// DeleteRecording will delete the recording permanently, soft deleted recording will be deleted too
 func (s *DatabaseService) DeleteRecording(recordId string) (int64, error) {
 	cond := &dbmodels.Recording{
 		RecordID: recordId,
 	}
 
-	result := s.db.Where(cond).Delete(&dbmodels.Recording{})
+	result := s.db.Unscoped().Where(cond).Delete(&dbmodels.Recording{})
 	switch {
 	case errors.Is(result.Error, gorm.ErrRecordNotFound):
 		return 0, nil
@@ -30,3 +32,65 @@ func (s *DatabaseService) DeleteRecording(recordId string) (int64, error) {
 
 	return result.RowsAffected, nil
 }
+
+// SoftDeleteRecording will only set deleted_at, so that the recording can be restored later
+func (s *DatabaseService) SoftDeleteRecording(recordId string) (int64, error) {
+	cond := &dbmodels.Recording{
+		RecordID: recordId,
+	}
+
+	result := s.db.Where(cond).Delete(&dbmodels.Recording{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// RestoreRecording will restore the soft deleted recording
+func (s *DatabaseService) RestoreRecording(recordId string) (int64, error) {
+	result := s.db.Unscoped().Model(&dbmodels.Recording{}).Where("record_id = ? AND deleted_at IS NOT NULL", recordId).Update("deleted_at", nil)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// UpdateRecordingsPublished will change the published status of the recordings
+func (s *DatabaseService) UpdateRecordingsPublished(recordIds []string, published bool) (int64, error) {
+	update := map[string]interface{}{
+		"published": publishedValue(published),
+	}
+
+	result := s.db.Model(&dbmodels.Recording{}).Where("record_id IN ?", recordIds).Updates(update)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// UpdateRecordingMetadata will update only the provided title, description & metadata
+func (s *DatabaseService) UpdateRecordingMetadata(recordId string, title, description, metadata *string) (int64, error) {
+	update := map[string]interface{}{}
+	if title != nil {
+		update["title"] = *title
+	}
+	if description != nil {
+		update["description"] = sql.NullString{String: *description, Valid: *description != ""}
+	}
+	if metadata != nil {
+		update["metadata"] = sql.NullString{String: *metadata, Valid: *metadata != ""}
+	}
+	if len(update) == 0 {
+		return 0, nil
+	}
+
+	result := s.db.Model(&dbmodels.Recording{}).Where("record_id = ?", recordId).Updates(update)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
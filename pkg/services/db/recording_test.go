@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"slices"
 	"testing"
 	"time"
 )
@@ -34,7 +35,7 @@ func TestDatabaseService_InsertRecordingData(t *testing.T) {
 
 func TestDatabaseService_GetRecordings(t *testing.T) {
 	roomIds := []string{roomId}
-	recordings, total, err := s.GetRecordings(roomIds, 0, 5, nil)
+	recordings, total, err := s.GetRecordings(roomIds, "", nil, 0, 5, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -42,6 +43,152 @@ func TestDatabaseService_GetRecordings(t *testing.T) {
 	t.Logf("%+v with total: %d", recordings, total)
 }
 
+func TestDatabaseService_GetRecordingsWithBreakoutRooms(t *testing.T) {
+	bkRecording := newTestRecording(t, func(r *dbmodels.Recording) {
+		r.RoomID = roomId + "-1"
+		r.ParentRoomID = roomId
+	})
+
+	recordings, _, err := s.GetRecordings([]string{roomId}, "", nil, 0, 20, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if r := findRecording(recordings, bkRecording.RecordID); r == nil || r.ParentRoomID != roomId {
+		t.Errorf("recording of the breakout room should be listed under the parent room, got: %+v", r)
+	}
+}
+
+func TestDatabaseService_UpdateRecordingsPublished(t *testing.T) {
+	_, err := s.UpdateRecordingsPublished([]string{recordId}, false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	published := true
+	recordings, _, err := s.GetRecordings([]string{roomId}, "", &published, 0, 20, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	for _, r := range recordings {
+		if r.RecordID == recordId {
+			t.Error("unpublished recording shouldn't be listed")
+		}
+	}
+
+	_, err = s.UpdateRecordingsPublished([]string{recordId}, true)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseService_UpdateRecordingMetadata(t *testing.T) {
+	title := "test title"
+	metadata := `{"course":"demo"}`
+	_, err := s.UpdateRecordingMetadata(recordId, &title, nil, &metadata)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	recording, err := s.GetRecording(recordId)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if recording == nil || recording.Title != title || recording.Metadata.String != metadata {
+		t.Errorf("recording metadata wasn't updated, got: %+v", recording)
+	}
+}
+
+func TestDatabaseService_GetExpiredRecordings(t *testing.T) {
+	expired := newTestRecording(t, func(r *dbmodels.Recording) {
+		r.RetentionDays = 1
+	})
+
+	recordings, _, err := s.GetExpiredRecordings("", 0, time.Now().Unix(), 0, 100)
+	if err != nil {
+		t.Error(err)
+	}
+	if r := findRecording(recordings, expired.RecordID); r != nil {
+		t.Errorf("recording shouldn't be expired yet, got: %+v", r)
+	}
+
+	recordings, _, err = s.GetExpiredRecordings("", 0, time.Now().Add(time.Hour*25).Unix(), 0, 100)
+	if err != nil {
+		t.Error(err)
+	}
+	if r := findRecording(recordings, expired.RecordID); r == nil || r.RetentionDays != 1 {
+		t.Errorf("recording should be expired after the retention days, got: %+v", r)
+	}
+}
+
+func TestDatabaseService_GetExpiredRecordingsAfterId(t *testing.T) {
+	expired := newTestRecording(t, func(r *dbmodels.Recording) {
+		r.RetentionDays = 1
+	})
+	expireAt := time.Now().Add(time.Hour * 25).Unix()
+
+	recordings, err := s.GetExpiredRecordingsAfterId(0, expireAt, expired.ID-1, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(recordings) != 1 || recordings[0].RecordID != expired.RecordID {
+		t.Errorf("expected only the expired recording, got: %+v", recordings)
+	}
+
+	recordings, err = s.GetExpiredRecordingsAfterId(0, expireAt, expired.ID, 100)
+	if err != nil {
+		t.Error(err)
+	}
+	if r := findRecording(recordings, expired.RecordID); r != nil {
+		t.Errorf("recording before the cursor shouldn't be returned, got: %+v", r)
+	}
+}
+
+func TestDatabaseService_SoftDeleteAndRestoreRecording(t *testing.T) {
+	deleted := newTestRecording(t, nil)
+
+	_, err := s.SoftDeleteRecording(deleted.RecordID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	recording, err := s.GetRecording(deleted.RecordID)
+	if err != nil {
+		t.Error(err)
+	}
+	if recording != nil {
+		t.Error("soft deleted recording shouldn't be returned")
+	}
+
+	recording, err = s.GetDeletedRecording(deleted.RecordID)
+	if err != nil {
+		t.Error(err)
+	}
+	if recording == nil || recording.RecordID != deleted.RecordID {
+		t.Errorf("soft deleted recording should be found, got: %+v", recording)
+	}
+
+	affected, err := s.RestoreRecording(deleted.RecordID)
+	if err != nil {
+		t.Error(err)
+	}
+	if affected == 0 {
+		t.Error("should restore the recording but got no affected recording")
+	}
+
+	recording, err = s.GetRecording(deleted.RecordID)
+	if err != nil {
+		t.Error(err)
+	}
+	if recording == nil || recording.RecordID != deleted.RecordID {
+		t.Errorf("restored recording should be returned, got: %+v", recording)
+	}
+}
+
 func TestDatabaseService_GetRecording(t *testing.T) {
 	recording, err := s.GetRecording(recordId)
 	if err != nil {
@@ -84,3 +231,36 @@ func TestDatabaseService_DeleteRecording(t *testing.T) {
 		return
 	}
 }
+
+// newTestRecording will insert a recording of the test room,
+// it will be deleted when the test finishes
+func newTestRecording(t *testing.T, modify func(r *dbmodels.Recording)) *dbmodels.Recording {
+	t.Helper()
+	data := &dbmodels.Recording{
+		RecordID: fmt.Sprintf("%d", time.Now().UnixNano()),
+		RoomID:   roomId,
+		Size:     10.10,
+	}
+	if modify != nil {
+		modify(data)
+	}
+
+	if _, err := s.InsertRecordingData(data); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_, _ = s.DeleteRecording(data.RecordID)
+	})
+
+	return data
+}
+
+func findRecording(recordings []dbmodels.Recording, recordId string) *dbmodels.Recording {
+	i := slices.IndexFunc(recordings, func(r dbmodels.Recording) bool {
+		return r.RecordID == recordId
+	})
+	if i < 0 {
+		return nil
+	}
+	return &recordings[i]
+}
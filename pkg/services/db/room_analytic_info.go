@@ -6,7 +6,8 @@ import (
 	"gorm.io/gorm"
 )
 
-func (s *DatabaseService) GetAnalytics(roomIds []string, offset, limit uint64, direction *string) ([]dbmodels.Analytics, int64, error) {
+// GetAnalytics will return analytics, empty tenantId will return analytics of all tenants
+func (s *DatabaseService) GetAnalytics(roomIds []string, tenantId string, offset, limit uint64, direction *string) ([]dbmodels.Analytics, int64, error) {
 	var analytics []dbmodels.Analytics
 	var total int64
 
@@ -14,6 +15,9 @@ func (s *DatabaseService) GetAnalytics(roomIds []string, offset, limit uint64, d
 	if len(roomIds) > 0 {
 		d.Where("room_id IN ?", roomIds)
 	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
 
 	if err := d.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -8,7 +8,7 @@ import (
 
 func TestDatabaseService_GetAnalytics(t *testing.T) {
 	roomIds := []string{"test01"}
-	analytics, total, err := s.GetAnalytics(roomIds, 0, 5, nil)
+	analytics, total, err := s.GetAnalytics(roomIds, "", 0, 5, nil)
 	if err != nil {
 		t.Error(err)
 	}
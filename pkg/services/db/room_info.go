@@ -76,7 +76,8 @@ func (s *DatabaseService) GetActiveRoomsInfo() ([]dbmodels.RoomInfo, error) {
 	return rooms, nil
 }
 
-func (s *DatabaseService) GetPastRooms(roomIds []string, offset, limit uint64, direction *string) ([]dbmodels.RoomInfo, int64, error) {
+// GetPastRooms will return ended rooms, empty tenantId will return rooms of all tenants
+func (s *DatabaseService) GetPastRooms(roomIds []string, seriesId, tenantId string, offset, limit uint64, direction *string) ([]dbmodels.RoomInfo, int64, error) {
 	var roomsInfo []dbmodels.RoomInfo
 	var total int64
 	cond := &dbmodels.RoomInfo{
@@ -85,7 +86,14 @@ func (s *DatabaseService) GetPastRooms(roomIds []string, offset, limit uint64, d
 
 	d := s.db.Model(&dbmodels.RoomInfo{}).Where(cond)
 	if len(roomIds) > 0 {
-		d.Where("roomId IN ?", roomIds)
+		// column name is case-sensitive, map will make sure it's quoted for all the drivers
+		d.Where(map[string]interface{}{"roomId": roomIds})
+	}
+	if seriesId != "" {
+		d.Where("series_id = ?", seriesId)
+	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
 	}
 
 	if err := d.Count(&total).Error; err != nil {
@@ -85,6 +85,19 @@ func (s *DatabaseService) UpdateRoomRTMPStatus(roomTableId uint64, isActiveRtmp
 	return result.RowsAffected, nil
 }
 
+func (s *DatabaseService) UpdateRoomSeriesId(sId, seriesId string) (int64, error) {
+	update := map[string]interface{}{
+		"series_id": seriesId,
+	}
+
+	result := s.db.Model(&dbmodels.RoomInfo{}).Where("sid = ?", sId).Updates(update)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (s *DatabaseService) UpdateNumParticipants(sId string, num int64) (int64, error) {
 	update := map[string]interface{}{
 		"joined_participants": num,
@@ -100,8 +113,13 @@ func (s *DatabaseService) UpdateNumParticipants(sId string, num int64) (int64, e
 
 // IncrementOrDecrementNumParticipants will increment or decrement the number of Participants
 func (s *DatabaseService) IncrementOrDecrementNumParticipants(sId, operator string) (int64, error) {
+	// CASE is used instead of GREATEST/CAST AS SIGNED, so that it works for both MySQL & PostgreSQL
+	expr := gorm.Expr("joined_participants + 1")
+	if operator == "-" {
+		expr = gorm.Expr("CASE WHEN joined_participants > 0 THEN joined_participants - 1 ELSE 0 END")
+	}
 	update := map[string]interface{}{
-		"joined_participants": gorm.Expr("GREATEST(CAST(joined_participants AS SIGNED)" + operator + " 1, 0)"),
+		"joined_participants": expr,
 	}
 
 	result := s.db.Model(&dbmodels.RoomInfo{}).Where("sid = ?", sId).Updates(update)
@@ -0,0 +1,47 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+func (s *DatabaseService) GetRoomTemplate(templateId string) (*dbmodels.RoomTemplate, error) {
+	info := new(dbmodels.RoomTemplate)
+	cond := &dbmodels.RoomTemplate{
+		TemplateId: templateId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+// GetRoomTemplates will return templates of the tenant,
+// empty tenantId will return all the templates
+func (s *DatabaseService) GetRoomTemplates(tenantId string, offset, limit uint64) ([]dbmodels.RoomTemplate, int64, error) {
+	var templates []dbmodels.RoomTemplate
+	var total int64
+
+	d := s.db.Model(&dbmodels.RoomTemplate{})
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+	if limit == 0 {
+		limit = 20
+	}
+
+	d.Count(&total)
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("id DESC").Find(&templates)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return templates, total, nil
+}
@@ -0,0 +1,34 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// InsertOrUpdateRoomTemplate will insert new template
+// otherwise it will update if table ID was sent
+func (s *DatabaseService) InsertOrUpdateRoomTemplate(info *dbmodels.RoomTemplate) (int64, error) {
+	result := s.db.Save(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (s *DatabaseService) DeleteRoomTemplate(templateId string) (int64, error) {
+	cond := &dbmodels.RoomTemplate{
+		TemplateId: templateId,
+	}
+
+	result := s.db.Where(cond).Delete(&dbmodels.RoomTemplate{})
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return 0, nil
+	case result.Error != nil:
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,72 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var roomTemplateId = fmt.Sprintf("template-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertOrUpdateRoomTemplate(t *testing.T) {
+	info := &dbmodels.RoomTemplate{
+		TemplateId:          roomTemplateId,
+		TenantId:            tenantId,
+		Name:                "Testing",
+		RoomFeatures:        `{"allowWebcams":true}`,
+		DefaultLockSettings: `{"lockMicrophone":true}`,
+		RoomDuration:        30,
+	}
+
+	_, err := s.InsertOrUpdateRoomTemplate(info)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_GetRoomTemplate(t *testing.T) {
+	info, err := s.GetRoomTemplate(roomTemplateId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetRoomTemplate(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil template but got something else")
+	}
+}
+
+func TestDatabaseService_GetRoomTemplates(t *testing.T) {
+	templates, total, err := s.GetRoomTemplates(tenantId, 0, 10)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if total == 0 {
+		t.Error("got empty data but should contain data")
+	}
+	t.Logf("%+v", templates)
+}
+
+func TestDatabaseService_DeleteRoomTemplate(t *testing.T) {
+	affected, err := s.DeleteRoomTemplate(roomTemplateId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if affected == 0 {
+		t.Error("should delete template but got no affected template")
+	}
+}
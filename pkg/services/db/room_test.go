@@ -127,7 +127,7 @@ func TestDatabaseService_UpdateRoomStatus(t *testing.T) {
 func TestDatabaseService_GetPastRooms(t *testing.T) {
 	rooms := []string{roomId}
 
-	info, total, err := s.GetPastRooms(rooms, 0, 5, nil)
+	info, total, err := s.GetPastRooms(rooms, "", "", 0, 5, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,98 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+func (s *DatabaseService) GetScheduledRoom(scheduleId string) (*dbmodels.ScheduledRoom, error) {
+	info := new(dbmodels.ScheduledRoom)
+	cond := &dbmodels.ScheduledRoom{
+		ScheduleId: scheduleId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+func (s *DatabaseService) GetScheduledRooms(roomIds []string, seriesId, tenantId string, status *int, offset, limit uint64, direction *string) ([]dbmodels.ScheduledRoom, int64, error) {
+	var rooms []dbmodels.ScheduledRoom
+	var total int64
+
+	d := s.db.Model(&dbmodels.ScheduledRoom{})
+	if len(roomIds) > 0 {
+		d.Where("room_id IN ?", roomIds)
+	}
+	if seriesId != "" {
+		d.Where("series_id = ?", seriesId)
+	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+	if status != nil {
+		d.Where("status = ?", *status)
+	}
+
+	if err := d.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	orderBy := "ASC"
+	if direction != nil && *direction == "DESC" {
+		orderBy = "DESC"
+	}
+
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("start_at " + orderBy).Find(&rooms)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, 0, result.Error
+	}
+
+	return rooms, total, nil
+}
+
+// GetDueScheduledRooms will return pending schedules which should start at or before the given unix time
+func (s *DatabaseService) GetDueScheduledRooms(before int64) ([]dbmodels.ScheduledRoom, error) {
+	var rooms []dbmodels.ScheduledRoom
+	cond := &dbmodels.ScheduledRoom{
+		Status: dbmodels.ScheduledRoomStatusPending,
+	}
+
+	result := s.db.Where(cond).Where("start_at <= ?", before).Order("start_at ASC").Find(&rooms)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return rooms, nil
+}
+
+func (s *DatabaseService) GetScheduledRoomSeries(seriesId string) (*dbmodels.ScheduledRoomSeries, error) {
+	info := new(dbmodels.ScheduledRoomSeries)
+	cond := &dbmodels.ScheduledRoomSeries{
+		SeriesId: seriesId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
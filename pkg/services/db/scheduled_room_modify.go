@@ -0,0 +1,94 @@
+package dbservice
+
+import (
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// InsertOrUpdateScheduledRoom will insert new schedule
+// otherwise it will update if table ID was sent
+func (s *DatabaseService) InsertOrUpdateScheduledRoom(info *dbmodels.ScheduledRoom) (int64, error) {
+	result := s.db.Save(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// UpdatePendingScheduledRoom will update the schedule only if it is still pending.
+// The scheduler may claim the schedule at the same time, so RowsAffected must be checked
+func (s *DatabaseService) UpdatePendingScheduledRoom(info *dbmodels.ScheduledRoom) (int64, error) {
+	update := map[string]interface{}{
+		"room_id":          info.RoomId,
+		"room_title":       info.RoomTitle,
+		"start_at":         info.StartAt,
+		"create_room_req":  info.CreateRoomReq,
+		"create_room_opts": info.CreateRoomOpts,
+	}
+
+	result := s.db.Model(&dbmodels.ScheduledRoom{}).Where("id = ? AND status = ?", info.ID, dbmodels.ScheduledRoomStatusPending).Updates(update)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// UpdateScheduledRoomStatus will change the status only if the current status matches fromStatus.
+// This way only one server in the cluster will be able to claim a schedule.
+func (s *DatabaseService) UpdateScheduledRoomStatus(scheduleId string, fromStatus, toStatus int, roomSid, errorMsg string) (int64, error) {
+	update := map[string]interface{}{
+		"status":    toStatus,
+		"room_sid":  roomSid,
+		"error_msg": errorMsg,
+	}
+
+	result := s.db.Model(&dbmodels.ScheduledRoom{}).Where("schedule_id = ? AND status = ?", scheduleId, fromStatus).Updates(update)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// InsertScheduledRoomSeries will insert the series with all of its occurrences
+func (s *DatabaseService) InsertScheduledRoomSeries(series *dbmodels.ScheduledRoomSeries, occurrences []*dbmodels.ScheduledRoom) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(series).Error; err != nil {
+			return err
+		}
+		for _, o := range occurrences {
+			o.SeriesId = series.SeriesId
+		}
+		return tx.Create(occurrences).Error
+	})
+}
+
+// CancelScheduledRoomSeries will cancel the series & all of its pending occurrences.
+// It will return the occurrences which were cancelled
+func (s *DatabaseService) CancelScheduledRoomSeries(seriesId string) ([]dbmodels.ScheduledRoom, error) {
+	var cancelled []dbmodels.ScheduledRoom
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&dbmodels.ScheduledRoomSeries{}).Where("series_id = ?", seriesId).Update("status", dbmodels.ScheduledRoomSeriesStatusCancelled)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		cond := &dbmodels.ScheduledRoom{
+			SeriesId: seriesId,
+			Status:   dbmodels.ScheduledRoomStatusPending,
+		}
+		if err := tx.Where(cond).Find(&cancelled).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&dbmodels.ScheduledRoom{}).Where(cond).Update("status", dbmodels.ScheduledRoomStatusCancelled).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
@@ -0,0 +1,193 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var scheduleId = fmt.Sprintf("schedule-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertOrUpdateScheduledRoom(t *testing.T) {
+	info := &dbmodels.ScheduledRoom{
+		ScheduleId:     scheduleId,
+		RoomId:         roomId,
+		RoomTitle:      "Testing",
+		StartAt:        time.Now().Add(-time.Minute).Unix(),
+		CreateRoomReq:  fmt.Sprintf(`{"roomId":"%s"}`, roomId),
+		CreateRoomOpts: `{"wait_for_moderator":true}`,
+	}
+
+	_, err := s.InsertOrUpdateScheduledRoom(info)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_GetScheduledRoom(t *testing.T) {
+	info, err := s.GetScheduledRoom(scheduleId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	if info.CreateRoomOpts != `{"wait_for_moderator":true}` {
+		t.Errorf("expected stored options but got: %s", info.CreateRoomOpts)
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetScheduledRoom(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil schedule but got something else")
+	}
+}
+
+func TestDatabaseService_GetDueScheduledRooms(t *testing.T) {
+	rooms, err := s.GetDueScheduledRooms(time.Now().Unix())
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(rooms) == 0 {
+		t.Error("got empty data but should contain data")
+		return
+	}
+
+	t.Logf("%+v", rooms)
+}
+
+func TestDatabaseService_UpdatePendingScheduledRoom(t *testing.T) {
+	info, err := s.GetScheduledRoom(scheduleId)
+	if err != nil || info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+
+	info.RoomTitle = "Testing updated"
+	affected, err := s.UpdatePendingScheduledRoom(info)
+	if err != nil {
+		t.Error(err)
+	}
+	if affected == 0 {
+		t.Error("should update pending schedule but got no affected schedule")
+	}
+}
+
+func TestDatabaseService_UpdateScheduledRoomStatus(t *testing.T) {
+	affected, err := s.UpdateScheduledRoomStatus(scheduleId, dbmodels.ScheduledRoomStatusPending, dbmodels.ScheduledRoomStatusStarted, sid, "")
+	if err != nil {
+		t.Error(err)
+	}
+	if affected == 0 {
+		t.Error("should update schedule but got no affected schedule")
+		return
+	}
+
+	// already claimed, so should not update again
+	affected, err = s.UpdateScheduledRoomStatus(scheduleId, dbmodels.ScheduledRoomStatusPending, dbmodels.ScheduledRoomStatusStarted, sid, "")
+	if err != nil {
+		t.Error(err)
+	}
+	if affected != 0 {
+		t.Error("should not update schedule but got affected schedule")
+	}
+
+	// claimed schedule must not be overwritten by an update
+	info, _ := s.GetScheduledRoom(scheduleId)
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	affected, err = s.UpdatePendingScheduledRoom(info)
+	if err != nil {
+		t.Error(err)
+	}
+	if affected != 0 {
+		t.Error("should not update claimed schedule but got affected schedule")
+	}
+}
+
+func TestDatabaseService_GetScheduledRooms(t *testing.T) {
+	rooms := []string{roomId}
+	status := dbmodels.ScheduledRoomStatusStarted
+
+	info, total, err := s.GetScheduledRooms(rooms, "", "", &status, 0, 5, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v with total: %d", info, total)
+}
+
+var seriesId = fmt.Sprintf("series-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertScheduledRoomSeries(t *testing.T) {
+	series := &dbmodels.ScheduledRoomSeries{
+		SeriesId:       seriesId,
+		RoomId:         roomId,
+		RoomTitle:      "Testing",
+		RecurrenceRule: "FREQ=DAILY;COUNT=2",
+		Timezone:       "UTC",
+		StartAt:        time.Now().Add(time.Hour).Unix(),
+		Occurrences:    2,
+		CreateRoomReq:  fmt.Sprintf(`{"roomId":"%s"}`, roomId),
+	}
+
+	var occurrences []*dbmodels.ScheduledRoom
+	for i := 0; i < series.Occurrences; i++ {
+		occurrences = append(occurrences, &dbmodels.ScheduledRoom{
+			ScheduleId:    fmt.Sprintf("%s-%d", seriesId, i),
+			RoomId:        roomId,
+			RoomTitle:     series.RoomTitle,
+			StartAt:       time.Unix(series.StartAt, 0).AddDate(0, 0, i).Unix(),
+			CreateRoomReq: series.CreateRoomReq,
+		})
+	}
+
+	err := s.InsertScheduledRoomSeries(series, occurrences)
+	if err != nil {
+		t.Error(err)
+	}
+
+	info, total, err := s.GetScheduledRooms(nil, seriesId, "", nil, 0, 5, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if total != 2 {
+		t.Errorf("expected 2 occurrences but got %d", total)
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_GetScheduledRoomSeries(t *testing.T) {
+	info, err := s.GetScheduledRoomSeries(seriesId)
+	if err != nil {
+		t.Error(err)
+	}
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_CancelScheduledRoomSeries(t *testing.T) {
+	cancelled, err := s.CancelScheduledRoomSeries(seriesId)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(cancelled) != 2 {
+		t.Errorf("expected 2 cancelled occurrences but got %d", len(cancelled))
+	}
+}
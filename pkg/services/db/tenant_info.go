@@ -0,0 +1,73 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+type TenantUsage struct {
+	Rooms        int64
+	Participants int64
+}
+
+func (s *DatabaseService) GetTenant(tenantId string) (*dbmodels.Tenant, error) {
+	info := new(dbmodels.Tenant)
+	cond := &dbmodels.Tenant{
+		TenantId: tenantId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+func (s *DatabaseService) GetTenantByApiKey(apiKey string) (*dbmodels.Tenant, error) {
+	info := new(dbmodels.Tenant)
+	cond := &dbmodels.Tenant{
+		ApiKey: apiKey,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+func (s *DatabaseService) GetTenants() ([]dbmodels.Tenant, error) {
+	var tenants []dbmodels.Tenant
+
+	result := s.db.Model(&dbmodels.Tenant{}).Order("id ASC").Find(&tenants)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return tenants, nil
+}
+
+// GetTenantUsage will return number of running rooms (excluding breakout rooms)
+// & total joined participants of all running rooms of the tenant
+func (s *DatabaseService) GetTenantUsage(tenantId string) (*TenantUsage, error) {
+	usage := new(TenantUsage)
+
+	result := s.db.Model(&dbmodels.RoomInfo{}).
+		Select("COUNT(CASE WHEN is_breakout_room = 0 THEN 1 END) AS rooms, COALESCE(SUM(joined_participants), 0) AS participants").
+		Where("tenant_id = ? AND is_running = ?", tenantId, 1).
+		Scan(usage)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,70 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var tenantId = fmt.Sprintf("tenant-%d", time.Now().UnixNano())
+var tenantApiKey = fmt.Sprintf("key-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertOrUpdateTenant(t *testing.T) {
+	info := &dbmodels.Tenant{
+		TenantId:           tenantId,
+		Name:               "Testing",
+		ApiKey:             tenantApiKey,
+		Secret:             "secret",
+		MaxConcurrentRooms: 2,
+		IsActive:           1,
+	}
+
+	_, err := s.InsertOrUpdateTenant(info)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_GetTenantByApiKey(t *testing.T) {
+	info, err := s.GetTenantByApiKey(tenantApiKey)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetTenantByApiKey(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil tenant but got something else")
+	}
+}
+
+func TestDatabaseService_GetTenantUsage(t *testing.T) {
+	usage, err := s.GetTenantUsage(tenantId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v", usage)
+}
+
+func TestDatabaseService_DeleteTenant(t *testing.T) {
+	affected, err := s.DeleteTenant(tenantId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if affected == 0 {
+		t.Error("should delete tenant but got no affected tenant")
+	}
+}
@@ -0,0 +1,46 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+func (s *DatabaseService) GetWebhookEndpoint(endpointId string) (*dbmodels.WebhookEndpoint, error) {
+	info := new(dbmodels.WebhookEndpoint)
+	cond := &dbmodels.WebhookEndpoint{
+		EndpointId: endpointId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
+
+// GetWebhookEndpoints will return the registered endpoints of the tenant,
+// empty tenantId will return all the endpoints.
+// If onlyActive is true then disabled endpoints will be skipped
+func (s *DatabaseService) GetWebhookEndpoints(tenantId string, onlyActive bool) ([]dbmodels.WebhookEndpoint, error) {
+	var endpoints []dbmodels.WebhookEndpoint
+
+	d := s.db.Model(&dbmodels.WebhookEndpoint{})
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+	if onlyActive {
+		d.Where("is_active = ?", 1)
+	}
+
+	result := d.Order("id ASC").Find(&endpoints)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return endpoints, nil
+}
@@ -0,0 +1,34 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// InsertOrUpdateWebhookEndpoint will insert new endpoint
+// otherwise it will update if table ID was sent
+func (s *DatabaseService) InsertOrUpdateWebhookEndpoint(info *dbmodels.WebhookEndpoint) (int64, error) {
+	result := s.db.Save(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (s *DatabaseService) DeleteWebhookEndpoint(endpointId string) (int64, error) {
+	cond := &dbmodels.WebhookEndpoint{
+		EndpointId: endpointId,
+	}
+
+	result := s.db.Where(cond).Delete(&dbmodels.WebhookEndpoint{})
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return 0, nil
+	case result.Error != nil:
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
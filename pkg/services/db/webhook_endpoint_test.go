@@ -0,0 +1,85 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var endpointId = fmt.Sprintf("endpoint-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertOrUpdateWebhookEndpoint(t *testing.T) {
+	info := &dbmodels.WebhookEndpoint{
+		EndpointId: endpointId,
+		Name:       "Testing",
+		Url:        "http://localhost/webhook",
+		Secret:     "secret",
+		Events:     "room_started,room_finished",
+		IsActive:   1,
+		TenantId:   tenantId,
+	}
+
+	_, err := s.InsertOrUpdateWebhookEndpoint(info)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v", info)
+}
+
+func TestDatabaseService_GetWebhookEndpoint(t *testing.T) {
+	info, err := s.GetWebhookEndpoint(endpointId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetWebhookEndpoint(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil endpoint but got something else")
+	}
+}
+
+func TestDatabaseService_GetWebhookEndpoints(t *testing.T) {
+	endpoints, err := s.GetWebhookEndpoints(tenantId, true)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(endpoints) == 0 {
+		t.Error("got empty data but should contain data")
+		return
+	}
+
+	t.Logf("%+v", endpoints)
+}
+
+func TestDatabaseService_DeleteWebhookEndpoint(t *testing.T) {
+	affected, err := s.DeleteWebhookEndpoint(endpointId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if affected == 0 {
+		t.Error("should delete endpoint but got no affected endpoint")
+		return
+	}
+
+	affected, err = s.DeleteWebhookEndpoint(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if affected != 0 {
+		t.Error("should not find endpoint but got affected endpoint")
+	}
+}
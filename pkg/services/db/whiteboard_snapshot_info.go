@@ -0,0 +1,58 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// GetWhiteboardSnapshots will return snapshots, empty tenantId will return snapshots of all tenants
+func (s *DatabaseService) GetWhiteboardSnapshots(roomIds []string, tenantId string, offset, limit uint64, direction *string) ([]dbmodels.WhiteboardSnapshot, int64, error) {
+	var snapshots []dbmodels.WhiteboardSnapshot
+	var total int64
+
+	d := s.db.Model(&dbmodels.WhiteboardSnapshot{})
+	if len(roomIds) > 0 {
+		d.Where("room_id IN ?", roomIds)
+	}
+	if tenantId != "" {
+		d.Where("tenant_id = ?", tenantId)
+	}
+
+	if err := d.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	orderBy := "DESC"
+	if direction != nil && *direction == "ASC" {
+		orderBy = "ASC"
+	}
+
+	result := d.Offset(int(offset)).Limit(int(limit)).Order("id " + orderBy).Find(&snapshots)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, 0, result.Error
+	}
+
+	return snapshots, total, nil
+}
+
+func (s *DatabaseService) GetWhiteboardSnapshot(snapshotId string) (*dbmodels.WhiteboardSnapshot, error) {
+	info := new(dbmodels.WhiteboardSnapshot)
+	cond := &dbmodels.WhiteboardSnapshot{
+		SnapshotId: snapshotId,
+	}
+
+	result := s.db.Where(cond).Take(info)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return nil, nil
+	case result.Error != nil:
+		return nil, result.Error
+	}
+
+	return info, nil
+}
@@ -0,0 +1,32 @@
+package dbservice
+
+import (
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+func (s *DatabaseService) InsertWhiteboardSnapshot(info *dbmodels.WhiteboardSnapshot) (int64, error) {
+	result := s.db.Create(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (s *DatabaseService) DeleteWhiteboardSnapshot(snapshotId string) (int64, error) {
+	cond := &dbmodels.WhiteboardSnapshot{
+		SnapshotId: snapshotId,
+	}
+
+	result := s.db.Where(cond).Delete(&dbmodels.WhiteboardSnapshot{})
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return 0, nil
+	case result.Error != nil:
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,71 @@
+package dbservice
+
+import (
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/dbmodels"
+	"testing"
+	"time"
+)
+
+var whiteboardSnapshotId = fmt.Sprintf("wb-%d", time.Now().UnixNano())
+
+func TestDatabaseService_InsertWhiteboardSnapshot(t *testing.T) {
+	info := &dbmodels.WhiteboardSnapshot{
+		SnapshotId:       whiteboardSnapshotId,
+		RoomTableID:      roomTableId,
+		RoomId:           roomId,
+		RoomSid:          sid,
+		FileId:           "file01",
+		FileName:         "testing.pdf",
+		TotalPages:       2,
+		TotalFiles:       1,
+		TotalEvents:      10,
+		RoomCreationTime: roomCreationTime,
+	}
+
+	_, err := s.InsertWhiteboardSnapshot(info)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseService_GetWhiteboardSnapshots(t *testing.T) {
+	snapshots, total, err := s.GetWhiteboardSnapshots([]string{roomId}, "", 0, 5, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Logf("%+v with total: %d", snapshots, total)
+}
+
+func TestDatabaseService_GetWhiteboardSnapshot(t *testing.T) {
+	info, err := s.GetWhiteboardSnapshot(whiteboardSnapshotId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if info == nil {
+		t.Error("got empty data but should contain data")
+		return
+	}
+	t.Logf("%+v", info)
+
+	info, err = s.GetWhiteboardSnapshot(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	if err != nil {
+		t.Error(err)
+	}
+	if info != nil {
+		t.Error("expected nil snapshot but got something else")
+	}
+}
+
+func TestDatabaseService_DeleteWhiteboardSnapshot(t *testing.T) {
+	affected, err := s.DeleteWhiteboardSnapshot(whiteboardSnapshotId)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if affected == 0 {
+		t.Error("should delete snapshot but got no affected snapshot")
+	}
+}
@@ -70,9 +70,16 @@ func (s *NatsService) CreateSystemPrivateConsumer(roomId, userId string) (jwt.St
 }
 
 func (s *NatsService) CreateWhiteboardConsumer(roomId, userId string) (jwt.StringList, error) {
+	// if the whiteboard was restored from a snapshot,
+	// then the user will need all the events to get the same board
+	deliverPolicy := jetstream.DeliverNewPolicy
+	if snapshotId, _ := s.GetRoomWhiteboardSnapshotId(roomId); snapshotId != "" {
+		deliverPolicy = jetstream.DeliverAllPolicy
+	}
+
 	_, err := s.js.CreateOrUpdateConsumer(s.ctx, roomId, jetstream.ConsumerConfig{
 		Durable:       fmt.Sprintf("%s:%s", s.app.NatsInfo.Subjects.Whiteboard, userId),
-		DeliverPolicy: jetstream.DeliverNewPolicy,
+		DeliverPolicy: deliverPolicy,
 		FilterSubjects: []string{
 			fmt.Sprintf("%s:%s.>", roomId, s.app.NatsInfo.Subjects.Whiteboard),
 		},
@@ -0,0 +1,78 @@
+package natsservice
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+const (
+	// IngressChat & IngressDataChannel are the last token of the ingress worker subject
+	IngressChat        = "chat"
+	IngressDataChannel = "dataChannel"
+
+	roomIngressStream = Prefix + "ingress-%s-%s"
+	// ingressCheckedToken will be added after the userId of the checked messages
+	ingressCheckedToken = "checked"
+)
+
+// IngressWorkerSubject returns the subject where messages of the clients will be re-published
+// for the ingress worker. Use * as roomId & userId to get the wildcard subject
+func (s *NatsService) IngressWorkerSubject(roomId, userId, kind string) string {
+	return fmt.Sprintf("%s.%s.%s.%s", s.app.NatsInfo.Subjects.SystemJsWorker, roomId, userId, kind)
+}
+
+// isIngressEnabled checks if messages of the kind should be checked by the server
+func (s *NatsService) isIngressEnabled(kind string) bool {
+	switch kind {
+	case IngressChat:
+		return s.app.ChatModerationSettings != nil && s.app.ChatModerationSettings.Enabled
+	case IngressDataChannel:
+		return s.app.NatsInfo.RateLimit != nil && s.app.NatsInfo.RateLimit.Enabled
+	}
+	return false
+}
+
+func (s *NatsService) ingressSubject(kind string) string {
+	if kind == IngressDataChannel {
+		return s.app.NatsInfo.Subjects.DataChannel
+	}
+	return s.app.NatsInfo.Subjects.Chat
+}
+
+// createRoomIngressStream will capture messages which clients publish to the subject of the room.
+// Those will be re-published to the ingress worker & only the checked messages
+// will be stored in the room stream, so clients can keep using the same subject
+func (s *NatsService) createRoomIngressStream(roomId, kind string) error {
+	subject := fmt.Sprintf("%s:%s.*", roomId, s.ingressSubject(kind))
+	_, err := s.js.CreateOrUpdateStream(s.ctx, jetstream.StreamConfig{
+		Name:     fmt.Sprintf(roomIngressStream, kind, roomId),
+		Replicas: s.app.NatsInfo.NumReplicas,
+		Storage:  jetstream.MemoryStorage,
+		// messages are stored only to re-publish them
+		MaxAge:   time.Minute,
+		Subjects: []string{subject},
+		RePublish: &jetstream.RePublish{
+			Source:      subject,
+			Destination: s.IngressWorkerSubject(roomId, "{{wildcard(1)}}", kind),
+		},
+	})
+	return err
+}
+
+func (s *NatsService) deleteRoomIngressStreams(roomId string) error {
+	for _, kind := range []string{IngressChat, IngressDataChannel} {
+		err := s.js.DeleteStream(s.ctx, fmt.Sprintf(roomIngressStream, kind, roomId))
+		if err != nil && !errors.Is(err, jetstream.ErrStreamNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishCheckedMessage will publish the checked message to the room stream on behalf of the user
+func (s *NatsService) PublishCheckedMessage(roomId, userId, kind string, data []byte) error {
+	_, err := s.js.Publish(s.ctx, fmt.Sprintf("%s:%s.%s.%s", roomId, s.ingressSubject(kind), userId, ingressCheckedToken), data)
+	return err
+}
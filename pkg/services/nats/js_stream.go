@@ -1,21 +1,35 @@
 package natsservice
 
 import (
+	"errors"
 	"fmt"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"time"
 )
 
 func (s *NatsService) CreateRoomNatsStreams(roomId string) error {
+	subjects := []string{
+		fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.SystemPublic),
+		fmt.Sprintf("%s:%s.*.*", roomId, s.app.NatsInfo.Subjects.SystemPrivate),
+		fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.Whiteboard),
+	}
+	for _, kind := range []string{IngressChat, IngressDataChannel} {
+		subject := fmt.Sprintf("%s:%s.*", roomId, s.ingressSubject(kind))
+		if s.isIngressEnabled(kind) {
+			if err := s.createRoomIngressStream(roomId, kind); err != nil {
+				return err
+			}
+			// only the checked messages will be stored
+			subject += "." + ingressCheckedToken
+		}
+		subjects = append(subjects, subject)
+	}
+
 	_, err := s.js.CreateOrUpdateStream(s.ctx, jetstream.StreamConfig{
 		Name:     roomId,
 		Replicas: s.app.NatsInfo.NumReplicas,
-		Subjects: []string{
-			fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.Chat),
-			fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.SystemPublic),
-			fmt.Sprintf("%s:%s.*.*", roomId, s.app.NatsInfo.Subjects.SystemPrivate),
-			fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.Whiteboard),
-			fmt.Sprintf("%s:%s.*", roomId, s.app.NatsInfo.Subjects.DataChannel),
-		},
+		Subjects: subjects,
 	})
 	if err != nil {
 		return err
@@ -25,5 +39,68 @@ func (s *NatsService) CreateRoomNatsStreams(roomId string) error {
 }
 
 func (s *NatsService) DeleteRoomNatsStream(roomId string) error {
+	if err := s.deleteRoomIngressStreams(roomId); err != nil {
+		return err
+	}
 	return s.js.DeleteStream(s.ctx, roomId)
 }
+
+// GetRoomChatMessages will return all the chat messages stored in the room stream,
+// so it should be called before DeleteRoomNatsStream
+func (s *NatsService) GetRoomChatMessages(roomId string) ([][]byte, error) {
+	return s.fetchRoomStreamMsgs(roomId, fmt.Sprintf("%s:%s.>", roomId, s.app.NatsInfo.Subjects.Chat))
+}
+
+// GetRoomWhiteboardMessages will return all the whiteboard messages stored in the room stream,
+// so it should be called before DeleteRoomNatsStream
+func (s *NatsService) GetRoomWhiteboardMessages(roomId string) ([][]byte, error) {
+	return s.fetchRoomStreamMsgs(roomId, fmt.Sprintf("%s:%s.>", roomId, s.app.NatsInfo.Subjects.Whiteboard))
+}
+
+// PublishWhiteboardSnapshotEvents will restore the saved whiteboard events into the room stream.
+// The whiteboard consumers of the room will deliver them to the users on join
+func (s *NatsService) PublishWhiteboardSnapshotEvents(roomId string, events [][]byte) error {
+	sub := fmt.Sprintf("%s:%s.system", roomId, s.app.NatsInfo.Subjects.Whiteboard)
+	for _, e := range events {
+		if _, err := s.js.Publish(s.ctx, sub, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *NatsService) fetchRoomStreamMsgs(roomId, subject string) ([][]byte, error) {
+	cons, err := s.js.OrderedConsumer(s.ctx, roomId, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+	})
+	switch {
+	case errors.Is(err, jetstream.ErrStreamNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var list [][]byte
+	for {
+		msg, err := cons.Next(jetstream.FetchMaxWait(time.Second))
+		if errors.Is(err, nats.ErrTimeout) {
+			// nothing more to read
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		list = append(list, msg.Data())
+
+		meta, err := msg.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		if meta.NumPending == 0 {
+			break
+		}
+	}
+
+	return list, nil
+}
@@ -62,3 +62,14 @@ func (s *NatsService) GetRoomMetadataStruct(roomId string) (*plugnmeet.RoomMetad
 
 	return s.UnmarshalRoomMetadata(info.Metadata)
 }
+
+// GetRoomWhiteboardSnapshotId returns the id of the whiteboard snapshot used to restore the room,
+// empty if the room wasn't restored from any snapshot
+func (s *NatsService) GetRoomWhiteboardSnapshotId(roomId string) (string, error) {
+	kv, err := s.getKV(fmt.Sprintf(RoomInfoBucket, roomId))
+	if err != nil || kv == nil {
+		return "", err
+	}
+
+	return s.getStringValue(kv, RoomWhiteboardSnapshotKey)
+}
@@ -22,6 +22,8 @@ const (
 	RoomStatusKey       = "status"
 	RoomMetadataKey     = "metadata"
 	RoomCreatedKey      = "created_at"
+	// RoomWhiteboardSnapshotKey holds the snapshot id if the whiteboard was restored from it
+	RoomWhiteboardSnapshotKey = "whiteboard_snapshot_id"
 
 	RoomStatusCreated = "created"
 	RoomStatusActive  = "active"
@@ -145,6 +147,22 @@ func (s *NatsService) UpdateRoomStatus(roomId string, status string) error {
 	return nil
 }
 
+// SetRoomWhiteboardSnapshotId stores the id of the whiteboard snapshot used to restore the room
+func (s *NatsService) SetRoomWhiteboardSnapshotId(roomId, snapshotId string) error {
+	kv, err := s.js.KeyValue(s.ctx, fmt.Sprintf(RoomInfoBucket, roomId))
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		return fmt.Errorf("no room found with roomId: %s", roomId)
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := kv.PutString(s.ctx, RoomWhiteboardSnapshotKey, snapshotId); err != nil {
+		return fmt.Errorf("failed to update whiteboard snapshot id: %w", err)
+	}
+
+	return nil
+}
+
 // OnAfterSessionEndCleanup performs cleanup after a session ends
 func (s *NatsService) OnAfterSessionEndCleanup(roomId string) {
 	if err := s.DeleteRoom(roomId); err != nil {
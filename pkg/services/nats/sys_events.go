@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/mynaparrot/plugnmeet-protocol/plugnmeet"
+	"github.com/mynaparrot/plugnmeet-server/pkg/protocol"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 	"time"
@@ -105,3 +106,23 @@ func (s *NatsService) NotifyWarningMsg(roomId, msg string, withSound bool, userI
 func (s *NatsService) NotifyErrorMsg(roomId, msg string, userId *string) error {
 	return s.BroadcastSystemNotificationToRoom(roomId, msg, plugnmeet.NatsSystemNotificationTypes_NATS_SYSTEM_NOTIFICATION_ERROR, true, userId)
 }
+
+// BroadcastI18nNotificationToRoom will send the key with params so that the client can show it in the user's language
+func (s *NatsService) BroadcastI18nNotificationToRoom(roomId, key string, params map[string]string, msgType plugnmeet.NatsSystemNotificationTypes, withSound bool, userId *string) error {
+	msg, err := s.MarshalToProtoJson(&protocol.I18NNotificationMsg{
+		Key:    key,
+		Params: params,
+	})
+	if err != nil {
+		return err
+	}
+	return s.BroadcastSystemNotificationToRoom(roomId, msg, msgType, withSound, userId)
+}
+
+func (s *NatsService) NotifyI18nInfoMsg(roomId, key string, params map[string]string, withSound bool, userId *string) error {
+	return s.BroadcastI18nNotificationToRoom(roomId, key, params, plugnmeet.NatsSystemNotificationTypes_NATS_SYSTEM_NOTIFICATION_INFO, withSound, userId)
+}
+
+func (s *NatsService) NotifyI18nWarningMsg(roomId, key string, params map[string]string, withSound bool, userId *string) error {
+	return s.BroadcastI18nNotificationToRoom(roomId, key, params, plugnmeet.NatsSystemNotificationTypes_NATS_SYSTEM_NOTIFICATION_WARNING, withSound, userId)
+}
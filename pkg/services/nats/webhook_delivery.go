@@ -0,0 +1,188 @@
+package natsservice
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+const (
+	WebhookDeliveryStream    = Prefix + "webhookDelivery"
+	WebhookDeliveryLogStream = Prefix + "webhookDeliveryLog"
+	WebhookDeadLetterStream  = Prefix + "webhookDeadLetter"
+	// WebhookDeliveryNoSid will be used as subject token for events without any room session
+	WebhookDeliveryNoSid = "none"
+	// WebhookEndpointsChanged will be published after any change of the registered endpoints
+	WebhookEndpointsChanged = Prefix + "webhookEndpointsChanged"
+)
+
+// CreateWebhookDeliveryStreams will create the work queue for pending deliveries,
+// the stream to keep log of delivery attempts & the dead-letter stream
+func (s *NatsService) CreateWebhookDeliveryStreams(logMaxAge, deadLetterMaxAge time.Duration) (jetstream.Stream, error) {
+	stream, err := s.js.CreateOrUpdateStream(s.ctx, jetstream.StreamConfig{
+		Name:      WebhookDeliveryStream,
+		Replicas:  s.app.NatsInfo.NumReplicas,
+		Retention: jetstream.WorkQueuePolicy,
+		Subjects: []string{
+			fmt.Sprintf("%s.*", WebhookDeliveryStream),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.js.CreateOrUpdateStream(s.ctx, jetstream.StreamConfig{
+		Name:     WebhookDeliveryLogStream,
+		Replicas: s.app.NatsInfo.NumReplicas,
+		MaxAge:   logMaxAge,
+		Subjects: []string{
+			fmt.Sprintf("%s.*.*.*", WebhookDeliveryLogStream),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// dead letters will be removed after replay or when the limits are reached
+	_, err = s.js.CreateOrUpdateStream(s.ctx, jetstream.StreamConfig{
+		Name:     WebhookDeadLetterStream,
+		Replicas: s.app.NatsInfo.NumReplicas,
+		MaxAge:   deadLetterMaxAge,
+		MaxMsgs:  config.WebhookDeadLetterMaxMsgs,
+		Discard:  jetstream.DiscardOld,
+		Subjects: []string{
+			fmt.Sprintf("%s.*.*", WebhookDeadLetterStream),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// PublishWebhookDelivery will add the delivery in the work queue
+func (s *NatsService) PublishWebhookDelivery(deliveryId string, data []byte) error {
+	_, err := s.js.Publish(s.ctx, fmt.Sprintf("%s.%s", WebhookDeliveryStream, deliveryId), data, jetstream.WithMsgID(deliveryId))
+	return err
+}
+
+// AddWebhookDeliveryLog will store the current state of a delivery
+func (s *NatsService) AddWebhookDeliveryLog(roomSid, status, deliveryId string, data []byte) error {
+	_, err := s.js.Publish(s.ctx, fmt.Sprintf("%s.%s.%s.%s", WebhookDeliveryLogStream, webhookSidToken(roomSid), status, deliveryId), data)
+	return err
+}
+
+// DeleteWebhookDeliveryLogs will remove the log entries of the delivery with the status
+func (s *NatsService) DeleteWebhookDeliveryLogs(roomSid, status, deliveryId string) error {
+	stream, err := s.js.Stream(s.ctx, WebhookDeliveryLogStream)
+	if err != nil {
+		return err
+	}
+	return stream.Purge(s.ctx, jetstream.WithPurgeSubject(fmt.Sprintf("%s.%s.%s.%s", WebhookDeliveryLogStream, webhookSidToken(roomSid), status, deliveryId)))
+}
+
+// AddWebhookDeadLetter will store the delivery which has exhausted all attempts
+func (s *NatsService) AddWebhookDeadLetter(roomSid, deliveryId string, data []byte) error {
+	_, err := s.js.Publish(s.ctx, fmt.Sprintf("%s.%s.%s", WebhookDeadLetterStream, webhookSidToken(roomSid), deliveryId), data)
+	return err
+}
+
+// DeleteWebhookDeadLetter will remove the dead letter of the delivery
+func (s *NatsService) DeleteWebhookDeadLetter(roomSid, deliveryId string) error {
+	stream, err := s.js.Stream(s.ctx, WebhookDeadLetterStream)
+	if err != nil {
+		return err
+	}
+	return stream.Purge(s.ctx, jetstream.WithPurgeSubject(fmt.Sprintf("%s.%s.%s", WebhookDeadLetterStream, webhookSidToken(roomSid), deliveryId)))
+}
+
+// PublishWebhookEndpointsChanged will notify all the servers of the cluster,
+// so that they can reload the registered endpoints
+func (s *NatsService) PublishWebhookEndpointsChanged() error {
+	return s.nc.Publish(WebhookEndpointsChanged, nil)
+}
+
+// SubscribeWebhookEndpointsChanged will call the handler when endpoints were changed in any server
+func (s *NatsService) SubscribeWebhookEndpointsChanged(handler func()) (*nats.Subscription, error) {
+	return s.nc.Subscribe(WebhookEndpointsChanged, func(_ *nats.Msg) {
+		handler()
+	})
+}
+
+// GetWebhookDeliveryLogs will return the log entries of the room session between from & to
+// status is optional, empty value will return entries of all status
+func (s *NatsService) GetWebhookDeliveryLogs(roomSid, status string, from, to time.Time, limit int) ([][]byte, error) {
+	if status == "" {
+		status = "*"
+	}
+	return s.fetchWebhookDeliveryMsgs(WebhookDeliveryLogStream, fmt.Sprintf("%s.%s.%s.*", WebhookDeliveryLogStream, webhookSidToken(roomSid), status), from, to, limit)
+}
+
+// GetWebhookDeadLetters will return the dead letters of the room session between from & to
+func (s *NatsService) GetWebhookDeadLetters(roomSid string, from, to time.Time, limit int) ([][]byte, error) {
+	return s.fetchWebhookDeliveryMsgs(WebhookDeadLetterStream, fmt.Sprintf("%s.%s.*", WebhookDeadLetterStream, webhookSidToken(roomSid)), from, to, limit)
+}
+
+func (s *NatsService) fetchWebhookDeliveryMsgs(stream, subject string, from, to time.Time, limit int) ([][]byte, error) {
+	cons, err := s.js.OrderedConsumer(s.ctx, stream, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverByStartTimePolicy,
+		OptStartTime:   &from,
+	})
+	switch {
+	case errors.Is(err, jetstream.ErrStreamNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var list [][]byte
+	for len(list) < limit {
+		msg, err := cons.Next(jetstream.FetchMaxWait(time.Second))
+		if errors.Is(err, nats.ErrTimeout) {
+			// nothing more to read
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		meta, err := msg.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		if meta.Timestamp.After(to) {
+			break
+		}
+		list = append(list, msg.Data())
+
+		if meta.NumPending == 0 {
+			break
+		}
+	}
+
+	return list, nil
+}
+
+// webhookSidToken will return room sid as a single token of the subject.
+// Sid generated by livekit is always a valid token, anything else will be escaped,
+// so that characters like . * > can't change the subject
+func webhookSidToken(roomSid string) string {
+	if roomSid == "" {
+		return WebhookDeliveryNoSid
+	}
+	for _, c := range roomSid {
+		if !isWebhookSidTokenChar(c) {
+			return "~" + base64.RawURLEncoding.EncodeToString([]byte(roomSid))
+		}
+	}
+	return roomSid
+}
+
+func isWebhookSidTokenChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-'
+}
@@ -0,0 +1,29 @@
+package natsservice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWebhookSidToken(t *testing.T) {
+	tests := map[string]string{
+		"":             WebhookDeliveryNoSid,
+		"RM_abc123":    "RM_abc123",
+		"room-sid_123": "room-sid_123",
+	}
+	for sid, expected := range tests {
+		if token := webhookSidToken(sid); token != expected {
+			t.Errorf("sid %q: expected %q but got %q", sid, expected, token)
+		}
+	}
+
+	for _, sid := range []string{"RM.abc", "*", ">", "RM abc", "RM.*.>"} {
+		token := webhookSidToken(sid)
+		if strings.ContainsAny(token, ".*> \t") {
+			t.Errorf("sid %q: token %q is not a single subject token", sid, token)
+		}
+		if token == webhookSidToken(sid+"x") {
+			t.Errorf("sid %q: escaped token should be unique", sid)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package redisservice
 
 import (
+	"errors"
+	"github.com/redis/go-redis/v9"
 	"time"
 )
 
@@ -63,3 +65,32 @@ func (s *RedisService) AnalyticsDeleteKeys(allKeys []string) error {
 	}
 	return nil
 }
+
+// AnalyticsBreakoutRoomsKey holds breakout room id => parent room id
+// for the rooms which analytics should be collected under the parent room
+const AnalyticsBreakoutRoomsKey = Prefix + "analyticsBreakoutRooms"
+
+func (s *RedisService) AnalyticsAddBreakoutRoom(bkRoomId, parentRoomId string) error {
+	_, err := s.rc.HSet(s.ctx, AnalyticsBreakoutRoomsKey, bkRoomId, parentRoomId).Result()
+	return err
+}
+
+// AnalyticsGetBreakoutRoomParent will return empty if the room isn't a registered breakout room
+func (s *RedisService) AnalyticsGetBreakoutRoomParent(bkRoomId string) (string, error) {
+	parentRoomId, err := s.rc.HGet(s.ctx, AnalyticsBreakoutRoomsKey, bkRoomId).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+	return parentRoomId, nil
+}
+
+func (s *RedisService) AnalyticsDeleteBreakoutRooms(bkRoomIds ...string) error {
+	if len(bkRoomIds) == 0 {
+		return nil
+	}
+	_, err := s.rc.HDel(s.ctx, AnalyticsBreakoutRoomsKey, bkRoomIds...).Result()
+	return err
+}
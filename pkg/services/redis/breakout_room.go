@@ -0,0 +1,144 @@
+package redisservice
+
+import (
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"time"
+)
+
+const (
+	BreakoutRoomGroupingKey   = Prefix + "breakoutRoomGrouping"
+	BreakoutRoomSelfSelectKey = Prefix + "breakoutRoomSelfSelect"
+	BreakoutRoomSelectLockKey = Prefix + "breakoutRoomSelectLock"
+	BreakoutRoomReturnKey     = Prefix + "breakoutRoomReturn"
+
+	BreakoutRoomReturnAtField     = "return_at"
+	BreakoutRoomReturnEndAtField  = "end_at"
+	BreakoutRoomReturnWarnedField = "warned"
+	BreakoutRoomReturnSentField   = "tokens_sent"
+)
+
+// BreakoutRoomSaveGrouping will replace the previous grouping of the parent room.
+// grouping is a map of userId => breakout room id
+func (s *RedisService) BreakoutRoomSaveGrouping(roomId string, grouping map[string]string, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", BreakoutRoomGroupingKey, roomId)
+
+	pp := s.rc.TxPipeline()
+	pp.Del(s.ctx, key)
+	if len(grouping) > 0 {
+		pp.HSet(s.ctx, key, grouping)
+		pp.Expire(s.ctx, key, ttl)
+	}
+	_, err := pp.Exec(s.ctx)
+	return err
+}
+
+// BreakoutRoomGetGrouping will return userId => breakout room id of the last created breakout rooms
+func (s *RedisService) BreakoutRoomGetGrouping(roomId string) (map[string]string, error) {
+	return s.rc.HGetAll(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomGroupingKey, roomId)).Result()
+}
+
+func (s *RedisService) BreakoutRoomDeleteGrouping(roomId string) error {
+	_, err := s.rc.Del(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomGroupingKey, roomId)).Result()
+	return err
+}
+
+// BreakoutRoomSetUserGroup will update the group of a single user
+func (s *RedisService) BreakoutRoomSetUserGroup(roomId, userId, bkRoomId string, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", BreakoutRoomGroupingKey, roomId)
+
+	pp := s.rc.TxPipeline()
+	pp.HSet(s.ctx, key, userId, bkRoomId)
+	pp.Expire(s.ctx, key, ttl)
+	_, err := pp.Exec(s.ctx)
+	return err
+}
+
+// BreakoutRoomEnableSelfSelect will allow participants to pick a breakout room.
+// maxUsers 0 means no limit
+func (s *RedisService) BreakoutRoomEnableSelfSelect(roomId string, maxUsers int, ttl time.Duration) error {
+	_, err := s.rc.Set(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomSelfSelectKey, roomId), maxUsers, ttl).Result()
+	return err
+}
+
+// BreakoutRoomGetSelfSelect will return enabled false if self-selection isn't active for the room
+func (s *RedisService) BreakoutRoomGetSelfSelect(roomId string) (enabled bool, maxUsers int, err error) {
+	maxUsers, err = s.rc.Get(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomSelfSelectKey, roomId)).Int()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return false, 0, nil
+	case err != nil:
+		return false, 0, err
+	}
+
+	return true, maxUsers, nil
+}
+
+func (s *RedisService) BreakoutRoomDisableSelfSelect(roomId string) error {
+	_, err := s.rc.Del(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomSelfSelectKey, roomId)).Result()
+	return err
+}
+
+// BreakoutRoomLockSelection will return false if another selection is in progress for the parent room
+func (s *RedisService) BreakoutRoomLockSelection(roomId string, ttl time.Duration) (bool, error) {
+	return s.rc.SetNX(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomSelectLockKey, roomId), time.Now().Unix(), ttl).Result()
+}
+
+func (s *RedisService) BreakoutRoomUnlockSelection(roomId string) {
+	_, _ = s.rc.Del(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomSelectLockKey, roomId)).Result()
+}
+
+// BreakoutRoomReturnStart will schedule returning participants of the breakout rooms to the parent room.
+// returned false means a return is already in progress
+func (s *RedisService) BreakoutRoomReturnStart(roomId string, returnAt, endAt int64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s:%s", BreakoutRoomReturnKey, roomId)
+	ok, err := s.rc.HSetNX(s.ctx, key, BreakoutRoomReturnAtField, returnAt).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	pp := s.rc.TxPipeline()
+	pp.HSet(s.ctx, key, BreakoutRoomReturnEndAtField, endAt)
+	pp.Expire(s.ctx, key, ttl)
+	_, err = pp.Exec(s.ctx)
+	return true, err
+}
+
+// BreakoutRoomReturnGet will return nil if no return is in progress
+func (s *RedisService) BreakoutRoomReturnGet(roomId string) (map[string]string, error) {
+	result, err := s.rc.HGetAll(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomReturnKey, roomId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// BreakoutRoomReturnMark will return false if the field was already marked
+func (s *RedisService) BreakoutRoomReturnMark(roomId, field string) (bool, error) {
+	return s.rc.HSetNX(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomReturnKey, roomId), field, 1).Result()
+}
+
+// BreakoutRoomReturnFinish will return false if it was already finished
+func (s *RedisService) BreakoutRoomReturnFinish(roomId string) (bool, error) {
+	deleted, err := s.rc.Del(s.ctx, fmt.Sprintf("%s:%s", BreakoutRoomReturnKey, roomId)).Result()
+	return deleted > 0, err
+}
+
+// BreakoutRoomReturnGetRoomIds will return parent roomIds which have a return in progress
+func (s *RedisService) BreakoutRoomReturnGetRoomIds() ([]string, error) {
+	keys, err := s.rc.Keys(s.ctx, BreakoutRoomReturnKey+":*").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var roomIds []string
+	for _, k := range keys {
+		roomIds = append(roomIds, strings.TrimPrefix(k, BreakoutRoomReturnKey+":"))
+	}
+	return roomIds, nil
+}
@@ -0,0 +1,61 @@
+package redisservice
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	RateLimitBucketKey     = Prefix + "rateLimit:%s:%s:%s"
+	RateLimitViolationsKey = Prefix + "rateLimitViolations:%s:%s"
+)
+
+// token bucket, refilled based on the elapsed time since the last request
+const rateLimitTakeScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local data = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil or ts == nil then
+    tokens = burst
+    ts = now
+end
+tokens = math.min(burst, tokens + (math.max(0, now - ts) / 1000) * rate)
+local allowed = 0
+if tokens >= 1 then
+    tokens = tokens - 1
+    allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst / rate) * 1000) + 1000)
+return allowed
+`
+
+// RateLimitTake will try to take a token from the bucket of the user for the event.
+// rate is the number of tokens added per second & burst is the capacity of the bucket
+func (s *RedisService) RateLimitTake(roomId, userId, event string, rate float64, burst int) (bool, error) {
+	key := fmt.Sprintf(RateLimitBucketKey, roomId, userId, event)
+	allowed, err := s.rc.Eval(s.ctx, rateLimitTakeScript, []string{key}, rate, burst, time.Now().UnixMilli()).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return allowed == 1, nil
+}
+
+// RateLimitAddViolation will increase the number of violations of the user within the window
+// & return the updated count
+func (s *RedisService) RateLimitAddViolation(roomId, userId string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf(RateLimitViolationsKey, roomId, userId)
+
+	pp := s.rc.TxPipeline()
+	count := pp.Incr(s.ctx, key)
+	pp.ExpireNX(s.ctx, key, window)
+	if _, err := pp.Exec(s.ctx); err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
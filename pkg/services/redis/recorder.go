@@ -0,0 +1,40 @@
+package redisservice
+
+import (
+	"time"
+)
+
+// RecorderPendingStartsKey holds the start requests which were sent to the recorders
+// but haven't been confirmed yet, so the recorders haven't counted those in their progress
+const RecorderPendingStartsKey = Prefix + "recorderPendingStarts"
+
+// remove expired requests first, the same request will only refresh the expiry.
+// otherwise a slot will be reserved only if the pending requests are less than free slots
+const recorderReservePendingStartScript = `
+local now = tonumber(ARGV[1])
+local expireAt = tonumber(ARGV[2])
+local free = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now)
+if redis.call("ZSCORE", KEYS[1], ARGV[4]) == false and redis.call("ZCARD", KEYS[1]) >= free then
+    return 0
+end
+redis.call("ZADD", KEYS[1], expireAt, ARGV[4])
+return 1
+`
+
+// RecorderReservePendingStart will atomically reserve a slot for the start request if any free slot is left.
+// The request will be kept as pending until it was removed or the ttl passed
+func (s *RedisService) RecorderReservePendingStart(id string, freeSlots int64, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	reserved, err := s.rc.Eval(s.ctx, recorderReservePendingStartScript, []string{RecorderPendingStartsKey}, now.Unix(), now.Add(ttl).Unix(), freeSlots, id).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return reserved == 1, nil
+}
+
+func (s *RedisService) RecorderRemovePendingStart(id string) error {
+	_, err := s.rc.ZRem(s.ctx, RecorderPendingStartsKey, id).Result()
+	return err
+}
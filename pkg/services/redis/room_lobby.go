@@ -0,0 +1,82 @@
+package redisservice
+
+import (
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"time"
+)
+
+const (
+	RoomLobbyKey      = Prefix + "roomLobby"
+	RoomLobbyUsersKey = Prefix + "roomLobbyUsers"
+)
+
+// RoomLobbyEnable will hold non-admin users of the room until the deadline
+func (s *RedisService) RoomLobbyEnable(roomId string, deadline int64, ttl time.Duration) error {
+	_, err := s.rc.Set(s.ctx, fmt.Sprintf("%s:%s", RoomLobbyKey, roomId), deadline, ttl).Result()
+	return err
+}
+
+// RoomLobbyGetDeadline will return 0 if the lobby isn't active for the room
+func (s *RedisService) RoomLobbyGetDeadline(roomId string) (int64, error) {
+	deadline, err := s.rc.Get(s.ctx, fmt.Sprintf("%s:%s", RoomLobbyKey, roomId)).Int64()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	return deadline, nil
+}
+
+func (s *RedisService) RoomLobbyAddUser(roomId, userId string, ttl time.Duration) error {
+	key := fmt.Sprintf("%s:%s", RoomLobbyUsersKey, roomId)
+
+	pp := s.rc.TxPipeline()
+	pp.SAdd(s.ctx, key, userId)
+	pp.Expire(s.ctx, key, ttl)
+	_, err := pp.Exec(s.ctx)
+	return err
+}
+
+func (s *RedisService) RoomLobbyIsUserHeld(roomId, userId string) (bool, error) {
+	return s.rc.SIsMember(s.ctx, fmt.Sprintf("%s:%s", RoomLobbyUsersKey, roomId), userId).Result()
+}
+
+func (s *RedisService) RoomLobbyGetUsers(roomId string) ([]string, error) {
+	return s.rc.SMembers(s.ctx, fmt.Sprintf("%s:%s", RoomLobbyUsersKey, roomId)).Result()
+}
+
+// RoomLobbyRelease will close the lobby & return the held users.
+// released will be false if the lobby was already closed
+func (s *RedisService) RoomLobbyRelease(roomId string) (released bool, userIds []string, err error) {
+	key := fmt.Sprintf("%s:%s", RoomLobbyKey, roomId)
+	usersKey := fmt.Sprintf("%s:%s", RoomLobbyUsersKey, roomId)
+
+	pp := s.rc.TxPipeline()
+	del := pp.Del(s.ctx, key)
+	members := pp.SMembers(s.ctx, usersKey)
+	pp.Del(s.ctx, usersKey)
+	if _, err = pp.Exec(s.ctx); err != nil {
+		return false, nil, err
+	}
+
+	return del.Val() > 0, members.Val(), nil
+}
+
+// RoomLobbyGetRoomIds will return roomIds which have an active lobby
+func (s *RedisService) RoomLobbyGetRoomIds() ([]string, error) {
+	keys, err := s.rc.Keys(s.ctx, RoomLobbyKey+":*").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var roomIds []string
+	for _, k := range keys {
+		roomIds = append(roomIds, strings.TrimPrefix(k, RoomLobbyKey+":"))
+	}
+	return roomIds, nil
+}
@@ -0,0 +1,71 @@
+package redisservice
+
+import (
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strings"
+)
+
+const WaitingRoomQueueKey = Prefix + "waitingRoomQueue"
+
+// WaitingRoomAddUser will add the user at the end of the queue,
+// the position of an existing user won't be changed
+func (s *RedisService) WaitingRoomAddUser(roomId, userId string, joinedAt int64) error {
+	_, err := s.rc.ZAddNX(s.ctx, fmt.Sprintf("%s:%s", WaitingRoomQueueKey, roomId), redis.Z{
+		Score:  float64(joinedAt),
+		Member: userId,
+	}).Result()
+	return err
+}
+
+// WaitingRoomRemoveUser will remove the user from the queue
+// & return the time when the user was added, 0 if not found
+func (s *RedisService) WaitingRoomRemoveUser(roomId, userId string) (int64, error) {
+	key := fmt.Sprintf("%s:%s", WaitingRoomQueueKey, roomId)
+	score, err := s.rc.ZScore(s.ctx, key, userId).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	if _, err = s.rc.ZRem(s.ctx, key, userId).Result(); err != nil {
+		return 0, err
+	}
+
+	return int64(score), nil
+}
+
+// WaitingRoomGetQueue will return users of the queue in FIFO order
+func (s *RedisService) WaitingRoomGetQueue(roomId string) ([]redis.Z, error) {
+	return s.rc.ZRangeWithScores(s.ctx, fmt.Sprintf("%s:%s", WaitingRoomQueueKey, roomId), 0, -1).Result()
+}
+
+// WaitingRoomGetUsersAddedBefore will return users of the queue who were added before the time
+func (s *RedisService) WaitingRoomGetUsersAddedBefore(roomId string, before int64) ([]string, error) {
+	return s.rc.ZRangeByScore(s.ctx, fmt.Sprintf("%s:%s", WaitingRoomQueueKey, roomId), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: fmt.Sprintf("(%d", before),
+	}).Result()
+}
+
+// WaitingRoomGetQueueRoomIds will return roomIds which have a waiting queue
+func (s *RedisService) WaitingRoomGetQueueRoomIds() ([]string, error) {
+	keys, err := s.rc.Keys(s.ctx, WaitingRoomQueueKey+":*").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var roomIds []string
+	for _, k := range keys {
+		roomIds = append(roomIds, strings.TrimPrefix(k, WaitingRoomQueueKey+":"))
+	}
+	return roomIds, nil
+}
+
+func (s *RedisService) WaitingRoomDeleteQueue(roomId string) error {
+	_, err := s.rc.Del(s.ctx, fmt.Sprintf("%s:%s", WaitingRoomQueueKey, roomId)).Result()
+	return err
+}
@@ -0,0 +1,162 @@
+package storageservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// LocalStorage stores files in a local or NFS directory
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) *LocalStorage {
+	return &LocalStorage{
+		root: root,
+	}
+}
+
+// resolve will make sure that the key can't go outside the root
+func (s *LocalStorage) resolve(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+func (s *LocalStorage) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	file := s.resolve(key)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = os.Remove(file)
+		return err
+	}
+	return f.Close()
+}
+
+func (s *LocalStorage) Get(_ context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	f, err := os.Open(s.resolve(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, err
+	}
+	if stat.IsDir() {
+		_ = f.Close()
+		return nil, nil, ErrNotFound
+	}
+
+	return f, s.objectInfo(key, stat), nil
+}
+
+func (s *LocalStorage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, *ObjectInfo, error) {
+	rd, info, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := rd.(*os.File)
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, nil, err
+	}
+
+	return &limitedReadCloser{Reader: io.LimitReader(f, length), Closer: f}, info, nil
+}
+
+func (s *LocalStorage) Stat(_ context.Context, key string) (*ObjectInfo, error) {
+	stat, err := os.Stat(s.resolve(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, ErrNotFound
+	}
+
+	return s.objectInfo(key, stat), nil
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(s.resolve(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) DeletePrefix(_ context.Context, prefix string) error {
+	dir := s.resolve(prefix)
+	if dir == filepath.Clean(s.root) {
+		return errors.New("empty prefix")
+	}
+	return os.RemoveAll(dir)
+}
+
+func (s *LocalStorage) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.resolve(prefix), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *LocalStorage) PresignedURL(context.Context, string, time.Duration, string, bool) (string, error) {
+	return "", nil
+}
+
+func (s *LocalStorage) LocalPath(key string) string {
+	return s.resolve(key)
+}
+
+func (s *LocalStorage) objectInfo(key string, stat os.FileInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ModTime:     stat.ModTime(),
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		ETag:        fmt.Sprintf(`"%x-%x"`, stat.ModTime().UnixNano(), stat.Size()),
+	}
+}
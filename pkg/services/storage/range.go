@@ -0,0 +1,56 @@
+package storageservice
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidRange = errors.New("requested range not satisfiable")
+	// ErrUnsupportedRange means the range header can be ignored & the full file can be sent
+	ErrUnsupportedRange = errors.New("unsupported range")
+)
+
+// ParseByteRange parses a single byte range of the Range header,
+// e.g. bytes=0-99, bytes=100- or bytes=-100. Multiple ranges aren't supported
+func ParseByteRange(header string, size int64) (start, length int64, err error) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, ErrUnsupportedRange
+	}
+	from, to, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return 0, 0, ErrInvalidRange
+	}
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+
+	if from == "" {
+		// suffix range, the last n bytes
+		n, err := strconv.ParseInt(to, 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return 0, 0, ErrInvalidRange
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, nil
+	}
+
+	start, err = strconv.ParseInt(from, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, ErrInvalidRange
+	}
+	end := size - 1
+	if to != "" {
+		end, err = strconv.ParseInt(to, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, ErrInvalidRange
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+
+	return start, end - start + 1, nil
+}
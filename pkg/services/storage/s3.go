@@ -0,0 +1,246 @@
+package storageservice
+
+import (
+	"context"
+	"fmt"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// s3PartSize is the size of each part of the multipart upload,
+// files bigger than this will be uploaded using multipart
+const s3PartSize = 16 << 20
+
+// S3Storage stores files in an S3 compatible object storage, e.g. AWS S3, MinIO
+type S3Storage struct {
+	client    *minio.Client
+	endpoint  string
+	bucket    string
+	accessKey string
+	// prefix will be added before all the keys, including the area
+	prefix string
+}
+
+func NewS3Storage(cnf *config.S3StorageSettings, area string) (*S3Storage, error) {
+	endpoint := cnf.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("s3.%s.amazonaws.com", cnf.Region)
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := minio.BucketLookupDNS
+	if cnf.UsePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(cnf.AccessKey, cnf.SecretKey, ""),
+		Secure:       u.Scheme == "https",
+		Region:       cnf.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(cnf.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	if area != "" {
+		prefix += area + "/"
+	}
+
+	return &S3Storage{
+		client:    client,
+		endpoint:  u.Host,
+		bucket:    cnf.Bucket,
+		accessKey: cnf.AccessKey,
+		prefix:    prefix,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if size <= 0 {
+		// unknown size, it will be uploaded using multipart
+		size = -1
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, s.objectKey(key), r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    s3PartSize,
+		// the connection is already protected by TLS
+		DisableContentSha256: true,
+	})
+	return s.toError(err)
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectKey(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, s.toError(err)
+	}
+	// the request will be sent during Stat
+	st, err := obj.Stat()
+	if err != nil {
+		_ = obj.Close()
+		return nil, nil, s.toError(err)
+	}
+	return obj, s.objectInfo(key, st), nil
+}
+
+func (s *S3Storage) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, *ObjectInfo, error) {
+	// size of the ranged response is the size of the part only
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := minio.GetObjectOptions{}
+	if err = opts.SetRange(offset, offset+length-1); err != nil {
+		return nil, nil, err
+	}
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectKey(key), opts)
+	if err != nil {
+		return nil, nil, s.toError(err)
+	}
+	return obj, info, nil
+}
+
+func (s *S3Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	st, err := s.client.StatObject(ctx, s.bucket, s.objectKey(key), minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s.toError(err)
+	}
+	return s.objectInfo(key, st), nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	err := s.toError(s.client.RemoveObject(ctx, s.bucket, s.objectKey(key), minio.RemoveObjectOptions{}))
+	if err == ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+func (s *S3Storage) DeletePrefix(ctx context.Context, prefix string) error {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return fmt.Errorf("empty prefix")
+	}
+
+	objects := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    s.objectKey(prefix) + "/",
+		Recursive: true,
+	})
+	for e := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if err := s.toError(e.Err); err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]string, error) {
+	objectPrefix := s.prefix
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		objectPrefix = s.objectKey(prefix) + "/"
+	}
+
+	var keys []string
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    objectPrefix,
+		Recursive: true,
+	}) {
+		if obj.Err != nil {
+			return nil, s.toError(obj.Err)
+		}
+		keys = append(keys, strings.TrimPrefix(obj.Key, s.prefix))
+	}
+	// objects are already returned in ascending order of the key
+	return keys, nil
+}
+
+func (s *S3Storage) PresignedURL(ctx context.Context, key string, expiry time.Duration, filename string, inline bool) (string, error) {
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+	if filename != "" {
+		disposition += "; filename=" + strconv.Quote(filename)
+	}
+	params := url.Values{}
+	params.Set("response-content-disposition", disposition)
+
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, s.objectKey(key), expiry, params)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *S3Storage) LocalPath(string) string {
+	return ""
+}
+
+func (s *S3Storage) objectKey(key string) string {
+	return s.prefix + strings.TrimPrefix(key, "/")
+}
+
+func (s *S3Storage) sameBucket(o *S3Storage) bool {
+	return s.endpoint == o.endpoint && s.bucket == o.bucket && s.accessKey == o.accessKey
+}
+
+// copyObject will copy the object inside the same bucket,
+// srcObjectKey must be the full key including the prefix
+func (s *S3Storage) copyObject(ctx context.Context, srcObjectKey, dstKey string) error {
+	_, err := s.client.CopyObject(ctx, minio.CopyDestOptions{
+		Bucket: s.bucket,
+		Object: s.objectKey(dstKey),
+	}, minio.CopySrcOptions{
+		Bucket: s.bucket,
+		Object: srcObjectKey,
+	})
+	return s.toError(err)
+}
+
+func (s *S3Storage) objectInfo(key string, st minio.ObjectInfo) *ObjectInfo {
+	info := &ObjectInfo{
+		Key:         key,
+		Size:        st.Size,
+		ModTime:     st.LastModified,
+		ContentType: st.ContentType,
+	}
+	if st.ETag != "" {
+		// minio removes the quotes, but we'll use it as header
+		info.ETag = strconv.Quote(st.ETag)
+	}
+	return info
+}
+
+// toError will convert the not found response of minio to ErrNotFound
+func (s *S3Storage) toError(err error) error {
+	if err == nil {
+		return nil
+	}
+	res := minio.ToErrorResponse(err)
+	if res.Code == "NoSuchKey" || (res.StatusCode == http.StatusNotFound && res.Code != "NoSuchBucket") {
+		return ErrNotFound
+	}
+	return err
+}
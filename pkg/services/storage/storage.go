@@ -0,0 +1,137 @@
+package storageservice
+
+import (
+	"context"
+	"errors"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	AreaRecordings      = "recordings"
+	AreaRecordingBackup = "recordings_backup"
+	AreaUploads         = "uploads"
+	AreaAnalytics       = "analytics"
+	AreaWhiteboardSnaps = "whiteboard_snapshots"
+	AreaChatArchives    = "chat_archives"
+)
+
+var ErrNotFound = errors.New("file not found")
+
+// ObjectInfo is the common information of a stored file
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ModTime     time.Time
+	ContentType string
+	ETag        string
+}
+
+// Storage is used to store recordings, uploaded & analytics files.
+// Keys are always relative & slash separated, e.g. sub_path/roomSid/filename
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// GetRange will return the reader of the part of the file,
+	// Size of the ObjectInfo is always the size of the full file
+	GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, *ObjectInfo, error)
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete won't return any error if the file doesn't exist
+	Delete(ctx context.Context, key string) error
+	DeletePrefix(ctx context.Context, prefix string) error
+	// List will return keys of all the files under the prefix, sorted by key
+	List(ctx context.Context, prefix string) ([]string, error)
+	// PresignedURL will return empty if the storage doesn't support it,
+	// the file will be served as inline instead of attachment if inline is true
+	PresignedURL(ctx context.Context, key string, expiry time.Duration, filename string, inline bool) (string, error)
+	// LocalPath will return the full path of the file
+	// or empty if the storage isn't the local filesystem
+	LocalPath(key string) string
+}
+
+// New will return the storage of the area based on storage_settings,
+// the local filesystem will be used as default
+func New(app *config.AppConfig, area string) Storage {
+	if app.StorageSettings.Type == config.StorageTypeS3 {
+		s, err := NewS3Storage(&app.StorageSettings.S3, area)
+		if err != nil {
+			log.Fatalln("invalid s3 storage settings:", err)
+		}
+		return s
+	}
+	return NewLocalStorage(localRoot(app, area))
+}
+
+// IsLocal checks if the storage is the local filesystem
+func IsLocal(s Storage) bool {
+	_, ok := s.(*LocalStorage)
+	return ok
+}
+
+// Move will move the file from one storage to another one.
+// For the same kind of storage it will avoid downloading the file
+func Move(ctx context.Context, src Storage, srcKey string, dst Storage, dstKey string) error {
+	if from, to := src.LocalPath(srcKey), dst.LocalPath(dstKey); from != "" && to != "" {
+		if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			return err
+		}
+		err := os.Rename(from, to)
+		if os.IsNotExist(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if from, ok := src.(*S3Storage); ok {
+		if to, ok := dst.(*S3Storage); ok && to.sameBucket(from) {
+			if err := to.copyObject(ctx, from.objectKey(srcKey), dstKey); err != nil {
+				return err
+			}
+			return src.Delete(ctx, srcKey)
+		}
+	}
+
+	rd, info, err := src.Get(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	if err = dst.Put(ctx, dstKey, rd, info.Size, info.ContentType); err != nil {
+		return err
+	}
+	return src.Delete(ctx, srcKey)
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func localRoot(app *config.AppConfig, area string) string {
+	switch area {
+	case AreaRecordings:
+		return app.RecorderInfo.RecordingFilesPath
+	case AreaRecordingBackup:
+		return app.RecorderInfo.DelRecordingBackupPath
+	case AreaUploads:
+		return app.UploadFileSettings.Path
+	case AreaAnalytics:
+		if app.AnalyticsSettings != nil && app.AnalyticsSettings.FilesStorePath != nil {
+			return *app.AnalyticsSettings.FilesStorePath
+		}
+	case AreaWhiteboardSnaps:
+		if app.WhiteboardSnapshotSettings != nil && app.WhiteboardSnapshotSettings.StorePath != nil {
+			return *app.WhiteboardSnapshotSettings.StorePath
+		}
+	case AreaChatArchives:
+		if app.ChatArchiveSettings != nil && app.ChatArchiveSettings.FilesStorePath != nil {
+			return *app.ChatArchiveSettings.FilesStorePath
+		}
+	}
+	return ""
+}
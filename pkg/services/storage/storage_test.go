@@ -0,0 +1,320 @@
+package storageservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/mynaparrot/plugnmeet-server/pkg/config"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a minimal stand-in of an S3 compatible server using path style
+type fakeS3 struct {
+	sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>"))
+		return
+	}
+	f.Lock()
+	defer f.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/"+f.bucket+"/")
+	switch r.Method {
+	case http.MethodPut:
+		if src := r.Header.Get("X-Amz-Copy-Source"); src != "" {
+			src, _ = url.PathUnescape(src)
+			data, ok := f.objects[strings.TrimPrefix(strings.TrimPrefix(src, "/"), f.bucket+"/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			f.objects[key] = data
+			_, _ = w.Write([]byte("<CopyObjectResult></CopyObjectResult>"))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case http.MethodGet, http.MethodHead:
+		if r.URL.Query().Get("list-type") == "2" {
+			var keys []string
+			for k := range f.objects {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			var b strings.Builder
+			b.WriteString("<ListBucketResult><IsTruncated>false</IsTruncated>")
+			for _, k := range keys {
+				b.WriteString("<Contents><Key>" + k + "</Key></Contents>")
+			}
+			b.WriteString("</ListBucketResult>")
+			_, _ = w.Write([]byte(b.String()))
+			return
+		}
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, len(data)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		if rg := r.Header.Get("Range"); rg != "" {
+			var start, end int
+			_, _ = fmt.Sscanf(rg, "bytes=%d-%d", &start, &end)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			w.Header().Set("Content-Length", fmt.Sprintf("%d", end-start+1))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(data[start : end+1])
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodPost:
+		if _, ok := r.URL.Query()["delete"]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		req := new(struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		})
+		if err := xml.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, o := range req.Objects {
+			delete(f.objects, o.Key)
+		}
+		_, _ = w.Write([]byte("<DeleteResult></DeleteResult>"))
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTestS3(t *testing.T, area string) (*S3Storage, *fakeS3) {
+	f := &fakeS3{
+		bucket:  "plugnmeet",
+		objects: make(map[string][]byte),
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	s, err := NewS3Storage(newTestS3Settings(srv.URL, f.bucket), area)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, f
+}
+
+func newTestS3Settings(endpoint, bucket string) *config.S3StorageSettings {
+	return &config.S3StorageSettings{
+		Endpoint:     endpoint,
+		Region:       "us-east-1",
+		Bucket:       bucket,
+		AccessKey:    "access",
+		SecretKey:    "secret",
+		UsePathStyle: true,
+	}
+}
+
+func testStorage(t *testing.T, s Storage) {
+	ctx := context.Background()
+	content := []byte("recording data")
+
+	err := s.Put(ctx, "sub/room sid/file.mp4", bytes.NewReader(content), int64(len(content)), "video/mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := s.Stat(ctx, "sub/room sid/file.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(content)) || info.ETag == "" {
+		t.Errorf("unexpected info: %+v", info)
+	}
+
+	rd, info, err := s.Get(ctx, "sub/room sid/file.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(rd)
+	_ = rd.Close()
+	if !bytes.Equal(data, content) || info.Size != int64(len(content)) {
+		t.Errorf("expected %s but got %s", content, data)
+	}
+
+	rd, info, err = s.GetRange(ctx, "sub/room sid/file.mp4", 2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ = io.ReadAll(rd)
+	_ = rd.Close()
+	if string(data) != "cord" || info.Size != int64(len(content)) {
+		t.Errorf("expected cord with size %d but got %s with size %d", len(content), data, info.Size)
+	}
+
+	if _, err = s.Stat(ctx, "sub/missing.mp4"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound but got %v", err)
+	}
+
+	_ = s.Put(ctx, "sub/a.json", bytes.NewReader(content), int64(len(content)), "")
+	_ = s.Put(ctx, "other/b.json", bytes.NewReader(content), int64(len(content)), "")
+	keys, err := s.List(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(keys, ",") != "sub/a.json,sub/room sid/file.mp4" {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+	if keys, _ = s.List(ctx, "missing"); len(keys) != 0 {
+		t.Errorf("expected empty list but got %v", keys)
+	}
+
+	if err = s.DeletePrefix(ctx, "sub"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Stat(ctx, "sub/room sid/file.mp4"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound after delete but got %v", err)
+	}
+	if err = s.Delete(ctx, "sub/room sid/file.mp4"); err != nil {
+		t.Errorf("delete of missing file should not return error but got %v", err)
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	testStorage(t, NewLocalStorage(t.TempDir()))
+}
+
+func TestLocalStorage_LocalPath(t *testing.T) {
+	root := t.TempDir()
+	s := NewLocalStorage(root)
+
+	if p := s.LocalPath("../../etc/passwd"); !strings.HasPrefix(p, root) {
+		t.Errorf("path should be inside the root but got %s", p)
+	}
+	if err := s.DeletePrefix(context.Background(), "../"); err == nil {
+		t.Error("should not allow to delete the root")
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	s, f := newTestS3(t, AreaRecordings)
+	testStorage(t, s)
+
+	_ = s.Put(context.Background(), "file.mp4", strings.NewReader("data"), 4, "")
+	if _, ok := f.objects[AreaRecordings+"/file.mp4"]; !ok {
+		t.Errorf("key should contain the area, got %v", f.objects)
+	}
+}
+
+func TestS3Storage_PresignedURL(t *testing.T) {
+	s, _ := newTestS3(t, AreaRecordings)
+
+	u, err := s.PresignedURL(context.Background(), "sub/file.mp4", time.Minute*5, "file.mp4", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"/plugnmeet/recordings/sub/file.mp4?", "X-Amz-Expires=300", "X-Amz-Signature=", "response-content-disposition=attachment"} {
+		if !strings.Contains(u, v) {
+			t.Errorf("%s should contain %s", u, v)
+		}
+	}
+
+	u, err = s.PresignedURL(context.Background(), "sub/file.mp4", time.Minute*5, "file.mp4", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(u, "response-content-disposition=inline") {
+		t.Errorf("%s should contain inline disposition", u)
+	}
+}
+
+func TestMove(t *testing.T) {
+	ctx := context.Background()
+
+	// local to local
+	src, dst := NewLocalStorage(t.TempDir()), NewLocalStorage(t.TempDir())
+	_ = src.Put(ctx, "sub/file.mp4", strings.NewReader("data"), 4, "")
+	if err := Move(ctx, src, "sub/file.mp4", dst, "file.mp4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dst.root, "file.mp4")); err != nil {
+		t.Error(err)
+	}
+
+	// s3 to s3 in the same bucket
+	s3Src, f := newTestS3(t, AreaRecordings)
+	s3Dst, err := NewS3Storage(newTestS3Settings("http://"+s3Src.endpoint, s3Src.bucket), AreaRecordingBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = s3Src.Put(ctx, "sub/file.mp4", strings.NewReader("data"), 4, "")
+	if err := Move(ctx, s3Src, "sub/file.mp4", s3Dst, "file.mp4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.objects[AreaRecordingBackup+"/file.mp4"]; !ok {
+		t.Errorf("file should be moved, got %v", f.objects)
+	}
+	if _, ok := f.objects[AreaRecordings+"/sub/file.mp4"]; ok {
+		t.Error("source file should be deleted")
+	}
+
+	// s3 to local
+	_ = s3Src.Put(ctx, "file.mp4", strings.NewReader("data"), 4, "")
+	if err := Move(ctx, s3Src, "file.mp4", dst, "from_s3.mp4"); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dst.root, "from_s3.mp4")); string(data) != "data" {
+		t.Errorf("expected data but got %s", data)
+	}
+}
+
+func TestParseByteRange(t *testing.T) {
+	tests := []struct {
+		header        string
+		start, length int64
+		err           error
+	}{
+		{"bytes=0-99", 0, 100, nil},
+		{"bytes=100-", 100, 900, nil},
+		{"bytes=-100", 900, 100, nil},
+		{"bytes=900-2000", 900, 100, nil},
+		{"bytes=-2000", 0, 1000, nil},
+		{"bytes=1000-", 0, 0, ErrInvalidRange},
+		{"bytes=50-10", 0, 0, ErrInvalidRange},
+		{"bytes=abc", 0, 0, ErrInvalidRange},
+		{"bytes=0-1,5-6", 0, 0, ErrUnsupportedRange},
+		{"items=0-1", 0, 0, ErrUnsupportedRange},
+	}
+
+	for _, tt := range tests {
+		start, length, err := ParseByteRange(tt.header, 1000)
+		if !errors.Is(err, tt.err) || start != tt.start || length != tt.length {
+			t.Errorf("%s: expected %d, %d, %v but got %d, %d, %v", tt.header, tt.start, tt.length, tt.err, start, length, err)
+		}
+	}
+}
@@ -16,32 +16,48 @@ CREATE TABLE IF NOT EXISTS `pnm_room_info` (
   `webhook_url` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
   `is_breakout_room` int(1) NOT NULL DEFAULT 0,
   `parent_room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `chat_archive` int(1) NOT NULL DEFAULT 0,
+  `metadata` text COLLATE utf8mb4_unicode_ci DEFAULT NULL,
   `creation_time` int(10) NOT NULL DEFAULT 0,
   `created` datetime NOT NULL DEFAULT current_timestamp(),
-  `ended` datetime NOT NULL DEFAULT '0000-00-00 00:00:00',
+  `ended` datetime NOT NULL DEFAULT '1970-01-01 00:00:00',
   `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
   PRIMARY KEY (`id`),
   UNIQUE KEY `sid` (`sid`),
   KEY `roomId` (`roomId`),
-  KEY `is_running_roomId` (`is_running`, `roomId`)
+  KEY `is_running_roomId` (`is_running`, `roomId`),
+  KEY `series_id` (`series_id`),
+  KEY `tenant_id_is_running` (`tenant_id`, `is_running`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
 CREATE TABLE IF NOT EXISTS `pnm_recordings` (
   `id` int(11) NOT NULL AUTO_INCREMENT,
   `record_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
   `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `parent_room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
   `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci DEFAULT NULL,
   `recorder_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL,
   `file_path` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
   `size` double NOT NULL,
   `published` int(1) NOT NULL DEFAULT 1,
+  `title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `description` text COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+  `metadata` text COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+  `retention_days` int(10) NOT NULL DEFAULT 0,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
   `creation_time` int(10) NOT NULL DEFAULT 0,
   `room_creation_time` int(10) NOT NULL DEFAULT 0,
   `created` datetime NOT NULL DEFAULT current_timestamp(),
   `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  `deleted_at` datetime DEFAULT NULL,
   PRIMARY KEY (`id`),
   UNIQUE KEY `record_id` (`record_id`),
   KEY `room_id` (`room_id`),
+  KEY `parent_room_id` (`parent_room_id`),
+  KEY `tenant_id` (`tenant_id`),
+  KEY `deleted_at` (`deleted_at`),
   FOREIGN KEY (room_sid) REFERENCES `pnm_room_info` (sid)
      ON DELETE SET NULL
      ON UPDATE CASCADE
@@ -56,10 +72,174 @@ CREATE TABLE IF NOT EXISTS `pnm_room_analytics` (
   `file_size` double UNSIGNED NOT NULL,
   `room_creation_time` int(11) NOT NULL,
   `creation_time` int(11) NOT NULL,
+  `tenant_id` varchar(64) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`),
   KEY `room_id` (`room_id`),
   KEY `file_id` (`file_id`),
+  KEY `tenant_id` (`tenant_id`),
   FOREIGN KEY (room_table_id) REFERENCES `pnm_room_info` (id)
      ON DELETE SET NULL
      ON UPDATE CASCADE
  ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+CREATE TABLE IF NOT EXISTS `pnm_scheduled_rooms` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `schedule_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `start_at` int(11) NOT NULL,
+  `create_room_req` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `create_room_opts` varchar(1000) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `status` int(1) NOT NULL DEFAULT 0,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `error_msg` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `schedule_id` (`schedule_id`),
+  KEY `room_id` (`room_id`),
+  KEY `series_id` (`series_id`),
+  KEY `tenant_id` (`tenant_id`),
+  KEY `status_start_at` (`status`, `start_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_scheduled_room_series` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `series_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `recurrence_rule` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `timezone` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'UTC',
+  `start_at` int(11) NOT NULL,
+  `occurrences` int(10) NOT NULL DEFAULT 0,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `create_room_req` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `create_room_opts` varchar(1000) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `status` int(1) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series_id` (`series_id`),
+  KEY `room_id` (`room_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_webhook_endpoints` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `endpoint_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `url` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `secret` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `events` text COLLATE utf8mb4_unicode_ci NOT NULL,
+  `is_active` int(1) NOT NULL DEFAULT 1,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `endpoint_id` (`endpoint_id`),
+  KEY `is_active` (`is_active`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_tenants` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `api_key` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `secret` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `max_concurrent_rooms` int(10) NOT NULL DEFAULT 0,
+  `max_participants` int(10) NOT NULL DEFAULT 0,
+  `is_active` int(1) NOT NULL DEFAULT 1,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tenant_id` (`tenant_id`),
+  UNIQUE KEY `api_key` (`api_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_room_templates` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `template_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `room_features` mediumtext COLLATE utf8mb4_unicode_ci NOT NULL,
+  `default_lock_settings` text COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_duration` int(10) NOT NULL DEFAULT 0,
+  `empty_timeout` int(10) NOT NULL DEFAULT 0,
+  `max_participants` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  `modified` datetime NOT NULL DEFAULT '0000-00-00 00:00:00' ON UPDATE current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `template_id` (`template_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_chat_archives` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `archive_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_table_id` int(11) NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `file_size` double NOT NULL DEFAULT 0,
+  `total_messages` int(10) NOT NULL DEFAULT 0,
+  `room_creation_time` int(10) NOT NULL DEFAULT 0,
+  `creation_time` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `archive_id` (`archive_id`),
+  UNIQUE KEY `room_sid` (`room_sid`),
+  KEY `room_id` (`room_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `pnm_whiteboard_snapshots` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `snapshot_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_table_id` int(11) NOT NULL,
+  `room_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `room_sid` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `tenant_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `file_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `total_pages` int(10) NOT NULL DEFAULT 0,
+  `total_files` int(10) NOT NULL DEFAULT 0,
+  `total_events` int(10) NOT NULL DEFAULT 0,
+  `room_creation_time` int(10) NOT NULL DEFAULT 0,
+  `creation_time` int(10) NOT NULL DEFAULT 0,
+  `created` datetime NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `snapshot_id` (`snapshot_id`),
+  UNIQUE KEY `room_sid` (`room_sid`),
+  KEY `room_id` (`room_id`),
+  KEY `tenant_id` (`tenant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- the above schema is the same as applying all the migrations till now
+CREATE TABLE IF NOT EXISTS `pnm_schema_migrations` (
+  `version` bigint(20) UNSIGNED NOT NULL,
+  `name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `applied_at` datetime(3) NOT NULL DEFAULT current_timestamp(3),
+  PRIMARY KEY (`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT IGNORE INTO `pnm_schema_migrations` (`version`, `name`) VALUES
+  (1, 'initial_schema'),
+  (2, 'scheduled_rooms'),
+  (3, 'scheduled_room_series'),
+  (4, 'webhook_endpoints'),
+  (5, 'tenants'),
+  (6, 'room_info_ended_default'),
+  (7, 'room_templates'),
+  (8, 'chat_archives'),
+  (9, 'whiteboard_snapshots'),
+  (10, 'recording_parent_room'),
+  (11, 'recording_metadata'),
+  (12, 'recording_retention'),
+  (13, 'recording_soft_delete'),
+  (14, 'scheduled_room_options'),
+  (15, 'room_info_metadata'),
+  (16, 'webhook_endpoint_tenant');
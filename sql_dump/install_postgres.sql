@@ -0,0 +1,247 @@
+-- PostgreSQL schema, the database should be created before importing this file
+-- createdb -E UTF8 plugnmeet
+
+CREATE TABLE IF NOT EXISTS pnm_room_info (
+  id bigserial NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  "roomId" varchar(64) NOT NULL,
+  sid varchar(64) NOT NULL,
+  joined_participants integer NOT NULL DEFAULT 0,
+  is_running smallint NOT NULL DEFAULT 0,
+  is_recording smallint NOT NULL DEFAULT 0,
+  recorder_id varchar(36) NOT NULL DEFAULT '',
+  is_active_rtmp smallint NOT NULL DEFAULT 0,
+  rtmp_node_id varchar(36) NOT NULL DEFAULT '',
+  webhook_url varchar(255) NOT NULL DEFAULT '',
+  is_breakout_room smallint NOT NULL DEFAULT 0,
+  parent_room_id varchar(64) NOT NULL DEFAULT '',
+  series_id varchar(64) NOT NULL DEFAULT '',
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  chat_archive smallint NOT NULL DEFAULT 0,
+  metadata text DEFAULT NULL,
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  ended timestamp NOT NULL DEFAULT '1970-01-01 00:00:00',
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_room_info_sid UNIQUE (sid)
+);
+CREATE INDEX IF NOT EXISTS pnm_room_info_roomid ON pnm_room_info ("roomId");
+CREATE INDEX IF NOT EXISTS pnm_room_info_is_running_roomid ON pnm_room_info (is_running, "roomId");
+CREATE INDEX IF NOT EXISTS pnm_room_info_series_id ON pnm_room_info (series_id);
+CREATE INDEX IF NOT EXISTS pnm_room_info_tenant_id_is_running ON pnm_room_info (tenant_id, is_running);
+
+CREATE TABLE IF NOT EXISTS pnm_recordings (
+  id bigserial NOT NULL,
+  record_id varchar(64) NOT NULL,
+  room_id varchar(64) NOT NULL,
+  parent_room_id varchar(64) NOT NULL DEFAULT '',
+  room_sid varchar(64) DEFAULT NULL,
+  recorder_id varchar(36) NOT NULL,
+  file_path varchar(255) NOT NULL,
+  size double precision NOT NULL,
+  published smallint NOT NULL DEFAULT 1,
+  title varchar(255) NOT NULL DEFAULT '',
+  description text DEFAULT NULL,
+  metadata text DEFAULT NULL,
+  retention_days integer NOT NULL DEFAULT 0,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  creation_time bigint NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  deleted_at timestamp DEFAULT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_recordings_record_id UNIQUE (record_id),
+  FOREIGN KEY (room_sid) REFERENCES pnm_room_info (sid)
+     ON DELETE SET NULL
+     ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS pnm_recordings_room_id ON pnm_recordings (room_id);
+CREATE INDEX IF NOT EXISTS pnm_recordings_parent_room_id ON pnm_recordings (parent_room_id);
+CREATE INDEX IF NOT EXISTS pnm_recordings_tenant_id ON pnm_recordings (tenant_id);
+CREATE INDEX IF NOT EXISTS pnm_recordings_deleted_at ON pnm_recordings (deleted_at);
+
+CREATE TABLE IF NOT EXISTS pnm_room_analytics (
+  id bigserial NOT NULL,
+  room_table_id bigint NULL,
+  room_id varchar(64) NOT NULL,
+  file_id varchar(255) NOT NULL,
+  file_name varchar(255) NOT NULL,
+  file_size double precision NOT NULL CHECK (file_size >= 0),
+  room_creation_time bigint NOT NULL,
+  creation_time bigint NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  PRIMARY KEY (id),
+  FOREIGN KEY (room_table_id) REFERENCES pnm_room_info (id)
+     ON DELETE SET NULL
+     ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS pnm_room_analytics_room_id ON pnm_room_analytics (room_id);
+CREATE INDEX IF NOT EXISTS pnm_room_analytics_file_id ON pnm_room_analytics (file_id);
+CREATE INDEX IF NOT EXISTS pnm_room_analytics_tenant_id ON pnm_room_analytics (tenant_id);
+
+CREATE TABLE IF NOT EXISTS pnm_scheduled_rooms (
+  id bigserial NOT NULL,
+  schedule_id varchar(64) NOT NULL,
+  series_id varchar(64) NOT NULL DEFAULT '',
+  room_id varchar(64) NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  start_at bigint NOT NULL,
+  create_room_req text NOT NULL,
+  create_room_opts varchar(1000) NOT NULL DEFAULT '',
+  status smallint NOT NULL DEFAULT 0,
+  room_sid varchar(64) NOT NULL DEFAULT '',
+  error_msg varchar(255) NOT NULL DEFAULT '',
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_scheduled_rooms_schedule_id UNIQUE (schedule_id)
+);
+CREATE INDEX IF NOT EXISTS pnm_scheduled_rooms_room_id ON pnm_scheduled_rooms (room_id);
+CREATE INDEX IF NOT EXISTS pnm_scheduled_rooms_series_id ON pnm_scheduled_rooms (series_id);
+CREATE INDEX IF NOT EXISTS pnm_scheduled_rooms_tenant_id ON pnm_scheduled_rooms (tenant_id);
+CREATE INDEX IF NOT EXISTS pnm_scheduled_rooms_status_start_at ON pnm_scheduled_rooms (status, start_at);
+
+CREATE TABLE IF NOT EXISTS pnm_scheduled_room_series (
+  id bigserial NOT NULL,
+  series_id varchar(64) NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_title varchar(255) NOT NULL DEFAULT '',
+  recurrence_rule varchar(255) NOT NULL,
+  timezone varchar(64) NOT NULL DEFAULT 'UTC',
+  start_at bigint NOT NULL,
+  occurrences integer NOT NULL DEFAULT 0,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  create_room_req text NOT NULL,
+  create_room_opts varchar(1000) NOT NULL DEFAULT '',
+  status smallint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_scheduled_room_series_series_id UNIQUE (series_id)
+);
+CREATE INDEX IF NOT EXISTS pnm_scheduled_room_series_room_id ON pnm_scheduled_room_series (room_id);
+
+CREATE TABLE IF NOT EXISTS pnm_webhook_endpoints (
+  id bigserial NOT NULL,
+  endpoint_id varchar(64) NOT NULL,
+  name varchar(255) NOT NULL DEFAULT '',
+  url varchar(255) NOT NULL,
+  secret varchar(255) NOT NULL,
+  events text NOT NULL,
+  is_active smallint NOT NULL DEFAULT 1,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_webhook_endpoints_endpoint_id UNIQUE (endpoint_id)
+);
+CREATE INDEX IF NOT EXISTS pnm_webhook_endpoints_is_active ON pnm_webhook_endpoints (is_active);
+CREATE INDEX IF NOT EXISTS pnm_webhook_endpoints_tenant_id ON pnm_webhook_endpoints (tenant_id);
+
+CREATE TABLE IF NOT EXISTS pnm_tenants (
+  id bigserial NOT NULL,
+  tenant_id varchar(64) NOT NULL,
+  name varchar(255) NOT NULL DEFAULT '',
+  api_key varchar(64) NOT NULL,
+  secret varchar(255) NOT NULL,
+  max_concurrent_rooms integer NOT NULL DEFAULT 0,
+  max_participants integer NOT NULL DEFAULT 0,
+  is_active smallint NOT NULL DEFAULT 1,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_tenants_tenant_id UNIQUE (tenant_id),
+  CONSTRAINT pnm_tenants_api_key UNIQUE (api_key)
+);
+
+CREATE TABLE IF NOT EXISTS pnm_room_templates (
+  id bigserial NOT NULL,
+  template_id varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  name varchar(255) NOT NULL DEFAULT '',
+  room_features text NOT NULL,
+  default_lock_settings text NOT NULL,
+  room_duration integer NOT NULL DEFAULT 0,
+  empty_timeout integer NOT NULL DEFAULT 0,
+  max_participants integer NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  modified timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_room_templates_template_id UNIQUE (template_id)
+);
+
+CREATE INDEX IF NOT EXISTS pnm_room_templates_tenant_id ON pnm_room_templates (tenant_id);
+
+CREATE TABLE IF NOT EXISTS pnm_chat_archives (
+  id bigserial NOT NULL,
+  archive_id varchar(64) NOT NULL,
+  room_table_id bigint NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_sid varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  file_name varchar(255) NOT NULL,
+  file_size double precision NOT NULL DEFAULT 0,
+  total_messages integer NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_chat_archives_archive_id UNIQUE (archive_id),
+  CONSTRAINT pnm_chat_archives_room_sid UNIQUE (room_sid)
+);
+
+CREATE INDEX IF NOT EXISTS pnm_chat_archives_room_id ON pnm_chat_archives (room_id);
+CREATE INDEX IF NOT EXISTS pnm_chat_archives_tenant_id ON pnm_chat_archives (tenant_id);
+
+CREATE TABLE IF NOT EXISTS pnm_whiteboard_snapshots (
+  id bigserial NOT NULL,
+  snapshot_id varchar(64) NOT NULL,
+  room_table_id bigint NOT NULL,
+  room_id varchar(64) NOT NULL,
+  room_sid varchar(64) NOT NULL,
+  tenant_id varchar(64) NOT NULL DEFAULT '',
+  file_id varchar(64) NOT NULL DEFAULT '',
+  file_name varchar(255) NOT NULL DEFAULT '',
+  total_pages integer NOT NULL DEFAULT 0,
+  total_files integer NOT NULL DEFAULT 0,
+  total_events integer NOT NULL DEFAULT 0,
+  room_creation_time bigint NOT NULL DEFAULT 0,
+  creation_time bigint NOT NULL DEFAULT 0,
+  created timestamp NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (id),
+  CONSTRAINT pnm_whiteboard_snapshots_snapshot_id UNIQUE (snapshot_id),
+  CONSTRAINT pnm_whiteboard_snapshots_room_sid UNIQUE (room_sid)
+);
+
+CREATE INDEX IF NOT EXISTS pnm_whiteboard_snapshots_room_id ON pnm_whiteboard_snapshots (room_id);
+CREATE INDEX IF NOT EXISTS pnm_whiteboard_snapshots_tenant_id ON pnm_whiteboard_snapshots (tenant_id);
+
+-- the above schema is the same as applying all the migrations till now
+CREATE TABLE IF NOT EXISTS pnm_schema_migrations (
+  version bigint NOT NULL,
+  name varchar(255) NOT NULL,
+  applied_at timestamptz NOT NULL DEFAULT current_timestamp,
+  PRIMARY KEY (version)
+);
+
+INSERT INTO pnm_schema_migrations (version, name) VALUES
+  (1, 'initial_schema'),
+  (2, 'scheduled_rooms'),
+  (3, 'scheduled_room_series'),
+  (4, 'webhook_endpoints'),
+  (5, 'tenants'),
+  (6, 'room_info_ended_default'),
+  (7, 'room_templates'),
+  (8, 'chat_archives'),
+  (9, 'whiteboard_snapshots'),
+  (10, 'recording_parent_room'),
+  (11, 'recording_metadata'),
+  (12, 'recording_retention'),
+  (13, 'recording_soft_delete'),
+  (14, 'scheduled_room_options'),
+  (15, 'room_info_metadata'),
+  (16, 'webhook_endpoint_tenant')
+ON CONFLICT (version) DO NOTHING;